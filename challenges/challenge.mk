@@ -1,14 +1,24 @@
 PREFIX ?= pathwar/
 PATHWAR_OPTS ?=
+SIGNING_KEY ?=
+REGISTRY ?=
 
 .PHONY: pathwar.run
 pathwar.run: pathwar.prepare
 	pathwar $(PATHWAR_OPTS) compose up --force-recreate pathwar-compose.yml
 
+.PHONY: pathwar.watch
+pathwar.watch: pathwar.prepare
+	pathwar $(PATHWAR_OPTS) compose up --force-recreate --watch pathwar-compose.yml
+
 .PHONY: pathwar.down
 pathwar.down:
 	pathwar --debug compose down $(notdir $(PWD))
 
+.PHONY: pathwar.reset
+pathwar.reset:
+	pathwar $(PATHWAR_OPTS) compose hook $(notdir $(PWD)) reset
+
 .PHONY: pathwar.ps
 pathwar.ps:
 	pathwar compose ps | grep $(notdir $(PWD))
@@ -17,17 +27,29 @@ pathwar.ps:
 docker.build:
 	docker-compose build --pull
 
+.PHONY: pathwar.lint
+pathwar.lint:
+	pathwar $(PATHWAR_OPTS) compose lint .
+
+.PHONY: pathwar.test
+pathwar.test:
+	pathwar $(PATHWAR_OPTS) compose test .
+
+.PHONY: pathwar.play
+pathwar.play:
+	pathwar $(PATHWAR_OPTS) compose play .
+
 .PHONY: pathwar.prepare
 pathwar.prepare:
 	pathwar $(PATHWAR_OPTS) compose prepare --no-push . > pathwar-compose.yml
 
 .PHONY: pathwar.push
 pathwar.push:
-	pathwar $(PATHWAR_OPTS) compose prepare --prefix=$(PREFIX) . > pathwar-compose.yml
+	pathwar $(PATHWAR_OPTS) compose prepare --prefix=$(PREFIX) $(if $(REGISTRY),--registry=$(REGISTRY)) $(if $(SIGNING_KEY),--sign=$(SIGNING_KEY)) . > pathwar-compose.yml
 
 .PHONY: pathwar.register
 pathwar.register: pathwar.push
-	pathwar $(PATHWAR_OPTS) compose register --print ./pathwar-compose.yml
+	pathwar $(PATHWAR_OPTS) compose register ./pathwar-compose.yml
 
 .PHONY: make.bump
 make.bump:
@@ -0,0 +1,5 @@
+#!/bin/sh
+set -e
+
+# the passphrase is displayed on the winning page
+curl -sSf --retry 30 --retry-connrefused --retry-delay 1 "${PATHWAR_URL}you-win.html"
@@ -8,6 +8,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/dustin/go-humanize"
 	"github.com/olekukonko/tablewriter"
@@ -49,7 +50,13 @@ func adminCommand() *ffcli.Command {
 			adminChallengeRedumpCommand(),
 			adminChallengeFlavorAddCommand(),
 			adminSeasonAddCommand(),
+			adminSeasonSetScoringCommand(),
 			adminSeasonChallengeAddCommand(),
+			adminAgentDrainCommand(),
+			adminRecomputeScoresCommand(),
+			adminRecomputeMedalsCommand(),
+			adminBackfillAchievementsCommand(),
+			adminChallengeValidationReviewCommand(),
 		},
 		ShortHelp: "admin commands",
 		FlagSet:   adminFlags,
@@ -60,6 +67,9 @@ func adminCommand() *ffcli.Command {
 
 func adminChallengesCommand() *ffcli.Command {
 	flags := flag.NewFlagSet("admin challenges", flag.ExitOnError)
+	var list listFlags
+	list.register(flags)
+	list.registerSeason(flags)
 	return &ffcli.Command{
 		Name:    "challenges",
 		Usage:   "pathwar [global flags] admin [admin flags] challenges [flags]",
@@ -75,7 +85,15 @@ func adminChallengesCommand() *ffcli.Command {
 				return errcode.TODO.Wrap(err)
 			}
 
-			ret, err := apiClient.AdminListChallenges(ctx, &pwapi.AdminListChallenges_Input{})
+			options, err := list.options()
+			if err != nil {
+				return err
+			}
+			input := pwapi.AdminListChallenges_Input{
+				ListOptions: options,
+				SeasonID:    list.seasonID,
+			}
+			ret, err := apiClient.AdminListChallenges(ctx, &input)
 			if err != nil {
 				return errcode.TODO.Wrap(err)
 			}
@@ -163,7 +181,7 @@ func adminChallengesCommand() *ffcli.Command {
 			{
 				fmt.Println("INSTANCES")
 				table := tablewriter.NewWriter(os.Stdout)
-				table.SetHeader([]string{"INSTANCE", "FLAVOR", "AGENT", "STATUS", "CREATED", "UPDATED", "CONFIG", "SEASON CHALLENGES"})
+				table.SetHeader([]string{"INSTANCE", "FLAVOR", "AGENT", "STATUS", "CREATED", "UPDATED", "CONFIG", "STARTUP", "SEASON CHALLENGES"})
 				table.SetAlignment(tablewriter.ALIGN_CENTER)
 				table.SetBorder(false)
 
@@ -182,15 +200,23 @@ func adminChallengesCommand() *ffcli.Command {
 							if len(config) > 30 {
 								config = config[:28] + "..."
 							}
+							startup := "-"
+							if instance.StartupAttempts > 0 {
+								startup = fmt.Sprintf("%d failures: %s", instance.StartupAttempts, instance.StartupError)
+								if len(startup) > 40 {
+									startup = startup[:38] + "..."
+								}
+							}
 
 							seasonChallenges := fmt.Sprintf("%d", len(flavor.SeasonChallenges))
-							table.Append([]string{id, flavorSlug, agentSlug, status, createdAgo, updatedAgo, config, seasonChallenges})
+							table.Append([]string{id, flavorSlug, agentSlug, status, createdAgo, updatedAgo, config, startup, seasonChallenges})
 						}
 					}
 				}
 				table.Render()
 			}
 
+			printListPage(len(ret.Challenges), list.offset, ret.Total, ret.NextOffset)
 			return nil
 		},
 	}
@@ -198,6 +224,10 @@ func adminChallengesCommand() *ffcli.Command {
 
 func adminUsersCommand() *ffcli.Command {
 	flags := flag.NewFlagSet("admin users", flag.ExitOnError)
+	var list listFlags
+	list.register(flags)
+	list.registerSeason(flags)
+	list.registerStatus(flags)
 	return &ffcli.Command{
 		Name:    "users",
 		Usage:   "pathwar [global flags] admin [admin flags] users [flags]",
@@ -213,7 +243,16 @@ func adminUsersCommand() *ffcli.Command {
 				return errcode.TODO.Wrap(err)
 			}
 
-			ret, err := apiClient.AdminListUsers(ctx, &pwapi.AdminListUsers_Input{})
+			options, err := list.options()
+			if err != nil {
+				return err
+			}
+			input := pwapi.AdminListUsers_Input{
+				ListOptions: options,
+				SeasonID:    list.seasonID,
+				Status:      list.status,
+			}
+			ret, err := apiClient.AdminListUsers(ctx, &input)
 			if err != nil {
 				return errcode.TODO.Wrap(err)
 			}
@@ -248,6 +287,7 @@ func adminUsersCommand() *ffcli.Command {
 				fmt.Println("")
 			}
 
+			printListPage(len(ret.Users), list.offset, ret.Total, ret.NextOffset)
 			return nil
 		},
 	}
@@ -255,6 +295,9 @@ func adminUsersCommand() *ffcli.Command {
 
 func adminAgentsCommand() *ffcli.Command {
 	flags := flag.NewFlagSet("admin agents", flag.ExitOnError)
+	var list listFlags
+	list.register(flags)
+	list.registerStatus(flags)
 	return &ffcli.Command{
 		Name:    "agents",
 		Usage:   "pathwar [global flags] admin [admin flags] agents [flags]",
@@ -270,7 +313,15 @@ func adminAgentsCommand() *ffcli.Command {
 				return errcode.TODO.Wrap(err)
 			}
 
-			ret, err := apiClient.AdminListAgents(ctx, &pwapi.AdminListAgents_Input{})
+			options, err := list.options()
+			if err != nil {
+				return err
+			}
+			input := pwapi.AdminListAgents_Input{
+				ListOptions: options,
+				Status:      list.status,
+			}
+			ret, err := apiClient.AdminListAgents(ctx, &input)
 			if err != nil {
 				return errcode.TODO.Wrap(err)
 			}
@@ -298,6 +349,9 @@ func adminAgentsCommand() *ffcli.Command {
 					instances := asciiInstancesStats(agent.ChallengeInstances)
 					stats := fmt.Sprintf("%d seen / %d reg.", agent.TimesSeen, agent.TimesRegistered)
 					status := asciiStatus(agent.Status.String())
+					if agent.Status == pwdb.Agent_Draining && agent.DrainDeadline != nil {
+						status += " " + humanize.Time(*agent.DrainDeadline)
+					}
 					isDefault := asciiBool(agent.DefaultAgent)
 					suffix := agent.DomainSuffix
 					hostname := agent.Hostname
@@ -307,6 +361,7 @@ func adminAgentsCommand() *ffcli.Command {
 				fmt.Println("")
 			}
 
+			printListPage(len(ret.Agents), list.offset, ret.Total, ret.NextOffset)
 			return nil
 		},
 	}
@@ -314,6 +369,9 @@ func adminAgentsCommand() *ffcli.Command {
 
 func adminActivitiesCommand() *ffcli.Command {
 	flags := flag.NewFlagSet("admin activities", flag.ExitOnError)
+	var list listFlags
+	list.register(flags)
+	list.registerSeason(flags)
 	return &ffcli.Command{
 		Name:    "activities",
 		Usage:   "pathwar [global flags] admin [admin flags] activities [flags]",
@@ -329,7 +387,15 @@ func adminActivitiesCommand() *ffcli.Command {
 				return errcode.TODO.Wrap(err)
 			}
 
-			ret, err := apiClient.AdminListActivities(ctx, &pwapi.AdminListActivities_Input{})
+			options, err := list.options()
+			if err != nil {
+				return err
+			}
+			input := pwapi.AdminListActivities_Input{
+				ListOptions: options,
+				SeasonID:    list.seasonID,
+			}
+			ret, err := apiClient.AdminListActivities(ctx, &input)
 			if err != nil {
 				return errcode.TODO.Wrap(err)
 			}
@@ -352,6 +418,9 @@ func adminActivitiesCommand() *ffcli.Command {
 					author := activity.Author.Slug
 					id := fmt.Sprintf("%d", activity.ID)
 					kind := activity.Kind.String()
+					if activity.Count > 0 {
+						kind += fmt.Sprintf(" (x%d)", activity.Count)
+					}
 					createdAgo := humanize.Time(*activity.CreatedAt)
 					team := activity.Team.ASCIIID()
 					user := activity.User.ASCIIID()
@@ -371,6 +440,7 @@ func adminActivitiesCommand() *ffcli.Command {
 				fmt.Println("")
 			}
 
+			printListPage(len(ret.Activities), list.offset, ret.Total, ret.NextOffset)
 			return nil
 		},
 	}
@@ -378,6 +448,10 @@ func adminActivitiesCommand() *ffcli.Command {
 
 func adminOrganizationsCommand() *ffcli.Command {
 	flags := flag.NewFlagSet("admin organizations", flag.ExitOnError)
+	var list listFlags
+	list.register(flags)
+	list.registerSeason(flags)
+	list.registerStatus(flags)
 	return &ffcli.Command{
 		Name:    "organizations",
 		Usage:   "pathwar [global flags] admin [admin flags] organizations [flags]",
@@ -393,7 +467,16 @@ func adminOrganizationsCommand() *ffcli.Command {
 				return errcode.TODO.Wrap(err)
 			}
 
-			ret, err := apiClient.AdminListOrganizations(ctx, &pwapi.AdminListOrganizations_Input{})
+			options, err := list.options()
+			if err != nil {
+				return err
+			}
+			input := pwapi.AdminListOrganizations_Input{
+				ListOptions: options,
+				SeasonID:    list.seasonID,
+				Status:      list.status,
+			}
+			ret, err := apiClient.AdminListOrganizations(ctx, &input)
 			if err != nil {
 				return errcode.TODO.Wrap(err)
 			}
@@ -435,6 +518,7 @@ func adminOrganizationsCommand() *ffcli.Command {
 				fmt.Println("")
 			}
 
+			printListPage(len(ret.Organizations), list.offset, ret.Total, ret.NextOffset)
 			return nil
 		},
 	}
@@ -442,6 +526,10 @@ func adminOrganizationsCommand() *ffcli.Command {
 
 func adminTeamsCommand() *ffcli.Command {
 	flags := flag.NewFlagSet("admin teams", flag.ExitOnError)
+	var list listFlags
+	list.register(flags)
+	list.registerSeason(flags)
+	list.registerStatus(flags)
 	return &ffcli.Command{
 		Name:    "teams",
 		Usage:   "pathwar [global flags] admin [admin flags] teams [flags]",
@@ -457,7 +545,16 @@ func adminTeamsCommand() *ffcli.Command {
 				return errcode.TODO.Wrap(err)
 			}
 
-			ret, err := apiClient.AdminListTeams(ctx, &pwapi.AdminListTeams_Input{})
+			options, err := list.options()
+			if err != nil {
+				return err
+			}
+			input := pwapi.AdminListTeams_Input{
+				ListOptions: options,
+				SeasonID:    list.seasonID,
+				Status:      list.status,
+			}
+			ret, err := apiClient.AdminListTeams(ctx, &input)
 			if err != nil {
 				return errcode.TODO.Wrap(err)
 			}
@@ -501,6 +598,7 @@ func adminTeamsCommand() *ffcli.Command {
 				fmt.Println("")
 			}
 
+			printListPage(len(ret.Teams), list.offset, ret.Total, ret.NextOffset)
 			return nil
 		},
 	}
@@ -508,6 +606,9 @@ func adminTeamsCommand() *ffcli.Command {
 
 func adminCouponsCommand() *ffcli.Command {
 	flags := flag.NewFlagSet("admin coupons", flag.ExitOnError)
+	var list listFlags
+	list.register(flags)
+	list.registerSeason(flags)
 	return &ffcli.Command{
 		Name:    "coupons",
 		Usage:   "pathwar [global flags] admin [admin flags] coupons [flags]",
@@ -523,7 +624,15 @@ func adminCouponsCommand() *ffcli.Command {
 				return errcode.TODO.Wrap(err)
 			}
 
-			ret, err := apiClient.AdminListCoupons(ctx, &pwapi.AdminListCoupons_Input{})
+			options, err := list.options()
+			if err != nil {
+				return err
+			}
+			input := pwapi.AdminListCoupons_Input{
+				ListOptions: options,
+				SeasonID:    list.seasonID,
+			}
+			ret, err := apiClient.AdminListCoupons(ctx, &input)
 			if err != nil {
 				return errcode.TODO.Wrap(err)
 			}
@@ -566,6 +675,7 @@ func adminCouponsCommand() *ffcli.Command {
 				fmt.Println("")
 			}
 
+			printListPage(len(ret.Coupons), list.offset, ret.Total, ret.NextOffset)
 			return nil
 		},
 	}
@@ -573,6 +683,10 @@ func adminCouponsCommand() *ffcli.Command {
 
 func adminChallengeSubscriptionsCommand() *ffcli.Command {
 	flags := flag.NewFlagSet("admin challengeSubscriptions", flag.ExitOnError)
+	var list listFlags
+	list.register(flags)
+	list.registerSeason(flags)
+	list.registerStatus(flags)
 	return &ffcli.Command{
 		Name:    "subscriptions",
 		Usage:   "pathwar [global flags] admin [admin flags] subscriptions [flags]",
@@ -588,7 +702,16 @@ func adminChallengeSubscriptionsCommand() *ffcli.Command {
 				return errcode.TODO.Wrap(err)
 			}
 
-			ret, err := apiClient.AdminListChallengeSubscriptions(ctx, &pwapi.AdminListChallengeSubscriptions_Input{})
+			options, err := list.options()
+			if err != nil {
+				return err
+			}
+			input := pwapi.AdminListChallengeSubscriptions_Input{
+				ListOptions: options,
+				SeasonID:    list.seasonID,
+				Status:      list.status,
+			}
+			ret, err := apiClient.AdminListChallengeSubscriptions(ctx, &input)
 			if err != nil {
 				return errcode.TODO.Wrap(err)
 			}
@@ -624,15 +747,20 @@ func adminChallengeSubscriptionsCommand() *ffcli.Command {
 				fmt.Println("")
 			}
 
+			printListPage(len(ret.Subscriptions), list.offset, ret.Total, ret.NextOffset)
 			return nil
 		},
 	}
 }
 
 func adminListAllCommand() *ffcli.Command {
+	flags := flag.NewFlagSet("admin list-all", flag.ExitOnError)
+	var list listFlags
+	list.register(flags)
 	return &ffcli.Command{
-		Name:  "list-all",
-		Usage: "pathwar [global flags] admin [admin flags] list-all",
+		Name:    "list-all",
+		Usage:   "pathwar [global flags] admin [admin flags] list-all [flags]",
+		FlagSet: flags,
 		Exec: func(args []string) error {
 			if err := globalPreRun(); err != nil {
 				return err
@@ -644,7 +772,14 @@ func adminListAllCommand() *ffcli.Command {
 				return errcode.TODO.Wrap(err)
 			}
 
-			ret, err := apiClient.AdminListAll(ctx, &pwapi.AdminListAll_Input{})
+			options, err := list.options()
+			if err != nil {
+				return err
+			}
+			input := pwapi.AdminListAll_Input{
+				ListOptions: options,
+			}
+			ret, err := apiClient.AdminListAll(ctx, &input)
 			if err != nil {
 				return errcode.TODO.Wrap(err)
 			}
@@ -724,6 +859,9 @@ func adminListAllCommand() *ffcli.Command {
 			}*/
 
 			table.Render()
+			if ret.NextOffset > 0 {
+				fmt.Printf("next page with --offset=%d\n", ret.NextOffset)
+			}
 			fmt.Println("")
 			return nil
 		},
@@ -800,6 +938,8 @@ func adminAddCouponCommand() *ffcli.Command {
 
 func adminRedumpCommand() *ffcli.Command {
 	flags := flag.NewFlagSet("admin redump", flag.ExitOnError)
+	input := pwapi.AdminRedump_Input{}
+	flags.BoolVar(&input.Soft, "soft", false, "run the on-reset hook of the running instances instead of recreating them")
 	return &ffcli.Command{
 		Name:    "redump",
 		Usage:   "pathwar [global flags] admin [admin flags] redump [flags] ID...",
@@ -819,9 +959,8 @@ func adminRedumpCommand() *ffcli.Command {
 				return errcode.TODO.Wrap(err)
 			}
 
-			ret, err := apiClient.AdminRedump(ctx, &pwapi.AdminRedump_Input{
-				Identifiers: args,
-			})
+			input.Identifiers = args
+			ret, err := apiClient.AdminRedump(ctx, &input)
 			if err != nil {
 				return errcode.TODO.Wrap(err)
 			}
@@ -838,6 +977,201 @@ func adminRedumpCommand() *ffcli.Command {
 	}
 }
 
+func adminAgentDrainCommand() *ffcli.Command {
+	input := pwapi.AdminAgentDrain_Input{}
+	var timeout time.Duration
+	flags := flag.NewFlagSet("admin agent drain", flag.ExitOnError)
+	flags.DurationVar(&timeout, "timeout", 30*time.Minute, "delay given to the players before stopping and moving the instances")
+	flags.BoolVar(&input.Cancel, "cancel", false, "put the agent back in service")
+
+	return &ffcli.Command{
+		Name:      "agent-drain",
+		Usage:     "pathwar [global flags] admin [admin flags] agent-drain [flags] AGENT",
+		ShortHelp: "stop placing instances on an agent and move its instances after a timeout",
+		FlagSet:   flags,
+		Exec: func(args []string) error {
+			if len(args) != 1 {
+				return flag.ErrHelp
+			}
+			input.Agent = args[0]
+			deadline := time.Now().Add(timeout)
+			input.Deadline = &deadline
+
+			if err := globalPreRun(); err != nil {
+				return err
+			}
+
+			ctx := context.Background()
+			apiClient, err := httpClientFromEnv(ctx)
+			if err != nil {
+				return errcode.TODO.Wrap(err)
+			}
+
+			ret, err := apiClient.AdminAgentDrain(ctx, &input)
+			if err != nil {
+				return errcode.TODO.Wrap(err)
+			}
+
+			if adminJSONFormat {
+				fmt.Println(godev.PrettyJSONPB(&ret))
+				return nil
+			}
+
+			if ret.Agent.Status == pwdb.Agent_Draining && ret.Agent.DrainDeadline != nil {
+				fmt.Printf("%s is draining, instances will be moved %s\n", ret.Agent.Name, humanize.Time(*ret.Agent.DrainDeadline))
+			} else {
+				fmt.Printf("%s is %s\n", ret.Agent.Name, ret.Agent.Status)
+			}
+			return nil
+		},
+	}
+}
+
+func adminRecomputeScoresCommand() *ffcli.Command {
+	input := pwapi.AdminRecomputeScores_Input{}
+	flags := flag.NewFlagSet("admin recompute scores", flag.ExitOnError)
+	flags.Int64Var(&input.SeasonID, "season", input.SeasonID, "Season ID, every season if empty")
+
+	return &ffcli.Command{
+		Name:      "recompute-scores",
+		Usage:     "pathwar [global flags] admin [admin flags] recompute-scores [flags]",
+		ShortHelp: "recompute the team scores from their accepted validations",
+		FlagSet:   flags,
+		Exec: func(args []string) error {
+			if err := globalPreRun(); err != nil {
+				return err
+			}
+
+			ctx := context.Background()
+			apiClient, err := httpClientFromEnv(ctx)
+			if err != nil {
+				return errcode.TODO.Wrap(err)
+			}
+
+			ret, err := apiClient.AdminRecomputeScores(ctx, &input)
+			if err != nil {
+				return errcode.TODO.Wrap(err)
+			}
+
+			if adminJSONFormat {
+				fmt.Println(godev.PrettyJSONPB(&ret))
+				return nil
+			}
+
+			if len(ret.Teams) == 0 {
+				fmt.Println("all scores are up to date")
+				return nil
+			}
+			table := tablewriter.NewWriter(os.Stdout)
+			table.SetHeader([]string{"TEAM", "SEASON", "SCORE"})
+			table.SetAlignment(tablewriter.ALIGN_CENTER)
+			table.SetBorder(false)
+			for _, team := range ret.Teams {
+				table.Append([]string{team.ASCIIID(), fmt.Sprintf("%d", team.SeasonID), fmt.Sprintf("%d", team.Score)})
+			}
+			table.Render()
+			return nil
+		},
+	}
+}
+
+func adminRecomputeMedalsCommand() *ffcli.Command {
+	input := pwapi.AdminRecomputeMedals_Input{}
+	flags := flag.NewFlagSet("admin recompute medals", flag.ExitOnError)
+	flags.Int64Var(&input.SeasonID, "season", input.SeasonID, "Season ID, every season if empty")
+
+	return &ffcli.Command{
+		Name:      "recompute-medals",
+		Usage:     "pathwar [global flags] admin [admin flags] recompute-medals [flags]",
+		ShortHelp: "award the medals again, i.e., after refusing a validation or deleting a team",
+		FlagSet:   flags,
+		Exec: func(args []string) error {
+			if err := globalPreRun(); err != nil {
+				return err
+			}
+
+			ctx := context.Background()
+			apiClient, err := httpClientFromEnv(ctx)
+			if err != nil {
+				return errcode.TODO.Wrap(err)
+			}
+
+			ret, err := apiClient.AdminRecomputeMedals(ctx, &input)
+			if err != nil {
+				return errcode.TODO.Wrap(err)
+			}
+
+			if adminJSONFormat {
+				fmt.Println(godev.PrettyJSONPB(&ret))
+				return nil
+			}
+
+			if len(ret.Teams) == 0 {
+				fmt.Println("all medals are up to date")
+				return nil
+			}
+			table := tablewriter.NewWriter(os.Stdout)
+			table.SetHeader([]string{"TEAM", "SEASON", "GOLD", "SILVER", "BRONZE"})
+			table.SetAlignment(tablewriter.ALIGN_CENTER)
+			table.SetBorder(false)
+			for _, team := range ret.Teams {
+				table.Append([]string{
+					team.ASCIIID(),
+					fmt.Sprintf("%d", team.SeasonID),
+					fmt.Sprintf("%d", team.GoldMedals),
+					fmt.Sprintf("%d", team.SilverMedals),
+					fmt.Sprintf("%d", team.BronzeMedals),
+				})
+			}
+			table.Render()
+			return nil
+		},
+	}
+}
+
+func adminBackfillAchievementsCommand() *ffcli.Command {
+	return &ffcli.Command{
+		Name:      "backfill-achievements",
+		Usage:     "pathwar [global flags] admin [admin flags] backfill-achievements",
+		ShortHelp: "grant the achievements deserved by the existing teams and users",
+		Exec: func(args []string) error {
+			if err := globalPreRun(); err != nil {
+				return err
+			}
+
+			ctx := context.Background()
+			apiClient, err := httpClientFromEnv(ctx)
+			if err != nil {
+				return errcode.TODO.Wrap(err)
+			}
+
+			ret, err := apiClient.AdminBackfillAchievements(ctx, &pwapi.AdminBackfillAchievements_Input{})
+			if err != nil {
+				return errcode.TODO.Wrap(err)
+			}
+
+			if adminJSONFormat {
+				fmt.Println(godev.PrettyJSONPB(&ret))
+				return nil
+			}
+
+			if len(ret.Achievements) == 0 {
+				fmt.Println("all achievements are up to date")
+				return nil
+			}
+			table := tablewriter.NewWriter(os.Stdout)
+			table.SetHeader([]string{"TEAM", "ACHIEVEMENT"})
+			table.SetAlignment(tablewriter.ALIGN_CENTER)
+			table.SetBorder(false)
+			for _, achievement := range ret.Achievements {
+				table.Append([]string{fmt.Sprintf("%d", achievement.TeamID), achievement.Type.String()})
+			}
+			table.Render()
+			return nil
+		},
+	}
+}
+
 func adminChallengeAddCommand() *ffcli.Command {
 	input := pwapi.AdminChallengeAdd_Input{Challenge: &pwdb.Challenge{}}
 	input.ApplyDefaults()
@@ -945,10 +1279,12 @@ func adminChallengeFlavorAddCommand() *ffcli.Command {
 	flags.StringVar(&input.ChallengeFlavor.SourceURL, "source-url", input.ChallengeFlavor.SourceURL, "Source URL")
 	flags.Int64Var(&input.ChallengeFlavor.PurchasePrice, "purchase-price", input.ChallengeFlavor.PurchasePrice, "Purchase Price")
 	flags.Int64Var(&input.ChallengeFlavor.ValidationReward, "validation-reward", input.ChallengeFlavor.ValidationReward, "Validation reward")
+	flags.Int64Var(&input.ChallengeFlavor.Points, "points", input.ChallengeFlavor.Points, "Points added to the score, defaults to the validation reward")
 	flags.StringVar(&input.ChallengeFlavor.Body, "body", input.ChallengeFlavor.Body, "Body")
 	flags.StringVar(&input.ChallengeFlavor.Category, "category", input.ChallengeFlavor.Category, "Category")
 	flags.StringVar(&input.ChallengeFlavor.TagList, "tags", input.ChallengeFlavor.TagList, "Comma-separated tags")
 	flags.StringVar(&input.ChallengeFlavor.RedumpPolicyConfig, "redump-policy", input.ChallengeFlavor.RedumpPolicyConfig, "JSON config for redump-policy")
+	flags.StringVar(&input.ChallengeFlavor.ProxyPolicyConfig, "proxy-policy", input.ChallengeFlavor.ProxyPolicyConfig, "JSON config for proxy-policy")
 	flags.Int64Var(&input.ChallengeFlavor.Passphrases, "passphrases", input.ChallengeFlavor.Passphrases, "Amount of passphrases")
 
 	return &ffcli.Command{
@@ -1024,6 +1360,10 @@ func adminSeasonAddCommand() *ffcli.Command {
 	flags.StringVar(&visibility, "visibility", visibility, "Season visibility (private, unlisted or public)")
 	flags.StringVar(&status, "status", status, "Season status (started or stopped)")
 	flags.StringVar(&subscription, "subscription", subscription, "Susbscription status (open or close)")
+	flags.BoolVar(&input.Season.DynamicScoring, "dynamic-scoring", input.Season.DynamicScoring, "decrease the points of a challenge as teams solve it")
+	flags.Int64Var(&input.Season.ScoringInitial, "scoring-initial", input.Season.ScoringInitial, "points of a challenge before its first solve, with dynamic scoring (default 500)")
+	flags.Int64Var(&input.Season.ScoringMinimum, "scoring-minimum", input.Season.ScoringMinimum, "minimum points of a challenge, with dynamic scoring (default 100)")
+	flags.Int64Var(&input.Season.ScoringDecay, "scoring-decay", input.Season.ScoringDecay, "number of solves for a challenge to reach its minimum points, with dynamic scoring (default 20)")
 
 	return &ffcli.Command{
 		Name:      "season-add",
@@ -1091,6 +1431,67 @@ func adminSeasonAddCommand() *ffcli.Command {
 	}
 }
 
+func adminSeasonSetScoringCommand() *ffcli.Command {
+	input := pwapi.AdminSeasonSetScoring_Input{}
+	flags := flag.NewFlagSet("admin season set scoring", flag.ExitOnError)
+	flags.Int64Var(&input.SeasonID, "season", input.SeasonID, "Season ID")
+	flags.BoolVar(&input.DynamicScoring, "dynamic-scoring", input.DynamicScoring, "decrease the points of a challenge as teams solve it")
+	flags.Int64Var(&input.ScoringInitial, "scoring-initial", input.ScoringInitial, "points of a challenge before its first solve, with dynamic scoring (default 500)")
+	flags.Int64Var(&input.ScoringMinimum, "scoring-minimum", input.ScoringMinimum, "minimum points of a challenge, with dynamic scoring (default 100)")
+	flags.Int64Var(&input.ScoringDecay, "scoring-decay", input.ScoringDecay, "number of solves for a challenge to reach its minimum points, with dynamic scoring (default 20)")
+
+	return &ffcli.Command{
+		Name:      "season-set-scoring",
+		Usage:     "pathwar [global flags] admin [admin flags] season-set-scoring [flags]",
+		ShortHelp: "switch the scoring mode of a season and recompute its scores",
+		FlagSet:   flags,
+		Exec: func(args []string) error {
+			if input.SeasonID == 0 {
+				return flag.ErrHelp
+			}
+
+			if err := globalPreRun(); err != nil {
+				return err
+			}
+
+			ctx := context.Background()
+			apiClient, err := httpClientFromEnv(ctx)
+			if err != nil {
+				return errcode.TODO.Wrap(err)
+			}
+
+			ret, err := apiClient.AdminSeasonSetScoring(ctx, &input)
+			if err != nil {
+				return errcode.TODO.Wrap(err)
+			}
+
+			if adminJSONFormat {
+				fmt.Println(godev.PrettyJSONPB(&ret))
+				return nil
+			}
+
+			if ret.Season.DynamicScoring {
+				fmt.Printf("%s uses dynamic scoring, a challenge decays from %d to %d points after %d solves, scaled by the points of its flavor if any\n", ret.Season.Slug, ret.Season.ScoringInitial, ret.Season.ScoringMinimum, ret.Season.ScoringDecay)
+			} else {
+				fmt.Printf("%s uses the points of the flavors\n", ret.Season.Slug)
+			}
+			if len(ret.Teams) == 0 {
+				fmt.Println("no score changed")
+				return nil
+			}
+			table := tablewriter.NewWriter(os.Stdout)
+			table.SetHeader([]string{"TEAM", "SCORE"})
+			table.SetAlignment(tablewriter.ALIGN_CENTER)
+			table.SetBorder(false)
+			for _, team := range ret.Teams {
+				table.Append([]string{team.ASCIIID(), fmt.Sprintf("%d", team.Score)})
+			}
+			table.Render()
+			return nil
+		},
+	}
+}
+
 func adminSeasonChallengeAddCommand() *ffcli.Command {
 	input := pwapi.AdminSeasonChallengeAdd_Input{}
 	input.ApplyDefaults()
@@ -1205,3 +1606,43 @@ func asciiStatus(status string) string {
 	}
 	return status
 }
+
+func adminChallengeValidationReviewCommand() *ffcli.Command {
+	input := pwapi.AdminChallengeValidationReview_Input{}
+	flags := flag.NewFlagSet("admin challenge validation review", flag.ExitOnError)
+	flags.Int64Var(&input.ChallengeValidationID, "id", input.ChallengeValidationID, "Challenge validation ID")
+	flags.BoolVar(&input.Refuse, "refuse", input.Refuse, "Refuse the validation instead of accepting it")
+	flags.StringVar(&input.CorrectorComment, "comment", input.CorrectorComment, "Comment sent to the team")
+
+	return &ffcli.Command{
+		Name:      "challenge-validation-review",
+		Usage:     "pathwar [global flags] admin [admin flags] challenge-validation-review [flags]",
+		ShortHelp: "accept or refuse a validation waiting for a review",
+		FlagSet:   flags,
+		Exec: func(args []string) error {
+			if err := globalPreRun(); err != nil {
+				return err
+			}
+
+			ctx := context.Background()
+			apiClient, err := httpClientFromEnv(ctx)
+			if err != nil {
+				return errcode.TODO.Wrap(err)
+			}
+
+			ret, err := apiClient.AdminChallengeValidationReview(ctx, &input)
+			if err != nil {
+				return errcode.TODO.Wrap(err)
+			}
+
+			if adminJSONFormat {
+				fmt.Println(godev.PrettyJSONPB(&ret))
+				return nil
+			}
+
+			validation := ret.ChallengeValidation
+			fmt.Printf("validation %d of team %d is %s\n", validation.ID, validation.TeamID, validation.Status)
+			return nil
+		},
+	}
+}
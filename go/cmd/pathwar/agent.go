@@ -13,10 +13,12 @@ import (
 	"moul.io/motd"
 	"pathwar.land/pathwar/v2/go/pkg/errcode"
 	"pathwar.land/pathwar/v2/go/pkg/pwagent"
+	"pathwar.land/pathwar/v2/go/pkg/pwcompose"
 	"pathwar.land/pathwar/v2/go/pkg/pwinit"
 )
 
 func agentCommand() *ffcli.Command {
+	var agentTrustedKeys string
 	agentFlags := flag.NewFlagSet("agent", flag.ExitOnError)
 	agentFlags.StringVar(&httpAPIAddr, "http-api-addr", defaultHTTPApiAddr, "HTTP API address")
 	agentFlags.StringVar(&ssoOpts.ClientID, "sso-clientid", ssoOpts.ClientID, "SSO ClientID")
@@ -31,10 +33,23 @@ func agentCommand() *ffcli.Command {
 	agentFlags.StringVar(&agentOpts.Name, "agent-name", agentOpts.Name, "Agent Name")
 	agentFlags.StringVar(&agentOpts.DomainSuffix, "domain-suffix", agentOpts.DomainSuffix, "Domain suffix to append")
 	agentFlags.StringVar(&agentOpts.NginxDockerImage, "docker-image", agentOpts.NginxDockerImage, "docker image used to generate nginx proxy container")
+	agentFlags.StringVar(&agentOpts.NginxConfigTemplate, "nginx-template", agentOpts.NginxConfigTemplate, "path to a custom nginx config template (Go text/template)")
 	agentFlags.StringVar(&agentOpts.HostIP, "host", agentOpts.HostIP, "Nginx HTTP listening addr")
 	agentFlags.StringVar(&agentOpts.HostPort, "port", agentOpts.HostPort, "Nginx HTTP listening port")
 	agentFlags.StringVar(&agentOpts.ModeratorPassword, "moderator-password", agentOpts.ModeratorPassword, "Challenge moderator password")
 	agentFlags.StringVar(&agentOpts.AuthSalt, "salt", agentOpts.AuthSalt, "salt used to generate secure hashes (random if empty)")
+	agentFlags.Int64Var(&agentOpts.RateLimit, "rate-limit", agentOpts.RateLimit, "max requests per second by user and by IP on challenge instances (0 to disable)")
+	agentFlags.Int64Var(&agentOpts.RateLimitBurst, "rate-limit-burst", agentOpts.RateLimitBurst, "number of requests allowed to exceed the rate limit")
+	agentFlags.Int64Var(&agentOpts.ConnLimit, "conn-limit", agentOpts.ConnLimit, "max concurrent connections by user and by IP on challenge instances (0 to disable)")
+	agentFlags.IntVar(&agentOpts.MaxStartupAttempts, "max-startup-attempts", agentOpts.MaxStartupAttempts, "number of failed startups before giving up on an instance")
+	agentFlags.DurationVar(&agentOpts.StartupBackoff, "startup-backoff", agentOpts.StartupBackoff, "initial delay between two startup attempts (doubled after each failure)")
+	agentFlags.BoolVar(&agentOpts.PrepullImages, "prepull-images", agentOpts.PrepullImages, "pull the images of all the assigned instances and of the upcoming flavor versions before starting them")
+	agentFlags.DurationVar(&agentOpts.PrepullInterval, "prepull-interval", agentOpts.PrepullInterval, "minimum delay between two image pre-pulls")
+	agentFlags.DurationVar(&agentOpts.GCInterval, "gc-interval", agentOpts.GCInterval, "delay between two garbage collections of unused challenge images and volumes (0 to disable)")
+	agentFlags.StringVar(&agentTrustedKeys, "trusted-keys", "", "path to a PEM file with the ed25519 public keys allowed to sign challenge bundles, unsigned bundles are refused if set")
+	agentFlags.DurationVar(&agentOpts.DrainTimeout, "drain-timeout", agentOpts.DrainTimeout, "on SIGTERM, delay given to the players before stopping the instances and exiting")
+	agentFlags.StringVar(&agentOpts.RegistryAuth.ServerAddress, "registry", agentOpts.RegistryAuth.ServerAddress, "private registry the credentials are sent to, i.e., registry.example.com:5000")
+	agentFlags.StringVar(&agentOpts.RegistryAuth.Username, "registry-username", agentOpts.RegistryAuth.Username, "private registry username, used when prepulling images with the password of $"+registryPasswordEnv)
 
 	return &ffcli.Command{
 		Name:      "agent",
@@ -45,9 +60,14 @@ func agentCommand() *ffcli.Command {
 		Subcommands: []*ffcli.Command{
 			{
 				Name:      "pwinit.bin",
-				ShortHelp: "dump pwinit binary to stdout",
+				Usage:     "pathwar [global flags] agent [agent flags] pwinit.bin [ARCH]",
+				ShortHelp: "dump pwinit binary to stdout, for amd64 (default), arm64 or 386",
 				Exec: func(args []string) error {
-					b, err := pwinit.Binary()
+					arch := pwinit.DefaultArchitecture
+					if len(args) > 0 {
+						arch = args[0]
+					}
+					b, err := pwinit.BinaryFor(arch)
 					if err != nil {
 						return err
 					}
@@ -81,6 +101,19 @@ func agentCommand() *ffcli.Command {
 				return errcode.TODO.Wrap(err)
 			}
 
+			// the password of the private registry, used when prepulling images, is only read from the environment
+			agentOpts.RegistryAuth.Password, err = registryPassword(false)
+			if err != nil {
+				return err
+			}
+
+			if agentTrustedKeys != "" {
+				agentOpts.TrustedKeys, err = pwcompose.ReadPublicKeys(agentTrustedKeys)
+				if err != nil {
+					return err
+				}
+			}
+
 			agentOpts.Logger = logger
 			return pwagent.Run(ctx, dockerCli, apiClient, agentOpts)
 		},
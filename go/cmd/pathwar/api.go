@@ -38,6 +38,8 @@ func apiCommand() *ffcli.Command {
 	serverFlags.DurationVar(&serverOpts.ShutdownTimeout, "shutdown-timeout", serverOpts.ShutdownTimeout, "shutdown timeout")
 	serverFlags.StringVar(&serverOpts.CORSAllowedOrigins, "cors-allowed-origins", serverOpts.CORSAllowedOrigins, "allowed CORS origins")
 	serverFlags.StringVar(&serverOpts.Bind, "bind", serverOpts.Bind, "server address")
+	serverFlags.DurationVar(&achievementsInterval, "achievements-interval", time.Hour, "delay between two evaluations of the time-based achievements (0 to disable)")
+	serverFlags.DurationVar(&drainsInterval, "drains-interval", time.Minute, "delay between two checks of the drain deadlines of the agents (0 to disable)")
 
 	return &ffcli.Command{
 		Name:      "api",
@@ -190,7 +192,9 @@ dbConnectLoop:
 
 	// init svc
 	svcOpts := pwapi.ServiceOpts{
-		Logger: logger.Named("svc"),
+		Logger:               logger.Named("svc"),
+		AchievementsInterval: achievementsInterval,
+		DrainsInterval:       drainsInterval,
 	}
 
 	svc, err := pwapi.NewService(db, sso, svcOpts)
@@ -46,6 +46,7 @@ func cliCommand() *ffcli.Command {
 			cliChallengeBuyCommand(),
 			cliChallengeValidateCommand(),
 			cliCouponValidateCommand(),
+			cliNotificationsCommand(),
 		},
 	}
 }
@@ -169,8 +170,14 @@ func cliSeasonsCommand() *ffcli.Command {
 }
 
 func cliTeamsCommand() *ffcli.Command {
+	flags := flag.NewFlagSet("cli teams", flag.ExitOnError)
+	var list listFlags
+	list.register(flags)
+	list.registerSeason(flags)
 	return &ffcli.Command{
 		Name:      "teams",
+		Usage:     "pathwar [global flags] cli [cli flags] teams [flags]",
+		FlagSet:   flags,
 		ShortHelp: "List teams, scores, etc",
 		Exec: func(args []string) error {
 			if err := globalPreRun(); err != nil {
@@ -186,8 +193,15 @@ func cliTeamsCommand() *ffcli.Command {
 				return err
 			}
 			logger.Debug("GET /user/session", zap.Any("ret", session))
+			options, err := list.options()
+			if err != nil {
+				return err
+			}
 
 			for _, seasonEntry := range session.Seasons {
+				if list.seasonID != 0 && list.seasonID != seasonEntry.Season.ID {
+					continue
+				}
 				if !jsonFormat {
 					fmt.Printf("Season: %s\n", seasonEntry.Season.Name)
 				}
@@ -196,9 +210,12 @@ func cliTeamsCommand() *ffcli.Command {
 				table.SetAlignment(tablewriter.ALIGN_CENTER)
 				table.SetBorder(false)
 
-				url := fmt.Sprintf("/teams?season_id=%d", seasonEntry.Season.ID)
-				var ret pwapi.TeamList_Output
-				if err := client.RawProto(ctx, "GET", url, nil, &ret); err != nil {
+				input := pwapi.TeamList_Input{
+					SeasonID:    seasonEntry.Season.ID,
+					ListOptions: options,
+				}
+				ret, err := client.TeamList(ctx, &input)
+				if err != nil {
 					return err
 				}
 
@@ -207,7 +224,7 @@ func cliTeamsCommand() *ffcli.Command {
 					continue
 				}
 
-				logger.Debug("GET "+url, zap.Any("ret", ret))
+				logger.Debug("GET /teams", zap.Any("ret", ret))
 
 				for _, teamEntry := range ret.Items {
 					slug := teamEntry.Organization.Slug
@@ -229,6 +246,7 @@ func cliTeamsCommand() *ffcli.Command {
 					table.Append([]string{slug, name, score, cash, medals, achievements})
 				}
 				table.Render()
+				printListPage(len(ret.Items), list.offset, ret.Total, ret.NextOffset)
 			}
 			return nil
 		},
@@ -359,8 +377,14 @@ func cliAcceptTeamInviteCommand() *ffcli.Command {
 }
 
 func cliChallengesCommand() *ffcli.Command {
+	flags := flag.NewFlagSet("cli challenges", flag.ExitOnError)
+	var list listFlags
+	list.register(flags)
+	list.registerSeason(flags)
 	return &ffcli.Command{
 		Name:      "challenges",
+		Usage:     "pathwar [global flags] cli [cli flags] challenges [flags]",
+		FlagSet:   flags,
 		ShortHelp: "List challenges",
 		Exec: func(args []string) error {
 			if err := globalPreRun(); err != nil {
@@ -376,20 +400,30 @@ func cliChallengesCommand() *ffcli.Command {
 				return err
 			}
 			logger.Debug("GET /user/session", zap.Any("ret", session))
+			options, err := list.options()
+			if err != nil {
+				return err
+			}
 
 			for _, seasonEntry := range session.Seasons {
+				if list.seasonID != 0 && list.seasonID != seasonEntry.Season.ID {
+					continue
+				}
 				if !jsonFormat {
 					fmt.Printf("Season: %s\n", seasonEntry.Season.Name)
 				}
 				table := tablewriter.NewWriter(os.Stdout)
-				table.SetHeader([]string{"FLAVOR", "INSTANCES", "PRICE/REWARD", "SUBSCRIPTION", "URLS"})
+				table.SetHeader([]string{"FLAVOR", "INSTANCES", "PRICE/REWARD", "POINTS", "SUBSCRIPTION", "URLS"})
 				table.SetAlignment(tablewriter.ALIGN_CENTER)
 				table.SetBorder(false)
 				table.SetColWidth(100)
 
-				url := fmt.Sprintf("/season-challenges?season_id=%d", seasonEntry.Season.ID)
-				var ret pwapi.SeasonChallengeList_Output
-				if err := client.RawProto(ctx, "GET", url, nil, &ret); err != nil {
+				input := pwapi.SeasonChallengeList_Input{
+					SeasonID:    seasonEntry.Season.ID,
+					ListOptions: options,
+				}
+				ret, err := client.SeasonChallengeList(ctx, &input)
+				if err != nil {
 					return err
 				}
 
@@ -398,7 +432,7 @@ func cliChallengesCommand() *ffcli.Command {
 					continue
 				}
 
-				logger.Debug("GET "+url, zap.Any("ret", ret))
+				logger.Debug("GET /season-challenges", zap.Any("ret", ret))
 
 				for _, challengeEntry := range ret.Items {
 					flavor := challengeEntry.Flavor
@@ -428,9 +462,11 @@ func cliChallengesCommand() *ffcli.Command {
 						price = fmt.Sprintf("$%d", flavor.PurchasePrice)
 					}
 					priceReward := fmt.Sprintf("%s / $%d", price, flavor.ValidationReward)
-					table.Append([]string{flavorID, instances, priceReward, subscription, urls})
+					points := fmt.Sprintf("%d (%d solves)", challengeEntry.Points, challengeEntry.Solves)
+					table.Append([]string{flavorID, instances, priceReward, points, subscription, urls})
 				}
 				table.Render()
+				printListPage(len(ret.Items), list.offset, ret.Total, ret.NextOffset)
 			}
 			return nil
 		},
@@ -578,3 +614,83 @@ func cliCouponValidateCommand() *ffcli.Command {
 		},
 	}
 }
+
+func cliNotificationsCommand() *ffcli.Command {
+	flags := flag.NewFlagSet("cli notifications", flag.ExitOnError)
+	var (
+		list       listFlags
+		unreadOnly bool
+		markRead   bool
+	)
+	list.register(flags)
+	flags.BoolVar(&unreadOnly, "unread", unreadOnly, "Only list the unread notifications")
+	flags.BoolVar(&markRead, "mark-read", markRead, "Mark the listed notifications as read")
+	return &ffcli.Command{
+		Name:      "notifications",
+		Usage:     "pathwar [global flags] cli [cli flags] notifications [flags]",
+		FlagSet:   flags,
+		ShortHelp: "List your notifications",
+		Exec: func(args []string) error {
+			if err := globalPreRun(); err != nil {
+				return err
+			}
+			ctx := context.Background()
+			client, err := httpClientFromEnv(ctx)
+			if err != nil {
+				return err
+			}
+			options, err := list.options()
+			if err != nil {
+				return err
+			}
+
+			input := pwapi.NotificationList_Input{
+				ListOptions: options,
+				UnreadOnly:  unreadOnly,
+			}
+			ret, err := client.NotificationList(ctx, &input)
+			if err != nil {
+				return err
+			}
+			logger.Debug("GET /notifications", zap.Any("ret", ret))
+
+			if markRead && len(ret.Items) > 0 {
+				markInput := pwapi.NotificationMarkRead_Input{}
+				for _, notification := range ret.Items {
+					markInput.NotificationIDs = append(markInput.NotificationIDs, notification.ID)
+				}
+				marked, err := client.NotificationMarkRead(ctx, &markInput)
+				if err != nil {
+					return err
+				}
+				ret.Unread = marked.Unread
+			}
+
+			if jsonFormat {
+				fmt.Println(godev.PrettyJSONPB(&ret))
+				return nil
+			}
+
+			table := tablewriter.NewWriter(os.Stdout)
+			table.SetHeader([]string{"DATE", "MESSAGE", "ARGS", "READ"})
+			table.SetAlignment(tablewriter.ALIGN_LEFT)
+			table.SetBorder(false)
+			table.SetColWidth(100)
+			for _, notification := range ret.Items {
+				date := "-"
+				if notification.CreatedAt != nil {
+					date = humanize.Time(*notification.CreatedAt)
+				}
+				read := "-"
+				if notification.ReadAt != nil {
+					read = humanize.Time(*notification.ReadAt)
+				}
+				table.Append([]string{date, notification.Msg, notification.Args, read})
+			}
+			table.Render()
+			printListPage(len(ret.Items), list.offset, ret.Total, ret.NextOffset)
+			fmt.Printf("%d unread\n", ret.Unread)
+			return nil
+		},
+	}
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"bufio"
 	"context"
 	"encoding/json"
 	"errors"
@@ -8,14 +9,21 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"os/signal"
 	"strings"
+	"syscall"
 
 	"github.com/alessio/shellescape"
 	"github.com/docker/docker/client"
 	"github.com/peterbourgon/ff"
 	"github.com/peterbourgon/ff/ffcli"
-	"gopkg.in/yaml.v2"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/ssh/terminal"
+	"gopkg.in/yaml.v3"
+	"moul.io/godev"
 	"pathwar.land/pathwar/v2/go/pkg/errcode"
+	"pathwar.land/pathwar/v2/go/pkg/pwagent"
+	"pathwar.land/pathwar/v2/go/pkg/pwapi"
 	"pathwar.land/pathwar/v2/go/pkg/pwcompose"
 )
 
@@ -27,7 +35,13 @@ func composeCommand() *ffcli.Command {
 		Subcommands: []*ffcli.Command{
 			composeUpCommand(),
 			composePrepareCommand(),
+			composeLintCommand(),
+			composeTestCommand(),
+			composePlayCommand(),
 			composePsCommand(),
+			composeLogsCommand(),
+			composeExecCommand(),
+			composeHookCommand(),
 			composeDownCommand(),
 			composeRegisterCommand(),
 		},
@@ -40,11 +54,16 @@ func composeCommand() *ffcli.Command {
 
 func composeUpCommand() *ffcli.Command {
 	var (
-		composeUpOpts  = pwcompose.NewUpOpts()
-		composeUpFlags = flag.NewFlagSet("compose up", flag.ExitOnError)
+		composeUpOpts          = pwcompose.NewUpOpts()
+		composeUpFlags         = flag.NewFlagSet("compose up", flag.ExitOnError)
+		composeUpWatch         bool
+		composeUpWatchInterval = pwcompose.NewWatchOpts().Interval
 	)
 	composeUpFlags.StringVar(&composeUpOpts.InstanceKey, "instance-key", composeUpOpts.InstanceKey, "instance key used to generate instance ID")
 	composeUpFlags.BoolVar(&composeUpOpts.ForceRecreate, "force-recreate", composeUpOpts.ForceRecreate, "down previously created instances of challenge")
+	composeUpFlags.BoolVar(&composeUpOpts.Build, "build", composeUpOpts.Build, "rebuild the images of the services built locally")
+	composeUpFlags.BoolVar(&composeUpWatch, "watch", composeUpWatch, "rebuild and restart a service when its build directory changes, the challenge should be prepared with --no-push")
+	composeUpFlags.DurationVar(&composeUpWatchInterval, "watch-interval", composeUpWatchInterval, "delay between two checks of the build directories")
 	return &ffcli.Command{
 		Name:    "up",
 		Usage:   "pathwar [global flags] compose [compose flags] up [flags] PATH",
@@ -65,7 +84,11 @@ func composeUpCommand() *ffcli.Command {
 			if err != nil {
 				return err
 			}
-			preparedCompose, err := ioutil.ReadAll(f)
+			composeBundle, err := ioutil.ReadAll(f)
+			if err != nil {
+				return err
+			}
+			bundle, err := pwcompose.OpenBundle(string(composeBundle), nil)
 			if err != nil {
 				return err
 			}
@@ -77,7 +100,28 @@ func composeUpCommand() *ffcli.Command {
 			}
 
 			composeUpOpts.Logger = logger
-			composeUpOpts.PreparedCompose = string(preparedCompose)
+			composeUpOpts.PreparedCompose = bundle.Compose
+			composeUpOpts.OnInit = bundle.OnInit
+			composeUpOpts.Attachments = bundle.Attachments
+
+			if composeUpWatch {
+				ctx, cancel := context.WithCancel(ctx)
+				defer cancel()
+				signals := make(chan os.Signal, 1)
+				signal.Notify(signals, syscall.SIGTERM, os.Interrupt)
+				defer signal.Stop(signals)
+				go func() {
+					<-signals
+					cancel()
+				}()
+
+				watchOpts := pwcompose.NewWatchOpts()
+				watchOpts.Up = composeUpOpts
+				watchOpts.Interval = composeUpWatchInterval
+				watchOpts.Logger = logger
+				return pwcompose.Watch(ctx, cli, watchOpts)
+			}
+
 			services, err := pwcompose.Up(ctx, cli, composeUpOpts)
 			if err != nil {
 				return err
@@ -96,11 +140,18 @@ func composePrepareCommand() *ffcli.Command {
 	var (
 		composePrepareOpts  = pwcompose.NewPrepareOpts()
 		composePrepareFlags = flag.NewFlagSet("compose prepare", flag.ExitOnError)
+
+		composePrepareRegistryPasswordStdin bool
 	)
 	composePrepareFlags.BoolVar(&composePrepareOpts.NoPush, "no-push", composePrepareOpts.NoPush, "don't push images")
 	composePrepareFlags.StringVar(&composePrepareOpts.Prefix, "prefix", composePrepareOpts.Prefix, "docker image prefix")
+	composePrepareFlags.StringVar(&composePrepareOpts.Registry, "registry", composePrepareOpts.Registry, "registry the images are pushed to, i.e., localhost:5000 (defaults to the Docker Hub)")
+	composePrepareFlags.StringVar(&composePrepareOpts.RegistryUsername, "registry-username", composePrepareOpts.RegistryUsername, "registry username")
+	composePrepareFlags.BoolVar(&composePrepareRegistryPasswordStdin, "registry-password-stdin", composePrepareRegistryPasswordStdin, "read the registry password from stdin (defaults to the $"+registryPasswordEnv+" environment variable)")
 	composePrepareFlags.StringVar(&composePrepareOpts.Version, "version", composePrepareOpts.Version, "challenge version")
 	composePrepareFlags.BoolVar(&composePrepareOpts.JSON, "json", composePrepareOpts.JSON, "JSON format")
+	composePrepareFlags.BoolVar(&composePrepareOpts.Bundle, "bundle", composePrepareOpts.Bundle, "generate a bundle archive with the on-init hooks, attachments and image digests")
+	composePrepareFlags.StringVar(&composePrepareOpts.SigningKey, "sign", composePrepareOpts.SigningKey, "path to an ed25519 private key (PEM) used to sign the bundle, implies --bundle")
 	return &ffcli.Command{
 		Name:    "prepare",
 		Usage:   "pathwar [global flags] compose [compose flags] prepare [flags] PATH",
@@ -116,15 +167,244 @@ func composePrepareCommand() *ffcli.Command {
 				return err
 			}
 
+			ctx := context.Background()
+			cli, err := client.NewEnvClient()
+			if err != nil {
+				return errcode.ErrInitDockerClient.Wrap(err)
+			}
+
+			composePrepareOpts.RegistryPassword, err = registryPassword(composePrepareRegistryPasswordStdin)
+			if err != nil {
+				return err
+			}
 			composePrepareOpts.ChallengeDir = path
 			composePrepareOpts.Logger = logger
-			preparedComposeData, err := pwcompose.Prepare(composePrepareOpts)
+			preparedComposeData, err := pwcompose.Prepare(ctx, cli, composePrepareOpts)
 			fmt.Println(preparedComposeData)
 			return err
 		},
 	}
 }
 
+func composeLintCommand() *ffcli.Command {
+	var (
+		composeLintOpts  = pwcompose.NewLintOpts()
+		composeLintFlags = flag.NewFlagSet("compose lint", flag.ExitOnError)
+		composeLintJSON  bool
+	)
+	composeLintFlags.BoolVar(&composeLintJSON, "json", composeLintJSON, "print one JSON diagnostic per line")
+	return &ffcli.Command{
+		Name:      "lint",
+		Usage:     "pathwar [global flags] compose [compose flags] lint [flags] PATH",
+		ShortHelp: "check a challenge for common mistakes",
+		FlagSet:   composeLintFlags,
+		Options:   []ff.Option{ff.WithEnvVarNoPrefix()},
+		Exec: func(args []string) error {
+			if len(args) != 1 {
+				return flag.ErrHelp
+			}
+			err := globalPreRun()
+			if err != nil {
+				return err
+			}
+
+			composeLintOpts.ChallengeDir = args[0]
+			composeLintOpts.Logger = logger
+			diagnostics, err := pwcompose.Lint(composeLintOpts)
+			if err != nil {
+				return err
+			}
+
+			for _, diagnostic := range diagnostics {
+				if composeLintJSON {
+					out, err := json.Marshal(diagnostic)
+					if err != nil {
+						return err
+					}
+					fmt.Println(string(out))
+				} else {
+					fmt.Println(diagnostic)
+				}
+			}
+
+			if pwcompose.HasErrors(diagnostics) {
+				return errcode.ErrComposeLint
+			}
+			return nil
+		},
+	}
+}
+
+func composeTestCommand() *ffcli.Command {
+	var (
+		composeTestOpts    = pwcompose.NewTestOpts()
+		composeTestFlags   = flag.NewFlagSet("compose test", flag.ExitOnError)
+		composeTestSkipped bool
+	)
+	composeTestFlags.DurationVar(&composeTestOpts.Timeout, "timeout", composeTestOpts.Timeout, "override the timeout of the solvers")
+	composeTestFlags.BoolVar(&composeTestOpts.Keep, "keep", composeTestOpts.Keep, "keep the challenges running after the tests")
+	composeTestFlags.BoolVar(&composeTestSkipped, "skip-missing", composeTestSkipped, "skip the challenges without solver instead of failing")
+	return &ffcli.Command{
+		Name:      "test",
+		Usage:     "pathwar [global flags] compose [compose flags] test [flags] PATH [PATH...]",
+		ShortHelp: "check that challenges are solvable by running their solver",
+		FlagSet:   composeTestFlags,
+		Options:   []ff.Option{ff.WithEnvVarNoPrefix()},
+		Exec: func(args []string) error {
+			if len(args) < 1 {
+				return flag.ErrHelp
+			}
+			if err := globalPreRun(); err != nil {
+				return err
+			}
+
+			ctx := context.Background()
+			cli, err := client.NewEnvClient()
+			if err != nil {
+				return errcode.ErrInitDockerClient.Wrap(err)
+			}
+
+			failed := 0
+			for _, path := range args {
+				composeTestOpts.ChallengeDir = path
+				composeTestOpts.Logger = logger
+				err := pwcompose.Test(ctx, cli, composeTestOpts)
+				switch {
+				case err == nil:
+					fmt.Printf("ok    %s\n", path)
+				case composeTestSkipped && errcode.Code(err) == errcode.ErrComposeMissingSolver.Code():
+					fmt.Printf("skip  %s: no solver\n", path)
+				default:
+					fmt.Printf("FAIL  %s: %v\n", path, err)
+					failed++
+				}
+			}
+			if failed > 0 {
+				return errcode.ErrComposeSolverFailed.Wrap(fmt.Errorf("%d/%d challenges failed", failed, len(args)))
+			}
+			return nil
+		},
+	}
+}
+
+func composePlayCommand() *ffcli.Command {
+	var (
+		composePlayOpts            = pwagent.NewOpts()
+		composePlayFlags           = flag.NewFlagSet("compose play", flag.ExitOnError)
+		composePlayKeep            bool
+		composePlayShowPassphrases bool
+	)
+	composePlayOpts.DomainSuffix = "localhost"
+	composePlayOpts.AuthSalt = "playground"
+	composePlayFlags.StringVar(&composePlayOpts.DomainSuffix, "domain-suffix", composePlayOpts.DomainSuffix, "domain suffix of the player and moderator URLs")
+	composePlayFlags.StringVar(&composePlayOpts.HostIP, "host", composePlayOpts.HostIP, "nginx HTTP listening addr")
+	composePlayFlags.StringVar(&composePlayOpts.HostPort, "port", composePlayOpts.HostPort, "nginx HTTP listening port")
+	composePlayFlags.StringVar(&composePlayOpts.NginxDockerImage, "docker-image", composePlayOpts.NginxDockerImage, "docker image used to generate nginx proxy container")
+	composePlayFlags.BoolVar(&composePlayKeep, "keep", composePlayKeep, "keep the challenge and the proxy running when leaving the prompt")
+	composePlayFlags.BoolVar(&composePlayShowPassphrases, "show-passphrases", composePlayShowPassphrases, "print the passphrases of the instance")
+	return &ffcli.Command{
+		Name:      "play",
+		Usage:     "pathwar [global flags] compose [compose flags] play [flags] PATH",
+		ShortHelp: "start a challenge with a local proxy and check passphrases, without API",
+		FlagSet:   composePlayFlags,
+		Options:   []ff.Option{ff.WithEnvVarNoPrefix()},
+		Exec: func(args []string) error {
+			if len(args) != 1 {
+				return flag.ErrHelp
+			}
+			if err := globalPreRun(); err != nil {
+				return err
+			}
+
+			ctx := context.Background()
+			cli, err := client.NewEnvClient()
+			if err != nil {
+				return errcode.ErrInitDockerClient.Wrap(err)
+			}
+
+			prepareOpts := pwcompose.NewPrepareOpts()
+			prepareOpts.ChallengeDir = args[0]
+			prepareOpts.NoPush = true
+			prepareOpts.Version = "play"
+			prepareOpts.Logger = logger
+			preparedCompose, err := pwcompose.Prepare(ctx, cli, prepareOpts)
+			if err != nil {
+				return err
+			}
+
+			composePlayOpts.Logger = logger
+			playground, err := pwagent.Play(ctx, cli, preparedCompose, composePlayOpts)
+			if err != nil {
+				return err
+			}
+			if !composePlayKeep {
+				defer func() {
+					err := pwcompose.Clean(ctx, cli, pwcompose.CleanOpts{
+						ContainerIDs:  []string{playground.ChallengeID},
+						RemoveVolumes: true,
+						RemoveNginx:   true,
+						Logger:        logger,
+					})
+					if err != nil {
+						logger.Warn("down playground", zap.Error(err))
+					}
+				}()
+			}
+
+			fmt.Printf("challenge:   %s\n", playground.ChallengeID)
+			fmt.Printf("player:      %s\n", playground.PlayerURL)
+			for _, url := range playground.ModeratorURLs {
+				fmt.Printf("moderator:   %s\n", url)
+			}
+			if composePlayShowPassphrases {
+				fmt.Printf("passphrases: %s\n", strings.Join(playground.Passphrases, " "))
+			}
+			fmt.Printf("\nsubmit the %d passphrase(s) separated by spaces, or \"quit\"\n", len(playground.Passphrases))
+
+			// read the submissions until EOF, "quit" or a signal
+			signals := make(chan os.Signal, 1)
+			signal.Notify(signals, syscall.SIGTERM, os.Interrupt)
+			defer signal.Stop(signals)
+			lines := make(chan string)
+			go func() {
+				scanner := bufio.NewScanner(os.Stdin)
+				for scanner.Scan() {
+					lines <- scanner.Text()
+				}
+				close(lines)
+			}()
+			for {
+				fmt.Print("> ")
+				select {
+				case <-signals:
+					fmt.Println()
+					return nil
+				case line, ok := <-lines:
+					submitted := strings.Fields(line)
+					switch {
+					case !ok:
+						fmt.Println()
+						return nil
+					case len(submitted) == 0:
+						continue
+					case len(submitted) == 1 && (submitted[0] == "quit" || submitted[0] == "exit"):
+						return nil
+					}
+					valid, expected := playground.Validate(submitted)
+					switch {
+					case valid == expected && expected > 0:
+						fmt.Printf("accepted: %d/%d valid passphrases\n", valid, expected)
+					case valid > 0:
+						fmt.Printf("refused: %d/%d valid passphrases\n", valid, expected)
+					default:
+						fmt.Println("refused: invalid passphrase(s)")
+					}
+				}
+			}
+		},
+	}
+}
+
 func composePsCommand() *ffcli.Command {
 	var composePSFlags = flag.NewFlagSet("compose ps", flag.ExitOnError)
 	composePSFlags.IntVar(&composePSDepth, "depth", 0, "depth to display")
@@ -150,6 +430,135 @@ func composePsCommand() *ffcli.Command {
 	}
 }
 
+func composeLogsCommand() *ffcli.Command {
+	var (
+		composeLogsOpts  = pwcompose.NewLogsOpts()
+		composeLogsFlags = flag.NewFlagSet("compose logs", flag.ExitOnError)
+	)
+	composeLogsFlags.BoolVar(&composeLogsOpts.Follow, "follow", composeLogsOpts.Follow, "follow log output")
+	composeLogsFlags.StringVar(&composeLogsOpts.Tail, "tail", composeLogsOpts.Tail, "number of lines to show from the end of the logs")
+	composeLogsFlags.BoolVar(&composeLogsOpts.Timestamps, "timestamps", composeLogsOpts.Timestamps, "show timestamps")
+	return &ffcli.Command{
+		Name:      "logs",
+		Usage:     "pathwar [global flags] compose [compose flags] logs [flags] ID [SERVICE]",
+		ShortHelp: "print the logs of an instance, ID is an instance ID, a challenge or a container",
+		FlagSet:   composeLogsFlags,
+		Options:   []ff.Option{ff.WithEnvVarNoPrefix()},
+		Exec: func(args []string) error {
+			if len(args) < 1 || len(args) > 2 {
+				return flag.ErrHelp
+			}
+			if err := globalPreRun(); err != nil {
+				return err
+			}
+
+			ctx := context.Background()
+			cli, err := client.NewEnvClient()
+			if err != nil {
+				return errcode.ErrInitDockerClient.Wrap(err)
+			}
+
+			composeLogsOpts.Logger = logger
+			composeLogsOpts.ID = args[0]
+			if len(args) == 2 {
+				composeLogsOpts.Service = args[1]
+			}
+			return pwcompose.Logs(ctx, cli, composeLogsOpts)
+		},
+	}
+}
+
+func composeHookCommand() *ffcli.Command {
+	var (
+		composeHookOpts  = pwcompose.NewHookOpts()
+		composeHookFlags = flag.NewFlagSet("compose hook", flag.ExitOnError)
+	)
+	composeHookFlags.StringVar(&composeHookOpts.Service, "service", composeHookOpts.Service, "only run the hook in the containers of this service")
+	return &ffcli.Command{
+		Name:      "hook",
+		Usage:     "pathwar [global flags] compose [compose flags] hook [flags] ID reset|validate [-- ARGS...]",
+		ShortHelp: "run a pwinit hook in the containers of an instance, i.e., reset to restore its state without recreating it",
+		FlagSet:   composeHookFlags,
+		Options:   []ff.Option{ff.WithEnvVarNoPrefix()},
+		Exec: func(args []string) error {
+			if len(args) > 2 && args[2] == "--" {
+				args = append(args[:2], args[3:]...)
+			}
+			if len(args) < 2 {
+				return flag.ErrHelp
+			}
+			if err := globalPreRun(); err != nil {
+				return err
+			}
+
+			ctx := context.Background()
+			cli, err := client.NewEnvClient()
+			if err != nil {
+				return errcode.ErrInitDockerClient.Wrap(err)
+			}
+
+			composeHookOpts.Logger = logger
+			composeHookOpts.ID = args[0]
+			composeHookOpts.Hook = args[1]
+			composeHookOpts.Args = args[2:]
+			return pwcompose.RunHook(ctx, cli, composeHookOpts)
+		},
+	}
+}
+
+func composeExecCommand() *ffcli.Command {
+	var (
+		composeExecOpts  = pwcompose.NewExecOpts()
+		composeExecFlags = flag.NewFlagSet("compose exec", flag.ExitOnError)
+		noTTY            bool
+	)
+	composeExecFlags.BoolVar(&noTTY, "no-tty", false, "disable pseudo-tty allocation, by default a TTY is allocated when stdin is a terminal")
+	return &ffcli.Command{
+		Name:      "exec",
+		Usage:     "pathwar [global flags] compose [compose flags] exec [flags] ID SERVICE -- COMMAND [ARGS...]",
+		ShortHelp: "run a command in a container of an instance, ID is an instance ID, a challenge or a container",
+		FlagSet:   composeExecFlags,
+		Options:   []ff.Option{ff.WithEnvVarNoPrefix()},
+		Exec: func(args []string) error {
+			if len(args) > 2 && args[2] == "--" {
+				args = append(args[:2], args[3:]...)
+			}
+			if len(args) < 3 {
+				return flag.ErrHelp
+			}
+			if err := globalPreRun(); err != nil {
+				return err
+			}
+
+			ctx := context.Background()
+			cli, err := client.NewEnvClient()
+			if err != nil {
+				return errcode.ErrInitDockerClient.Wrap(err)
+			}
+
+			composeExecOpts.Logger = logger
+			composeExecOpts.ID = args[0]
+			composeExecOpts.Service = args[1]
+			composeExecOpts.Command = args[2:]
+
+			stdinFd := int(os.Stdin.Fd())
+			composeExecOpts.TTY = !noTTY && terminal.IsTerminal(stdinFd)
+			if composeExecOpts.TTY {
+				state, err := terminal.MakeRaw(stdinFd)
+				if err != nil {
+					return errcode.TODO.Wrap(err)
+				}
+				defer func() {
+					if err := terminal.Restore(stdinFd, state); err != nil {
+						logger.Warn("restore terminal", zap.Error(err))
+					}
+				}()
+			}
+			return pwcompose.Exec(ctx, cli, composeExecOpts)
+		},
+	}
+}
+
 func composeDownCommand() *ffcli.Command {
 	var (
 		composeDownFlags = flag.NewFlagSet("compose down", flag.ExitOnError)
@@ -184,9 +593,11 @@ func composeDownCommand() *ffcli.Command {
 func composeRegisterCommand() *ffcli.Command {
 	var (
 		registerPrint        bool
+		registerJSON         bool
 		composeRegisterFlags = flag.NewFlagSet("compose register", flag.ExitOnError)
 	)
-	composeRegisterFlags.BoolVar(&registerPrint, "print", false, "print pathwar commands (pipe-friendly)")
+	composeRegisterFlags.BoolVar(&registerPrint, "print", false, "print pathwar commands instead of calling the API (pipe-friendly)")
+	composeRegisterFlags.BoolVar(&registerJSON, "json", false, "print JSON and exit")
 	return &ffcli.Command{
 		Name:    "register",
 		Usage:   "pathwar [global flags] compose [compose flags] register [flags] path/to/pathwar-compose.yml",
@@ -201,10 +612,6 @@ func composeRegisterCommand() *ffcli.Command {
 				return err
 			}
 
-			if !registerPrint {
-				return errors.New("--print flag is required (for now)")
-			}
-
 			composePath := args[0]
 			f, err := os.Open(composePath)
 			if err != nil {
@@ -217,8 +624,14 @@ func composeRegisterCommand() *ffcli.Command {
 				return errcode.TODO.Wrap(err)
 			}
 
+			// signed bundles are registered as is, the metadata is read from the prepared compose file they contain
+			bundle, err := pwcompose.OpenBundle(string(content), nil)
+			if err != nil {
+				return err
+			}
+
 			var config pwcompose.PathwarConfig
-			if err = yaml.Unmarshal(content, &config); err != nil {
+			if err = yaml.Unmarshal([]byte(bundle.Compose), &config); err != nil {
 				return errcode.TODO.Wrap(err)
 			}
 
@@ -229,6 +642,53 @@ func composeRegisterCommand() *ffcli.Command {
 				return errors.New("a challenge slug is required in docker-compose.yml")
 			}
 
+			// the flavor is registered in the global season unless x-pathwar.seasons is set
+			seasons := config.Pathwar.Seasons
+			if seasons == nil {
+				seasons = []string{"global"}
+			}
+
+			if !registerPrint {
+				ctx := context.Background()
+				apiClient, err := httpClientFromEnv(ctx)
+				if err != nil {
+					return errcode.TODO.Wrap(err)
+				}
+
+				flavor := config.Pathwar.Flavor
+				flavor.ComposeBundle = string(content)
+				input := pwapi.AdminChallengeRegister_Input{
+					Challenge:       &config.Pathwar.Challenge,
+					ChallengeFlavor: &flavor,
+					Seasons:         seasons,
+				}
+				ret, err := apiClient.AdminChallengeRegister(ctx, &input)
+				if err != nil {
+					return errcode.ErrChallengeRegister.Wrap(err)
+				}
+
+				if registerJSON {
+					fmt.Println(godev.PrettyJSONPB(&ret))
+					return nil
+				}
+
+				action := "updated"
+				if ret.FlavorCreated {
+					action = "created"
+				}
+				fmt.Printf("%s flavor %s of challenge %s\n", action, ret.ChallengeFlavor.Slug, ret.Challenge.Slug)
+				if len(seasons) > 0 {
+					fmt.Printf("seasons: %s\n", strings.Join(seasons, ", "))
+				}
+				switch {
+				case ret.BundleChanged:
+					fmt.Printf("compose bundle changed, %d instance(s) will be redumped\n", len(ret.RedumpedInstances))
+				case !ret.FlavorCreated:
+					fmt.Println("compose bundle unchanged, no redump needed")
+				}
+				return nil
+			}
+
 			command := []string{"pathwar", "admin", "challenge-add"}
 			if slug != "" {
 				command = append(command, "--slug", shellescape.Quote(slug))
@@ -270,21 +730,29 @@ func composeRegisterCommand() *ffcli.Command {
 				policy, _ := json.Marshal(config.Pathwar.Flavor.RedumpPolicy)
 				command = append(command, "--redump-policy", shellescape.Quote(string(policy)))
 			}
+			if proxyPolicy := config.Pathwar.Flavor.ProxyPolicy; proxyPolicy != nil {
+				policy, _ := json.Marshal(config.Pathwar.Flavor.ProxyPolicy)
+				command = append(command, "--proxy-policy", shellescape.Quote(string(policy)))
+			}
 			if tags := config.Pathwar.Flavor.Tags; len(tags) > 0 {
 				command = append(command, "--tags", shellescape.Quote(strings.Join(tags, ",")))
 			}
 			if validationReward := config.Pathwar.Flavor.ValidationReward; validationReward != 0 {
 				command = append(command, "--validation-reward", fmt.Sprintf("%d", validationReward))
 			}
+			if points := config.Pathwar.Flavor.Points; points != 0 {
+				command = append(command, "--points", fmt.Sprintf("%d", points))
+			}
 			if purchasePrice := config.Pathwar.Flavor.PurchasePrice; purchasePrice != 0 {
 				command = append(command, "--purchase-price", fmt.Sprintf("%d", purchasePrice))
 			}
 			command = append(command, "--compose-bundle", shellescape.Quote(composePath))
 			fmt.Println(strings.Join(command, " "))
 
-			// FIXME: add a bool in the yaml file to make it dynamic
-			command = []string{"pathwar", "admin", "season-challenge-add", "--flavor", shellescape.Quote(slug), "--season", "global"}
-			fmt.Println(strings.Join(command, " "))
+			for _, season := range seasons {
+				command = []string{"pathwar", "admin", "season-challenge-add", "--flavor", shellescape.Quote(slug), "--season", shellescape.Quote(season)}
+				fmt.Println(strings.Join(command, " "))
+			}
 
 			command = []string{"pathwar", "admin", "challenge-redump", "--id", shellescape.Quote(slug)}
 			fmt.Println(strings.Join(command, " "))
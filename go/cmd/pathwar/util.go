@@ -3,11 +3,13 @@ package main
 import (
 	"context"
 	"encoding/json"
+	"flag"
 	"fmt"
 	"io/ioutil"
 	"math/rand"
 	"net/http"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/Bearer/bearer-go"
@@ -32,6 +34,8 @@ import (
 const (
 	defaultDBURN       = "root:uns3cur3@tcp(127.0.0.1:3306)/pathwar?charset=utf8mb4&parseTime=true"
 	defaultHTTPApiAddr = "https://api-dev.pathwar.land"
+	// registryPasswordEnv is never a flag, the password would show up in the process list and in the shell history
+	registryPasswordEnv = "REGISTRY_PASSWORD"
 )
 
 var (
@@ -45,14 +49,16 @@ var (
 	serverOpts = pwapi.NewServerOpts()
 	ssoOpts    = pwsso.NewOpts()
 
-	DBURN           string
-	DBMaxOpenTries  int
-	bearerSecretKey string
-	composePSDepth  int
-	globalDebug     bool
-	globalSentryDSN string
-	httpAPIAddr     string
-	zipkinEndpoint  string
+	DBURN                string
+	DBMaxOpenTries       int
+	achievementsInterval time.Duration
+	bearerSecretKey      string
+	composePSDepth       int
+	drainsInterval       time.Duration
+	globalDebug          bool
+	globalSentryDSN      string
+	httpAPIAddr          string
+	zipkinEndpoint       string
 )
 
 func ssoFromFlags() (pwsso.Client, error) {
@@ -155,6 +161,92 @@ func httpClientFromEnv(ctx context.Context) (*pwapi.HTTPClient, error) {
 	return pwapi.NewHTTPClient(oauth2.NewClient(ctx, ts), httpAPIAddr), nil
 }
 
+// listFlags are the page, filter and sort flags of the list commands
+type listFlags struct {
+	limit         int64
+	offset        int64
+	orderBy       string
+	seasonID      int64
+	status        string
+	createdAfter  string
+	createdBefore string
+}
+
+// register registers the page, sort and creation range flags, the filters are registered by the commands supporting them
+func (opts *listFlags) register(flags *flag.FlagSet) {
+	flags.Int64Var(&opts.limit, "limit", opts.limit, "Maximum number of items (server default is 100)")
+	flags.Int64Var(&opts.offset, "offset", opts.offset, "Number of items to skip")
+	flags.StringVar(&opts.orderBy, "order-by", opts.orderBy, `Sort field, i.e., "created_at" or "-created_at" for descending order`)
+	flags.StringVar(&opts.createdAfter, "created-after", opts.createdAfter, `RFC3339 date or duration, i.e., "24h" for the last day`)
+	flags.StringVar(&opts.createdBefore, "created-before", opts.createdBefore, "RFC3339 date or duration")
+}
+
+func (opts *listFlags) registerSeason(flags *flag.FlagSet) {
+	flags.Int64Var(&opts.seasonID, "season", opts.seasonID, "Season ID")
+}
+
+func (opts *listFlags) registerStatus(flags *flag.FlagSet) {
+	flags.StringVar(&opts.status, "status", opts.status, `Status, i.e., "Active"`)
+}
+
+// options parses the created flags and returns the list options
+func (opts listFlags) options() (pwapi.ListOptions, error) {
+	parse := func(input string) (*time.Time, error) {
+		if input == "" {
+			return nil, nil
+		}
+		if duration, err := time.ParseDuration(input); err == nil {
+			ago := time.Now().Add(-duration)
+			return &ago, nil
+		}
+		date, err := time.Parse(time.RFC3339, input)
+		if err != nil {
+			return nil, errcode.ErrInvalidListOptions.Wrap(err)
+		}
+		return &date, nil
+	}
+	after, err := parse(opts.createdAfter)
+	if err != nil {
+		return pwapi.ListOptions{}, err
+	}
+	before, err := parse(opts.createdBefore)
+	if err != nil {
+		return pwapi.ListOptions{}, err
+	}
+	return pwapi.ListOptions{
+		Limit:         opts.limit,
+		Offset:        opts.offset,
+		OrderBy:       opts.orderBy,
+		CreatedAfter:  after,
+		CreatedBefore: before,
+	}, nil
+}
+
+// registryPassword returns the password of the docker registry, read from stdin if fromStdin is set, else from the
+// REGISTRY_PASSWORD environment variable
+func registryPassword(fromStdin bool) (string, error) {
+	if !fromStdin {
+		return os.Getenv(registryPasswordEnv), nil
+	}
+	data, err := ioutil.ReadAll(os.Stdin)
+	if err != nil {
+		return "", errcode.ErrInvalidInput.Wrap(err)
+	}
+	return strings.TrimRight(string(data), "\r\n"), nil
+}
+
+func printListPage(count int, offset, total, nextOffset int64) {
+	if count == 0 {
+		fmt.Printf("0 of %d items\n", total)
+		return
+	}
+	fmt.Printf("%d-%d of %d items", offset+1, offset+int64(count), total)
+	if nextOffset > 0 {
+		fmt.Printf(", next page with --offset=%d", nextOffset)
+	}
+	fmt.Println("")
+}
+
 func initSentryFromEnv(startMessage string) (func(), error) {
 	cleanup := func() {}
 	if globalSentryDSN != "" {
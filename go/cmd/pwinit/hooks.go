@@ -0,0 +1,95 @@
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"pathwar.land/pathwar/v2/go/pkg/errcode"
+	"pathwar.land/pathwar/v2/go/pkg/pwinit"
+)
+
+// the paths are variables so the tests can use a temporary directory
+var (
+	pwinitDir       = "/pwinit"
+	configPath      = "/pwinit/config.json"
+	initializedPath = "/pwinit/initialized"
+)
+
+const (
+	// onInitHook runs once, at the first start of the container
+	onInitHook = "on-init"
+	// onStartHook runs at every start of the container, after on-init
+	onStartHook = "on-start"
+	// onResetHook runs with `pwinit reset`, i.e., to restore the state of a running instance
+	onResetHook = "on-reset"
+	// onValidateHook runs with `pwinit validate`, i.e., after a passphrase of the instance was validated
+	onValidateHook = "on-validate"
+)
+
+func hookPath(name string) string {
+	return filepath.Join(pwinitDir, name)
+}
+
+// runHook runs a hook of /pwinit/ if it exists, with the output of pwinit
+func runHook(name string, args ...string) error {
+	path := hookPath(name)
+	if !fileExists(path) {
+		log.Printf("no such %s hook, skipping it", name)
+		return nil
+	}
+	log.Printf("starting %s hook", name)
+	if err := os.Chmod(path, 0555); err != nil {
+		return err
+	}
+	cmd := exec.Command(path, args...)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
+
+// retainConfig removes the config after on-init, it holds the passphrases of every player; it is only kept for the
+// on-reset and on-validate hooks when the image ships them, reduced to the passphrases and readable by root only, so
+// the hooks run with `docker exec --user 0` can get them with `pwinit passphrase`
+func retainConfig() error {
+	if !fileExists(configPath) {
+		return nil
+	}
+	if !fileExists(hookPath(onResetHook)) && !fileExists(hookPath(onValidateHook)) {
+		if err := os.Remove(configPath); err != nil && !os.IsNotExist(err) {
+			return errcode.ErrRemoveInitConfig.Wrap(err)
+		}
+		return nil
+	}
+
+	config, err := getConfig()
+	if err != nil {
+		return errcode.ErrRemoveInitConfig.Wrap(err)
+	}
+	retained, err := json.Marshal(pwinit.InitConfig{
+		Passphrases:      config.Passphrases,
+		PassphraseSecret: config.PassphraseSecret,
+	})
+	if err != nil {
+		return errcode.ErrRemoveInitConfig.Wrap(err)
+	}
+	// protect the file before rewriting it, the templates and the seed are not needed anymore
+	if err := os.Chown(configPath, 0, 0); err != nil {
+		return errcode.ErrChownInitFiles.Wrap(err)
+	}
+	if err := os.Chmod(configPath, 0600); err != nil {
+		return errcode.ErrChownInitFiles.Wrap(err)
+	}
+	if err := ioutil.WriteFile(configPath, retained, 0600); err != nil {
+		return errcode.ErrRemoveInitConfig.Wrap(err)
+	}
+	return nil
+}
+
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
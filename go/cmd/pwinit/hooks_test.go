@@ -0,0 +1,77 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pathwar.land/pathwar/v2/go/pkg/pwinit"
+)
+
+// testingPwinitDir points the pwinit paths to a temporary directory
+func testingPwinitDir(t *testing.T) func() {
+	t.Helper()
+	dir, err := ioutil.TempDir("", "pwinit")
+	require.NoError(t, err)
+	oldDir, oldConfig, oldInitialized := pwinitDir, configPath, initializedPath
+	pwinitDir = dir
+	configPath = filepath.Join(dir, "config.json")
+	initializedPath = filepath.Join(dir, "initialized")
+	return func() {
+		pwinitDir, configPath, initializedPath = oldDir, oldConfig, oldInitialized
+		os.RemoveAll(dir)
+	}
+}
+
+func TestRunHook(t *testing.T) {
+	cleanup := testingPwinitDir(t)
+	defer cleanup()
+	output := filepath.Join(pwinitDir, "output")
+
+	// a missing hook is skipped
+	assert.NoError(t, runHook(onResetHook))
+
+	script := "#!/bin/sh\necho \"$@\" > " + output + "\n"
+	require.NoError(t, ioutil.WriteFile(hookPath(onValidateHook), []byte(script), 0644))
+	require.NoError(t, runHook(onValidateHook, "a", "b"))
+	content, err := ioutil.ReadFile(output)
+	require.NoError(t, err)
+	assert.Equal(t, "a b\n", string(content))
+
+	require.NoError(t, ioutil.WriteFile(hookPath(onResetHook), []byte("#!/bin/sh\nexit 1\n"), 0644))
+	assert.Error(t, runHook(onResetHook))
+}
+
+func TestRetainConfig(t *testing.T) {
+	cleanup := testingPwinitDir(t)
+	defer cleanup()
+	config := `{"passphrases":["a","b"],"templates":["/etc/flag"],"seed":"s33d","passphrase_secret":"s3cr3t"}`
+
+	// a missing config is ignored
+	assert.NoError(t, retainConfig())
+
+	// without a hook run after on-init, the passphrases are removed with the config
+	require.NoError(t, ioutil.WriteFile(configPath, []byte(config), 0644))
+	require.NoError(t, ioutil.WriteFile(hookPath(onStartHook), []byte("#!/bin/sh\n"), 0644))
+	require.NoError(t, retainConfig())
+	assert.False(t, fileExists(configPath))
+
+	if os.Getuid() != 0 {
+		t.Skip("changing the owner of the config requires root")
+	}
+	for _, hook := range []string{onResetHook, onValidateHook} {
+		require.NoError(t, ioutil.WriteFile(configPath, []byte(config), 0644))
+		require.NoError(t, ioutil.WriteFile(hookPath(hook), []byte("#!/bin/sh\n"), 0644))
+		require.NoError(t, retainConfig())
+		info, err := os.Stat(configPath)
+		require.NoError(t, err, hook)
+		assert.Equal(t, os.FileMode(0600), info.Mode().Perm(), hook)
+		retained, err := getConfig()
+		require.NoError(t, err, hook)
+		assert.Equal(t, pwinit.InitConfig{Passphrases: []string{"a", "b"}, PassphraseSecret: "s3cr3t"}, *retained, hook)
+		require.NoError(t, os.Remove(hookPath(hook)))
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"os"
 	"os/exec"
 	"strconv"
+	"strings"
 	"syscall"
 
 	"github.com/peterbourgon/ff/ffcli"
@@ -21,9 +22,22 @@ import (
 func main() {
 	log.SetFlags(0)
 
+	var entrypointOpts struct {
+		user             string
+		group            string
+		selfDestruct     bool
+		keepCapabilities bool
+	}
+	entrypointFlags := flag.NewFlagSet("entrypoint", flag.ExitOnError)
+	entrypointFlags.StringVar(&entrypointOpts.user, "user", "", "user (name or uid, optionally followed by :group) to switch to after the on-init hook")
+	entrypointFlags.StringVar(&entrypointOpts.group, "group", "", "group (name or gid) to switch to, defaults to the primary group of the user")
+	entrypointFlags.BoolVar(&entrypointOpts.selfDestruct, "self-destruct", false, "remove pwinit from the container before switching to the original entrypoint")
+	entrypointFlags.BoolVar(&entrypointOpts.keepCapabilities, "keep-capabilities", false, "allow the user to gain privileges again, i.e., with setuid binaries")
+
 	entrypoint := &ffcli.Command{
-		Name:  "entrypoint",
-		Usage: "pwinit entrypoint [args...]",
+		Name:    "entrypoint",
+		Usage:   "pwinit entrypoint [flags] [--] [args...]",
+		FlagSet: entrypointFlags,
 		Exec: func(args []string) error {
 			// FIXME: lock to block other commands
 
@@ -33,38 +47,82 @@ func main() {
 
 			fmt.Println(banner.Inline("pwinit"))
 
-			_, err := os.Stat("/pwinit/config.json")
-			if err != nil {
-				log.Printf("no such config file, skipping on-init hook (%v)", err)
-			} else {
-				log.Print("starting on-init hook")
-				// prepare the challenge
-				err = os.Chmod("/pwinit/on-init", 0555)
+			_, err := os.Stat(initializedPath)
+			switch {
+			case err == nil:
+				log.Print("already initialized, skipping on-init hook")
+			case !fileExists(configPath):
+				log.Printf("no such config file, skipping on-init hook")
+			default:
+				config, err := getConfig()
 				if err != nil {
 					return errcode.ErrExecuteOnInitHook.Wrap(err)
 				}
-				cmd := exec.Command("/pwinit/on-init")
-				err = cmd.Run()
-				if err != nil {
+
+				// render the templates before the hook, so it can rely on them
+				for _, path := range config.Templates {
+					log.Printf("rendering %s", path)
+					if err := config.RenderFile(path); err != nil {
+						return err
+					}
+				}
+
+				// prepare the challenge
+				if err := runHook(onInitHook); err != nil {
 					return errcode.ErrExecuteOnInitHook.Wrap(err)
 				}
+				if err := os.Remove(hookPath(onInitHook)); err != nil && !os.IsNotExist(err) {
+					return errcode.ErrRemoveInitConfig.Wrap(err)
+				}
+				if err := ioutil.WriteFile(initializedPath, nil, 0600); err != nil {
+					return errcode.ErrExecuteOnInitHook.Wrap(err)
+				}
+			}
 
-				// clean pwinit config file that contains passphrases
-				for _, filename := range []string{"/pwinit/config.json", "/pwinit/on-init"} {
-					err = os.Remove(filename)
-					if err != nil {
-						return errcode.ErrRemoveInitConfig.Wrap(err)
-					}
+			if err := runHook(onStartHook); err != nil {
+				return errcode.ErrExecuteInitHook.Wrap(err)
+			}
+
+			// resolve the user before a self-destruct, the lookup may need files of the image only
+			var cred *credentials
+			if entrypointOpts.user != "" {
+				cred, err = lookupCredentials(entrypointOpts.user, entrypointOpts.group)
+				if err != nil {
+					return err
 				}
 			}
 
-			// FIXME: add a self-destruct mode that allows having root access only at runtime
+			// the passphrases are only kept for the on-reset and on-validate hooks
+			if err := retainConfig(); err != nil {
+				return err
+			}
+
+			if entrypointOpts.selfDestruct {
+				if err := selfDestruct(); err != nil {
+					return err
+				}
+			} else if cred != nil {
+				// give the files created in /pwinit by the hooks to the user, the hooks and the config stay owned by root
+				if err := chownFiles(pwinitDir, cred.uid, cred.gid); err != nil {
+					return err
+				}
+			}
 
 			// switch to original's entrypoint
 			binary, err := exec.LookPath(args[0])
 			if err != nil {
 				return err
 			}
+			if cred != nil {
+				log.Printf("switching to uid=%d gid=%d", cred.uid, cred.gid)
+				if err := dropPrivileges(*cred, entrypointOpts.keepCapabilities); err != nil {
+					return err
+				}
+				// like docker does when starting a container with a user, unless the image overrides it
+				if home := os.Getenv("HOME"); cred.home != "" && (home == "" || home == "/root") {
+					os.Setenv("HOME", cred.home)
+				}
+			}
 			env := os.Environ()
 			if err := syscall.Exec(binary, args, env); err != nil {
 				return err
@@ -99,23 +157,56 @@ func main() {
 	}
 
 	passphrase := &ffcli.Command{
-		Name:  "passphrase",
-		Usage: "pwinit passphrase ID",
+		Name:      "passphrase",
+		Usage:     "pwinit passphrase ID [PREFIX-HASH]",
+		ShortHelp: "print a passphrase, the prefix hash of the player is required if the passphrases are per user",
 		Exec: func(args []string) error {
-			if len(args) != 1 {
+			if len(args) < 1 || len(args) > 2 {
 				return flag.ErrHelp
 			}
-			id, err := strconv.ParseInt(args[0], 10, 64)
+			id, err := strconv.Atoi(args[0])
 			if err != nil {
 				return err
 			}
+			prefixHash := ""
+			if len(args) == 2 {
+				prefixHash = args[1]
+			}
 
 			config, err := getConfig()
 			if err != nil {
 				return err
 			}
 
-			fmt.Println(config.Passphrases[id])
+			passphrase, err := config.Passphrase(id, prefixHash)
+			if err != nil {
+				return err
+			}
+			fmt.Println(passphrase)
+			return nil
+		},
+	}
+
+	reset := &ffcli.Command{
+		Name:      "reset",
+		Usage:     "pwinit reset [args...]",
+		ShortHelp: "run the on-reset hook, i.e., to restore the state of a running instance",
+		Exec: func(args []string) error {
+			if err := runHook(onResetHook, args...); err != nil {
+				return errcode.ErrExecuteInitHook.Wrap(err)
+			}
+			return nil
+		},
+	}
+
+	validate := &ffcli.Command{
+		Name:      "validate",
+		Usage:     "pwinit validate [args...]",
+		ShortHelp: "run the on-validate hook, i.e., after a passphrase of the instance was validated",
+		Exec: func(args []string) error {
+			if err := runHook(onValidateHook, args...); err != nil {
+				return errcode.ErrExecuteInitHook.Wrap(err)
+			}
 			return nil
 		},
 	}
@@ -123,7 +214,7 @@ func main() {
 	root := &ffcli.Command{
 		Usage:       "pwinit <subcommand> [flags] [args...]",
 		LongHelp:    "More info here: https://github.com/pathwar/pathwar/wiki/CLI#pwinit",
-		Subcommands: []*ffcli.Command{entrypoint, env, config, passphrase},
+		Subcommands: []*ffcli.Command{entrypoint, env, config, passphrase, reset, validate},
 		Exec: func([]string) error {
 			fmt.Println(banner.Inline("pwinit"))
 			return flag.ErrHelp
@@ -131,7 +222,7 @@ func main() {
 	}
 
 	args := os.Args[1:]
-	if len(args) > 0 && args[0] == "entrypoint" && len(args) > 1 && args[1] != "--" {
+	if len(args) > 0 && args[0] == "entrypoint" && len(args) > 1 && !strings.HasPrefix(args[1], "-") {
 		args = append([]string{"entrypoint", "--"}, args[1:]...)
 	}
 	if err := root.Run(args); err != nil {
@@ -143,7 +234,7 @@ func main() {
 }
 
 func getConfig() (*pwinit.InitConfig, error) {
-	configJSON, err := ioutil.ReadFile("/pwinit/config.json")
+	configJSON, err := ioutil.ReadFile(configPath)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"pathwar.land/pathwar/v2/go/pkg/errcode"
+)
+
+const (
+	prCapbsetDrop   = 24 // PR_CAPBSET_DROP
+	prSetNoNewPrivs = 38 // PR_SET_NO_NEW_PRIVS
+
+	// defaultLastCapability is CAP_CHECKPOINT_RESTORE, used when /proc is not available
+	defaultLastCapability = 40
+)
+
+// dropPrivileges switches to the given user; unless keepCapabilities is set, the capability bounding set is emptied and
+// the process cannot gain privileges anymore, so that setuid binaries do not give root access back
+func dropPrivileges(cred credentials, keepCapabilities bool) error {
+	if !keepCapabilities {
+		for capability := 0; capability <= lastCapability(); capability++ {
+			_, _, errno := syscall.RawSyscall(syscall.SYS_PRCTL, prCapbsetDrop, uintptr(capability), 0)
+			if errno != 0 && errno != syscall.EINVAL {
+				return errcode.ErrDropInitPrivileges.Wrap(fmt.Errorf("drop capability %d: %w", capability, errno))
+			}
+		}
+		if _, _, errno := syscall.RawSyscall(syscall.SYS_PRCTL, prSetNoNewPrivs, 1, 0); errno != 0 {
+			return errcode.ErrDropInitPrivileges.Wrap(fmt.Errorf("set no_new_privs: %w", errno))
+		}
+	}
+
+	// the effective and permitted capabilities are cleared by the kernel when leaving uid 0
+	if err := syscall.Setgroups(cred.groups); err != nil {
+		return errcode.ErrDropInitPrivileges.Wrap(fmt.Errorf("setgroups: %w", err))
+	}
+	if err := syscall.Setgid(cred.gid); err != nil {
+		return errcode.ErrDropInitPrivileges.Wrap(fmt.Errorf("setgid: %w", err))
+	}
+	if err := syscall.Setuid(cred.uid); err != nil {
+		return errcode.ErrDropInitPrivileges.Wrap(fmt.Errorf("setuid: %w", err))
+	}
+	return nil
+}
+
+func lastCapability() int {
+	content, err := ioutil.ReadFile("/proc/sys/kernel/cap_last_cap")
+	if err != nil {
+		return defaultLastCapability
+	}
+	last, err := strconv.Atoi(strings.TrimSpace(string(content)))
+	if err != nil {
+		return defaultLastCapability
+	}
+	return last
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pathwar.land/pathwar/v2/go/pkg/errcode"
+)
+
+const testingDropPrivilegesEnv = "PWINIT_TESTING_DROP_PRIVILEGES"
+
+func TestDropPrivileges(t *testing.T) {
+	if mode := os.Getenv(testingDropPrivilegesEnv); mode != "" {
+		testingDropPrivilegesProcess(mode == "keep")
+		return
+	}
+
+	if os.Getuid() != 0 {
+		// no state is changed when the privileges cannot be dropped
+		err := dropPrivileges(credentials{uid: 12345, gid: 12345, groups: []int{12345}}, true)
+		assert.Equal(t, errcode.Code(errcode.ErrDropInitPrivileges), errcode.Code(err))
+		t.Skip("switching to another user requires root")
+	}
+
+	// the privileges are dropped in a child process, they cannot be restored in the test process
+	status := func(mode string) string {
+		cmd := exec.Command(os.Args[0], "-test.run=^TestDropPrivileges$")
+		cmd.Env = append(os.Environ(), testingDropPrivilegesEnv+"="+mode)
+		out, err := cmd.CombinedOutput()
+		require.NoErrorf(t, err, "%s", out)
+		return string(out)
+	}
+
+	out := status("drop")
+	assert.Contains(t, out, "uid=12345 gid=12346 groups=[12346]\n")
+	assert.Contains(t, out, "no_new_privs=1\n")
+	assert.Contains(t, out, "capbnd=0000000000000000\n")
+
+	out = status("keep")
+	assert.Contains(t, out, "uid=12345 gid=12346 groups=[12346]\n")
+	assert.Contains(t, out, "no_new_privs=0\n")
+	assert.NotContains(t, out, "capbnd=0000000000000000\n")
+}
+
+// testingDropPrivilegesProcess drops the privileges of the child process and prints its credentials
+func testingDropPrivilegesProcess(keepCapabilities bool) {
+	if err := dropPrivileges(credentials{uid: 12345, gid: 12346, groups: []int{12346}}, keepCapabilities); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	groups, _ := os.Getgroups()
+	fmt.Printf("uid=%d gid=%d groups=%v\n", os.Getuid(), os.Getgid(), groups)
+	content, _ := ioutil.ReadFile("/proc/self/status")
+	for _, line := range strings.Split(string(content), "\n") {
+		switch fields := strings.Fields(line); {
+		case len(fields) != 2:
+		case fields[0] == "NoNewPrivs:":
+			fmt.Printf("no_new_privs=%s\n", fields[1])
+		case fields[0] == "CapBnd:":
+			fmt.Printf("capbnd=%s\n", fields[1])
+		}
+	}
+	os.Exit(0)
+}
@@ -0,0 +1,15 @@
+//go:build !linux
+// +build !linux
+
+package main
+
+import (
+	"errors"
+
+	"pathwar.land/pathwar/v2/go/pkg/errcode"
+)
+
+// dropPrivileges is only supported on linux, pwinit runs in linux containers
+func dropPrivileges(credentials, bool) error {
+	return errcode.ErrDropInitPrivileges.Wrap(errors.New("not supported on this platform"))
+}
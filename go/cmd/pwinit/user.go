@@ -0,0 +1,136 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/user"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"pathwar.land/pathwar/v2/go/pkg/errcode"
+)
+
+type credentials struct {
+	uid    int
+	gid    int
+	groups []int
+	home   string
+}
+
+// lookupCredentials resolves a docker user spec ("user", "uid", "user:group", "uid:gid") in the files of the image,
+// like docker does, unknown numeric uids are allowed and default to the gid 0
+func lookupCredentials(spec string, group string) (*credentials, error) {
+	parts := strings.SplitN(spec, ":", 2)
+	if group == "" && len(parts) == 2 {
+		group = parts[1]
+	}
+
+	var cred credentials
+	u, err := lookupUser(parts[0])
+	switch {
+	case err == nil:
+		cred.uid, _ = strconv.Atoi(u.Uid)
+		cred.gid, _ = strconv.Atoi(u.Gid)
+		cred.home = u.HomeDir
+		if groupIDs, err := u.GroupIds(); err == nil {
+			for _, groupID := range groupIDs {
+				if gid, err := strconv.Atoi(groupID); err == nil {
+					cred.groups = append(cred.groups, gid)
+				}
+			}
+		}
+	case isNumeric(parts[0]):
+		cred.uid, _ = strconv.Atoi(parts[0])
+	default:
+		return nil, errcode.ErrLookupInitUser.Wrap(fmt.Errorf("user %q: %w", parts[0], err))
+	}
+
+	if group != "" {
+		g, err := lookupGroup(group)
+		switch {
+		case err == nil:
+			cred.gid, _ = strconv.Atoi(g.Gid)
+		case isNumeric(group):
+			cred.gid, _ = strconv.Atoi(group)
+		default:
+			return nil, errcode.ErrLookupInitUser.Wrap(fmt.Errorf("group %q: %w", group, err))
+		}
+		// the supplementary groups only make sense with the primary group of the user
+		cred.groups = nil
+	}
+	if !containsInt(cred.groups, cred.gid) {
+		cred.groups = append(cred.groups, cred.gid)
+	}
+	return &cred, nil
+}
+
+func lookupUser(name string) (*user.User, error) {
+	if isNumeric(name) {
+		return user.LookupId(name)
+	}
+	return user.Lookup(name)
+}
+
+func lookupGroup(name string) (*user.Group, error) {
+	if isNumeric(name) {
+		return user.LookupGroupId(name)
+	}
+	return user.LookupGroup(name)
+}
+
+// chownFiles gives the content of a directory to a user, except the hooks and the files of pwinit
+func chownFiles(dir string, uid, gid int) error {
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return errcode.ErrChownInitFiles.Wrap(err)
+	}
+	for _, entry := range entries {
+		switch entry.Name() {
+		case onInitHook, onStartHook, onResetHook, onValidateHook, filepath.Base(configPath), filepath.Base(initializedPath):
+			continue
+		}
+		err := filepath.Walk(filepath.Join(dir, entry.Name()), func(path string, _ os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			return os.Lchown(path, uid, gid)
+		})
+		if err != nil {
+			return errcode.ErrChownInitFiles.Wrap(err)
+		}
+	}
+	return nil
+}
+
+// selfDestruct removes the pwinit binary and its directory, the running process is not affected
+func selfDestruct() error {
+	binary, err := os.Executable()
+	if err != nil {
+		binary = "/bin/pwinit"
+	}
+	for _, path := range []string{binary, pwinitDir} {
+		if err := os.RemoveAll(path); err != nil {
+			return errcode.ErrInitSelfDestruct.Wrap(err)
+		}
+	}
+	return nil
+}
+
+func isNumeric(s string) bool {
+	_, err := strconv.Atoi(s)
+	return err == nil
+}
+
+func containsInt(slice []int, value int) bool {
+	for _, item := range slice {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
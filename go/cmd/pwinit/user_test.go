@@ -0,0 +1,83 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pathwar.land/pathwar/v2/go/pkg/errcode"
+)
+
+func TestLookupCredentials(t *testing.T) {
+	tests := []struct {
+		name        string
+		spec        string
+		group       string
+		expected    *credentials
+		expectedErr error
+	}{
+		{"name", "root", "", &credentials{uid: 0, gid: 0, groups: []int{0}, home: "/root"}, nil},
+		{"uid", "0", "", &credentials{uid: 0, gid: 0, groups: []int{0}, home: "/root"}, nil},
+		{"uid-gid", "0:0", "", &credentials{uid: 0, gid: 0, groups: []int{0}, home: "/root"}, nil},
+		{"unknown-uid", "12345", "", &credentials{uid: 12345, gid: 0, groups: []int{0}}, nil},
+		{"unknown-uid-gid", "12345:12346", "", &credentials{uid: 12345, gid: 12346, groups: []int{12346}}, nil},
+		{"group-overrides-spec", "12345:12346", "12347", &credentials{uid: 12345, gid: 12347, groups: []int{12347}}, nil},
+		{"group-name", "12345", "root", &credentials{uid: 12345, gid: 0, groups: []int{0}}, nil},
+		{"unknown-name", "pathwar-unknown", "", nil, errcode.ErrLookupInitUser},
+		{"unknown-group-name", "root", "pathwar-unknown", nil, errcode.ErrLookupInitUser},
+	}
+	for _, test := range tests {
+		cred, err := lookupCredentials(test.spec, test.group)
+		assert.Equalf(t, errcode.Code(test.expectedErr), errcode.Code(err), "%s: %v", test.name, err)
+		if err != nil {
+			continue
+		}
+		assert.Equal(t, test.expected, cred, test.name)
+	}
+}
+
+func TestChownFiles(t *testing.T) {
+	cleanup := testingPwinitDir(t)
+	defer cleanup()
+
+	// a missing directory is ignored
+	assert.NoError(t, chownFiles(filepath.Join(pwinitDir, "missing"), os.Getuid(), os.Getgid()))
+
+	require.NoError(t, os.MkdirAll(filepath.Join(pwinitDir, "data", "sub"), 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(pwinitDir, "data", "sub", "file"), nil, 0644))
+	require.NoError(t, ioutil.WriteFile(hookPath(onInitHook), nil, 0755))
+	require.NoError(t, ioutil.WriteFile(configPath, []byte("{}"), 0600))
+
+	if os.Getuid() != 0 {
+		// giving the files to another user requires root
+		err := chownFiles(pwinitDir, os.Getuid()+1, os.Getgid())
+		assert.Equal(t, errcode.Code(errcode.ErrChownInitFiles), errcode.Code(err))
+		assert.NoError(t, chownFiles(pwinitDir, os.Getuid(), os.Getgid()))
+		return
+	}
+
+	require.NoError(t, chownFiles(pwinitDir, 12345, 12346))
+	for _, path := range []string{"data", "data/sub", "data/sub/file"} {
+		uid, gid := testingOwner(t, filepath.Join(pwinitDir, path))
+		assert.Equal(t, 12345, uid, path)
+		assert.Equal(t, 12346, gid, path)
+	}
+	// the hooks and the files of pwinit stay owned by root
+	for _, path := range []string{hookPath(onInitHook), configPath} {
+		uid, gid := testingOwner(t, path)
+		assert.Equal(t, 0, uid, path)
+		assert.Equal(t, 0, gid, path)
+	}
+}
+
+func testingOwner(t *testing.T, path string) (int, int) {
+	t.Helper()
+	info, err := os.Lstat(path)
+	require.NoError(t, err)
+	stat := info.Sys().(*syscall.Stat_t)
+	return int(stat.Uid), int(stat.Gid)
+}
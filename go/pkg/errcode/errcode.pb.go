@@ -77,6 +77,25 @@ const (
 	ErrCopyPWInitToContainer                 ErrCode = 3026
 	ErrComposeGetContainersInfo              ErrCode = 3027
 	ErrMissingPwinitConfig                   ErrCode = 3028
+	ErrComposeReadPullProgress               ErrCode = 3029
+	ErrComposePinImages                      ErrCode = 3030
+	ErrComposeLint                           ErrCode = 3031
+	ErrComposeCreateBundle                   ErrCode = 3032
+	ErrComposeInvalidBundle                  ErrCode = 3033
+	ErrComposeBundleHashMismatch             ErrCode = 3034
+	ErrComposeUnsignedBundle                 ErrCode = 3035
+	ErrComposeUntrustedBundle                ErrCode = 3036
+	ErrComposeReadKey                        ErrCode = 3037
+	ErrComposeUnsupportedKey                 ErrCode = 3038
+	ErrComposeNoSuchContainer                ErrCode = 3039
+	ErrComposeAmbiguousContainer             ErrCode = 3040
+	ErrComposeMissingSolver                  ErrCode = 3041
+	ErrComposeReadSolver                     ErrCode = 3042
+	ErrComposeSolverFailed                   ErrCode = 3043
+	ErrComposeReadPushProgress               ErrCode = 3044
+	ErrComposeRegistryAuth                   ErrCode = 3045
+	ErrComposeWatch                          ErrCode = 3046
+	ErrComposeHook                           ErrCode = 3047
 	ErrGetUserIDFromContext                  ErrCode = 4001
 	ErrMissingChallengeValidation            ErrCode = 4002
 	ErrInvalidSeason                         ErrCode = 4003
@@ -169,6 +188,21 @@ const (
 	ErrSeasonNameAlreadyExist                ErrCode = 4090
 	ErrNoAvailableChallengeInstance          ErrCode = 4091
 	ErrDeleteUserAccountTransactionCommit    ErrCode = 4092
+	ErrInvalidProxyPolicy                    ErrCode = 4093
+	ErrDrainAgent                            ErrCode = 4094
+	ErrChallengeRegister                     ErrCode = 4095
+	ErrInvalidListOptions                    ErrCode = 4096
+	ErrUpdateTeamScore                       ErrCode = 4097
+	ErrInvalidScoringCurve                   ErrCode = 4098
+	ErrUpdateTeamMedals                      ErrCode = 4099
+	ErrEvaluateAchievements                  ErrCode = 4100
+	ErrGetNotifications                      ErrCode = 4101
+	ErrMarkNotificationsRead                 ErrCode = 4102
+	ErrCreateNotification                    ErrCode = 4103
+	ErrReviewChallengeValidation             ErrCode = 4104
+	ErrChallengeValidationAlreadyReviewed    ErrCode = 4105
+	ErrSeasonAdd                             ErrCode = 4106
+	ErrSeasonSetScoring                      ErrCode = 4107
 	ErrServerListen                          ErrCode = 5001
 	ErrServerRegisterGateway                 ErrCode = 5002
 	ErrInitLogger                            ErrCode = 6001
@@ -215,6 +249,13 @@ const (
 	ErrUpPathwarInstance                     ErrCode = 7026
 	ErrUpdateNginx                           ErrCode = 7027
 	ErrAgentUpdateState                      ErrCode = 7028
+	ErrParseProxyPolicy                      ErrCode = 7029
+	ErrReadNginxConfigTemplate               ErrCode = 7030
+	ErrCollectThrottlingReports              ErrCode = 7031
+	ErrAgentPrepullImages                    ErrCode = 7032
+	ErrAgentGarbageCollect                   ErrCode = 7033
+	ErrAgentDrain                            ErrCode = 7034
+	ErrAgentPlay                             ErrCode = 7035
 	ErrDockerAPIContainerList                ErrCode = 8001
 	ErrDockerAPIContainerRemove              ErrCode = 8002
 	ErrDockerAPIImageRemove                  ErrCode = 8003
@@ -229,8 +270,23 @@ const (
 	ErrDockerAPINetworkCreate                ErrCode = 8012
 	ErrDockerAPINetworkRemove                ErrCode = 8013
 	ErrDockerAPIExitCode                     ErrCode = 8014
+	ErrDockerAPIImageList                    ErrCode = 8015
+	ErrDockerAPIVolumeList                   ErrCode = 8016
+	ErrDockerAPIVolumeRemove                 ErrCode = 8017
+	ErrDockerAPIContainerLogs                ErrCode = 8018
+	ErrDockerAPIContainerInspect             ErrCode = 8019
+	ErrDockerAPIContainerStart               ErrCode = 8020
+	ErrDockerAPIContainerWait                ErrCode = 8021
+	ErrDockerAPIImagePush                    ErrCode = 8022
 	ErrExecuteOnInitHook                     ErrCode = 9001
 	ErrRemoveInitConfig                      ErrCode = 9002
+	ErrLookupInitUser                        ErrCode = 9003
+	ErrDropInitPrivileges                    ErrCode = 9004
+	ErrChownInitFiles                        ErrCode = 9005
+	ErrInitSelfDestruct                      ErrCode = 9006
+	ErrRenderInitTemplate                    ErrCode = 9007
+	ErrExecuteInitHook                       ErrCode = 9008
+	ErrUnsupportedPWInitArch                 ErrCode = 9009
 )
 
 var ErrCode_name = map[int32]string{
@@ -286,6 +342,25 @@ var ErrCode_name = map[int32]string{
 	3026:  "ErrCopyPWInitToContainer",
 	3027:  "ErrComposeGetContainersInfo",
 	3028:  "ErrMissingPwinitConfig",
+	3029:  "ErrComposeReadPullProgress",
+	3030:  "ErrComposePinImages",
+	3031:  "ErrComposeLint",
+	3032:  "ErrComposeCreateBundle",
+	3033:  "ErrComposeInvalidBundle",
+	3034:  "ErrComposeBundleHashMismatch",
+	3035:  "ErrComposeUnsignedBundle",
+	3036:  "ErrComposeUntrustedBundle",
+	3037:  "ErrComposeReadKey",
+	3038:  "ErrComposeUnsupportedKey",
+	3039:  "ErrComposeNoSuchContainer",
+	3040:  "ErrComposeAmbiguousContainer",
+	3041:  "ErrComposeMissingSolver",
+	3042:  "ErrComposeReadSolver",
+	3043:  "ErrComposeSolverFailed",
+	3044:  "ErrComposeReadPushProgress",
+	3045:  "ErrComposeRegistryAuth",
+	3046:  "ErrComposeWatch",
+	3047:  "ErrComposeHook",
 	4001:  "ErrGetUserIDFromContext",
 	4002:  "ErrMissingChallengeValidation",
 	4003:  "ErrInvalidSeason",
@@ -378,6 +453,21 @@ var ErrCode_name = map[int32]string{
 	4090:  "ErrSeasonNameAlreadyExist",
 	4091:  "ErrNoAvailableChallengeInstance",
 	4092:  "ErrDeleteUserAccountTransactionCommit",
+	4093:  "ErrInvalidProxyPolicy",
+	4094:  "ErrDrainAgent",
+	4095:  "ErrChallengeRegister",
+	4096:  "ErrInvalidListOptions",
+	4097:  "ErrUpdateTeamScore",
+	4098:  "ErrInvalidScoringCurve",
+	4099:  "ErrUpdateTeamMedals",
+	4100:  "ErrEvaluateAchievements",
+	4101:  "ErrGetNotifications",
+	4102:  "ErrMarkNotificationsRead",
+	4103:  "ErrCreateNotification",
+	4104:  "ErrReviewChallengeValidation",
+	4105:  "ErrChallengeValidationAlreadyReviewed",
+	4106:  "ErrSeasonAdd",
+	4107:  "ErrSeasonSetScoring",
 	5001:  "ErrServerListen",
 	5002:  "ErrServerRegisterGateway",
 	6001:  "ErrInitLogger",
@@ -424,6 +514,13 @@ var ErrCode_name = map[int32]string{
 	7026:  "ErrUpPathwarInstance",
 	7027:  "ErrUpdateNginx",
 	7028:  "ErrAgentUpdateState",
+	7029:  "ErrParseProxyPolicy",
+	7030:  "ErrReadNginxConfigTemplate",
+	7031:  "ErrCollectThrottlingReports",
+	7032:  "ErrAgentPrepullImages",
+	7033:  "ErrAgentGarbageCollect",
+	7034:  "ErrAgentDrain",
+	7035:  "ErrAgentPlay",
 	8001:  "ErrDockerAPIContainerList",
 	8002:  "ErrDockerAPIContainerRemove",
 	8003:  "ErrDockerAPIImageRemove",
@@ -438,8 +535,23 @@ var ErrCode_name = map[int32]string{
 	8012:  "ErrDockerAPINetworkCreate",
 	8013:  "ErrDockerAPINetworkRemove",
 	8014:  "ErrDockerAPIExitCode",
+	8015:  "ErrDockerAPIImageList",
+	8016:  "ErrDockerAPIVolumeList",
+	8017:  "ErrDockerAPIVolumeRemove",
+	8018:  "ErrDockerAPIContainerLogs",
+	8019:  "ErrDockerAPIContainerInspect",
+	8020:  "ErrDockerAPIContainerStart",
+	8021:  "ErrDockerAPIContainerWait",
+	8022:  "ErrDockerAPIImagePush",
 	9001:  "ErrExecuteOnInitHook",
 	9002:  "ErrRemoveInitConfig",
+	9003:  "ErrLookupInitUser",
+	9004:  "ErrDropInitPrivileges",
+	9005:  "ErrChownInitFiles",
+	9006:  "ErrInitSelfDestruct",
+	9007:  "ErrRenderInitTemplate",
+	9008:  "ErrExecuteInitHook",
+	9009:  "ErrUnsupportedPWInitArch",
 }
 
 var ErrCode_value = map[string]int32{
@@ -495,6 +607,25 @@ var ErrCode_value = map[string]int32{
 	"ErrCopyPWInitToContainer":                 3026,
 	"ErrComposeGetContainersInfo":              3027,
 	"ErrMissingPwinitConfig":                   3028,
+	"ErrComposeReadPullProgress":               3029,
+	"ErrComposePinImages":                      3030,
+	"ErrComposeLint":                           3031,
+	"ErrComposeCreateBundle":                   3032,
+	"ErrComposeInvalidBundle":                  3033,
+	"ErrComposeBundleHashMismatch":             3034,
+	"ErrComposeUnsignedBundle":                 3035,
+	"ErrComposeUntrustedBundle":                3036,
+	"ErrComposeReadKey":                        3037,
+	"ErrComposeUnsupportedKey":                 3038,
+	"ErrComposeNoSuchContainer":                3039,
+	"ErrComposeAmbiguousContainer":             3040,
+	"ErrComposeMissingSolver":                  3041,
+	"ErrComposeReadSolver":                     3042,
+	"ErrComposeSolverFailed":                   3043,
+	"ErrComposeReadPushProgress":               3044,
+	"ErrComposeRegistryAuth":                   3045,
+	"ErrComposeWatch":                          3046,
+	"ErrComposeHook":                           3047,
 	"ErrGetUserIDFromContext":                  4001,
 	"ErrMissingChallengeValidation":            4002,
 	"ErrInvalidSeason":                         4003,
@@ -587,6 +718,21 @@ var ErrCode_value = map[string]int32{
 	"ErrSeasonNameAlreadyExist":                4090,
 	"ErrNoAvailableChallengeInstance":          4091,
 	"ErrDeleteUserAccountTransactionCommit":    4092,
+	"ErrInvalidProxyPolicy":                    4093,
+	"ErrDrainAgent":                            4094,
+	"ErrChallengeRegister":                     4095,
+	"ErrInvalidListOptions":                    4096,
+	"ErrUpdateTeamScore":                       4097,
+	"ErrInvalidScoringCurve":                   4098,
+	"ErrUpdateTeamMedals":                      4099,
+	"ErrEvaluateAchievements":                  4100,
+	"ErrGetNotifications":                      4101,
+	"ErrMarkNotificationsRead":                 4102,
+	"ErrCreateNotification":                    4103,
+	"ErrReviewChallengeValidation":             4104,
+	"ErrChallengeValidationAlreadyReviewed":    4105,
+	"ErrSeasonAdd":                             4106,
+	"ErrSeasonSetScoring":                      4107,
 	"ErrServerListen":                          5001,
 	"ErrServerRegisterGateway":                 5002,
 	"ErrInitLogger":                            6001,
@@ -633,6 +779,13 @@ var ErrCode_value = map[string]int32{
 	"ErrUpPathwarInstance":                     7026,
 	"ErrUpdateNginx":                           7027,
 	"ErrAgentUpdateState":                      7028,
+	"ErrParseProxyPolicy":                      7029,
+	"ErrReadNginxConfigTemplate":               7030,
+	"ErrCollectThrottlingReports":              7031,
+	"ErrAgentPrepullImages":                    7032,
+	"ErrAgentGarbageCollect":                   7033,
+	"ErrAgentDrain":                            7034,
+	"ErrAgentPlay":                             7035,
 	"ErrDockerAPIContainerList":                8001,
 	"ErrDockerAPIContainerRemove":              8002,
 	"ErrDockerAPIImageRemove":                  8003,
@@ -647,8 +800,23 @@ var ErrCode_value = map[string]int32{
 	"ErrDockerAPINetworkCreate":                8012,
 	"ErrDockerAPINetworkRemove":                8013,
 	"ErrDockerAPIExitCode":                     8014,
+	"ErrDockerAPIImageList":                    8015,
+	"ErrDockerAPIVolumeList":                   8016,
+	"ErrDockerAPIVolumeRemove":                 8017,
+	"ErrDockerAPIContainerLogs":                8018,
+	"ErrDockerAPIContainerInspect":             8019,
+	"ErrDockerAPIContainerStart":               8020,
+	"ErrDockerAPIContainerWait":                8021,
+	"ErrDockerAPIImagePush":                    8022,
 	"ErrExecuteOnInitHook":                     9001,
 	"ErrRemoveInitConfig":                      9002,
+	"ErrLookupInitUser":                        9003,
+	"ErrDropInitPrivileges":                    9004,
+	"ErrChownInitFiles":                        9005,
+	"ErrInitSelfDestruct":                      9006,
+	"ErrRenderInitTemplate":                    9007,
+	"ErrExecuteInitHook":                       9008,
+	"ErrUnsupportedPWInitArch":                 9009,
 }
 
 func (x ErrCode) String() string {
@@ -666,164 +834,199 @@ func init() {
 func init() { proto.RegisterFile("errcode.proto", fileDescriptor_4240057316120df7) }
 
 var fileDescriptor_4240057316120df7 = []byte{
-	// 2532 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x58, 0x47, 0x70, 0x23, 0xc7,
-	0xd5, 0xde, 0xad, 0xfa, 0x7f, 0xb1, 0x34, 0xb6, 0xc4, 0xa7, 0x91, 0xb4, 0x50, 0xe4, 0x28, 0x58,
-	0x5a, 0x95, 0x2c, 0x81, 0x07, 0x57, 0xa1, 0xca, 0x17, 0x56, 0x01, 0x04, 0xc8, 0x85, 0xb5, 0x0b,
-	0xb2, 0x08, 0x52, 0x5b, 0xe5, 0x5b, 0x73, 0xe6, 0x61, 0xd0, 0xe6, 0xa0, 0x1b, 0xea, 0xe9, 0x61,
-	0xf0, 0xc9, 0x57, 0xf9, 0xe4, 0xb3, 0x6f, 0xce, 0x96, 0x9c, 0xb3, 0x95, 0xb3, 0xb4, 0xca, 0xab,
-	0x9c, 0xd3, 0xae, 0xe2, 0x2a, 0xe7, 0xe8, 0x50, 0x9d, 0x06, 0x33, 0xe0, 0xae, 0x6f, 0xc0, 0x4b,
-	0xfd, 0xde, 0xf7, 0x42, 0xf7, 0x1b, 0xef, 0x04, 0x14, 0x22, 0xe4, 0x11, 0x56, 0x87, 0x82, 0x4b,
-	0xee, 0x4f, 0x0e, 0x89, 0xec, 0x6f, 0x10, 0x51, 0xb5, 0xe4, 0x33, 0x2e, 0x8d, 0xa9, 0xec, 0x67,
-	0xab, 0xd5, 0x90, 0x0f, 0xa6, 0x63, 0x1e, 0xf3, 0x69, 0x2d, 0xb7, 0x9a, 0xf5, 0xf4, 0x3f, 0xfd,
-	0x47, 0xff, 0x32, 0xfa, 0x17, 0x3f, 0x77, 0x89, 0x37, 0xd1, 0x12, 0x62, 0x96, 0x47, 0xe8, 0x9f,
-	0xe0, 0x1d, 0xbf, 0xc2, 0x22, 0xec, 0x51, 0x86, 0x11, 0xec, 0xf0, 0x8f, 0xf7, 0xfe, 0x6f, 0x79,
-	0xa1, 0xb9, 0x00, 0x3f, 0xfe, 0x7f, 0x7f, 0x97, 0x77, 0x52, 0x4b, 0x88, 0x0e, 0x97, 0xed, 0xc1,
-	0x30, 0xc1, 0x01, 0x32, 0x89, 0x11, 0x5c, 0x79, 0x9c, 0xef, 0x7b, 0x27, 0xb4, 0x84, 0x68, 0xe2,
-	0x50, 0x60, 0x48, 0x14, 0xed, 0xb3, 0xe3, 0x7c, 0xf0, 0xbe, 0xd6, 0x12, 0xa2, 0xcd, 0x24, 0x0a,
-	0x46, 0x12, 0x78, 0x6d, 0xc2, 0x3f, 0xd9, 0x9b, 0xd4, 0x94, 0x75, 0x92, 0xd0, 0xa8, 0xcd, 0x86,
-	0x99, 0x04, 0xb4, 0xc4, 0x7d, 0x34, 0x4d, 0x29, 0x8b, 0x0d, 0xb1, 0xe7, 0xef, 0xf2, 0xfc, 0x96,
-	0x10, 0x2b, 0x8c, 0x64, 0xb2, 0x8f, 0x4c, 0x52, 0x63, 0x34, 0xf6, 0x4f, 0xd5, 0xe7, 0x2f, 0x61,
-	0x2a, 0x05, 0x0d, 0x25, 0x46, 0x75, 0x81, 0x04, 0xfa, 0xf6, 0xf8, 0x6e, 0x77, 0x61, 0x1e, 0xe5,
-	0x42, 0xbb, 0x39, 0x0b, 0x6f, 0x4c, 0xf8, 0x67, 0x7a, 0xbb, 0x0c, 0xcd, 0x9e, 0xb7, 0x98, 0xad,
-	0x26, 0x34, 0xbc, 0x0c, 0xb7, 0xe0, 0xc8, 0x84, 0x7f, 0x8e, 0x77, 0xa6, 0x61, 0xce, 0x11, 0x9a,
-	0x60, 0x74, 0x19, 0x6e, 0x85, 0x09, 0x27, 0x6b, 0x4b, 0x78, 0x45, 0x86, 0xa9, 0x84, 0x37, 0x27,
-	0xfc, 0xf3, 0xbc, 0xb3, 0x4b, 0xea, 0x23, 0x91, 0x74, 0xc8, 0x59, 0x8a, 0xf0, 0xd6, 0x84, 0x7f,
-	0x92, 0xf7, 0x75, 0x23, 0xb3, 0x97, 0xc7, 0x3c, 0x93, 0xf0, 0xf6, 0x84, 0x7f, 0xb6, 0x77, 0x9a,
-	0x53, 0xa3, 0xd2, 0xe9, 0xcc, 0x26, 0x14, 0x99, 0x84, 0x77, 0x26, 0xfc, 0xd3, 0xbc, 0x93, 0x4b,
-	0x56, 0x1b, 0x48, 0x04, 0x0a, 0x78, 0xb7, 0xc0, 0x71, 0x4a, 0x2d, 0x21, 0xb8, 0x80, 0xf7, 0x26,
-	0x1c, 0xb6, 0x8d, 0x0e, 0x97, 0x73, 0x3c, 0x63, 0x11, 0x3c, 0x34, 0x99, 0xd3, 0x72, 0x74, 0x1f,
-	0x9e, 0xf4, 0x2b, 0x1a, 0xb3, 0x66, 0x63, 0x29, 0x63, 0xfb, 0x68, 0x2c, 0x88, 0xa4, 0x9c, 0xa5,
-	0xf0, 0xc8, 0xa4, 0x7f, 0xa2, 0x77, 0xbc, 0x15, 0xa6, 0x12, 0x1e, 0x9d, 0xb4, 0x6e, 0x37, 0x1b,
-	0xb3, 0x9c, 0x31, 0x0c, 0x25, 0x3c, 0x36, 0xe9, 0x9f, 0xea, 0x81, 0x26, 0xd5, 0x33, 0xc9, 0x8d,
-	0x32, 0xc2, 0xe3, 0x23, 0x93, 0xf5, 0x28, 0x9a, 0xe3, 0x02, 0x69, 0xcc, 0x14, 0x7e, 0x4f, 0x4c,
-	0xfa, 0x67, 0x78, 0xa7, 0xea, 0x62, 0x19, 0x0c, 0x79, 0x8a, 0x0e, 0x60, 0x22, 0xfb, 0x70, 0x4d,
-	0xc5, 0x62, 0x6b, 0x79, 0x4d, 0x2a, 0x30, 0x94, 0x5c, 0x6c, 0xe5, 0xde, 0x5f, 0x5b, 0xf1, 0x4f,
-	0xf7, 0x4e, 0x19, 0x49, 0x2c, 0x21, 0x89, 0x66, 0x39, 0xeb, 0xd1, 0x18, 0xae, 0xab, 0xf8, 0x67,
-	0x79, 0x95, 0x6d, 0x86, 0x2d, 0xf7, 0xfa, 0x31, 0xee, 0x3e, 0x22, 0xd2, 0x3e, 0x49, 0x2c, 0xf7,
-	0x86, 0x8a, 0xc5, 0xde, 0x72, 0x67, 0x05, 0x12, 0x89, 0xcb, 0x38, 0x18, 0xce, 0xd1, 0x04, 0xe1,
-	0xc6, 0x31, 0xe5, 0xfd, 0x82, 0x16, 0xb8, 0x37, 0x8d, 0x71, 0x67, 0x13, 0x9e, 0x8e, 0xb8, 0x37,
-	0x57, 0xfc, 0x53, 0x74, 0x91, 0x5a, 0x6e, 0x23, 0xa3, 0x49, 0x04, 0xb7, 0x54, 0xfc, 0x5d, 0x1a,
-	0xb5, 0x9c, 0xca, 0xa2, 0x04, 0xe1, 0xda, 0x23, 0x3b, 0x6d, 0x97, 0x14, 0xe2, 0x6b, 0x92, 0x55,
-	0xb8, 0xad, 0x62, 0xe1, 0xb4, 0xf4, 0x45, 0x22, 0x52, 0x54, 0x8c, 0xdb, 0x2b, 0x65, 0x38, 0x35,
-	0xc3, 0x46, 0x75, 0xc7, 0xb8, 0x63, 0x79, 0x54, 0x4d, 0x2a, 0xe0, 0xce, 0xb1, 0x98, 0x57, 0x86,
-	0x51, 0x31, 0xe6, 0xbb, 0xc6, 0x72, 0x31, 0xc7, 0x45, 0x88, 0x4b, 0x18, 0x6a, 0x1b, 0x4d, 0xbe,
-	0xc1, 0xe0, 0x40, 0xc5, 0xd6, 0x9d, 0xf3, 0x35, 0x63, 0xe6, 0x04, 0xb8, 0x7b, 0x2c, 0xe6, 0xa5,
-	0x8c, 0xad, 0x0c, 0xe1, 0x1e, 0x17, 0xc3, 0x3c, 0xca, 0xc5, 0xfd, 0xaa, 0x9e, 0x1a, 0x94, 0x11,
-	0xb1, 0x05, 0xf7, 0x3a, 0x4f, 0x34, 0xae, 0x86, 0xa5, 0x7c, 0xd8, 0x83, 0x24, 0x42, 0x01, 0xf7,
-	0x39, 0xbd, 0x31, 0x36, 0xdc, 0x5f, 0xf1, 0x03, 0xef, 0x0c, 0xd5, 0xff, 0x26, 0x99, 0x86, 0x65,
-	0x82, 0xd7, 0x02, 0x0f, 0x54, 0xfc, 0xf3, 0xbd, 0xa9, 0xb2, 0xe6, 0x88, 0x6d, 0xcd, 0x3f, 0x78,
-	0x94, 0xd3, 0x0b, 0x36, 0x0e, 0x56, 0xfc, 0x73, 0xbd, 0xb3, 0xc6, 0xd8, 0x3a, 0xc3, 0xc4, 0x90,
-	0x04, 0x3c, 0x34, 0x42, 0x72, 0xb8, 0x65, 0x24, 0x96, 0xf9, 0x2c, 0x67, 0x92, 0x50, 0x86, 0x02,
-	0x1e, 0x1e, 0x43, 0x72, 0x1e, 0x65, 0xce, 0x4c, 0xdb, 0xac, 0xc7, 0xe1, 0x91, 0x8a, 0x1d, 0x38,
-	0x76, 0x90, 0x2d, 0x6e, 0xd0, 0xdc, 0x09, 0x78, 0xd4, 0x65, 0x71, 0x1e, 0xe5, 0x4a, 0x8a, 0xa2,
-	0xdd, 0x9c, 0x13, 0x7c, 0xa0, 0x2c, 0xe0, 0xa6, 0x84, 0x9f, 0x04, 0x76, 0xd8, 0x58, 0xd5, 0xd9,
-	0x3e, 0x49, 0x12, 0x64, 0x31, 0x5e, 0xae, 0x8a, 0x5f, 0xb7, 0x31, 0xfc, 0x34, 0xb0, 0x2d, 0x6a,
-	0x5b, 0xa2, 0x8b, 0x24, 0xe5, 0x0c, 0x7e, 0x16, 0x58, 0x5c, 0x97, 0x91, 0x0c, 0xd4, 0x54, 0x66,
-	0x96, 0xf1, 0xf3, 0xc0, 0x3a, 0xac, 0x3c, 0x75, 0xf6, 0xba, 0xd9, 0x6a, 0x1a, 0x0a, 0x3a, 0xd4,
-	0x16, 0x7f, 0x31, 0xb2, 0x48, 0x65, 0x97, 0xf1, 0x8d, 0x5e, 0x42, 0xd6, 0x10, 0x7e, 0x19, 0x58,
-	0xbc, 0x4d, 0x2d, 0x1d, 0x5d, 0xf7, 0x57, 0x81, 0x05, 0xd4, 0x14, 0xcb, 0xd1, 0x1c, 0xfe, 0x75,
-	0xe0, 0x4f, 0x79, 0xa7, 0x8f, 0x39, 0x50, 0xe0, 0x5f, 0x15, 0xf8, 0x27, 0x7b, 0x27, 0x8e, 0x02,
-	0x52, 0x01, 0xc0, 0xd5, 0x0e, 0x89, 0x5c, 0xa3, 0x9e, 0x08, 0x24, 0xd1, 0x96, 0x3d, 0x7d, 0x15,
-	0x23, 0xf8, 0x8d, 0x73, 0x70, 0xec, 0xec, 0x92, 0x83, 0xbf, 0x0d, 0xec, 0x8c, 0x99, 0xa3, 0x2c,
-	0x5a, 0x10, 0x31, 0x61, 0xf4, 0xfb, 0x76, 0x1e, 0xfe, 0x2e, 0xf0, 0xbf, 0xe1, 0x05, 0xc6, 0x31,
-	0x03, 0x96, 0xca, 0x85, 0xf9, 0x95, 0x1b, 0x83, 0xdf, 0x07, 0xb6, 0x1e, 0x6c, 0xc6, 0x94, 0x7b,
-	0x23, 0x39, 0xf8, 0x83, 0xc3, 0xbd, 0x94, 0x8e, 0x76, 0x13, 0xfe, 0xe8, 0xc2, 0x56, 0x4a, 0x7b,
-	0x48, 0xda, 0xe1, 0x5a, 0x93, 0x0b, 0xab, 0xf8, 0xa7, 0xc0, 0x96, 0x49, 0x7e, 0x7a, 0x7e, 0x66,
-	0x0a, 0x7f, 0x0e, 0xec, 0x68, 0xce, 0x99, 0xf0, 0x97, 0xc0, 0xb6, 0xa1, 0xf9, 0xdf, 0x44, 0x46,
-	0x31, 0x82, 0xbf, 0x06, 0xb6, 0x6b, 0x2c, 0x3c, 0x7b, 0x48, 0x5a, 0x3e, 0xe6, 0x6f, 0x4e, 0x6d,
-	0x09, 0x53, 0x14, 0xeb, 0x18, 0x75, 0xc8, 0x00, 0xe1, 0xef, 0x39, 0x74, 0x7d, 0x0c, 0xd7, 0x8a,
-	0xb0, 0xac, 0x30, 0x7a, 0x45, 0x86, 0x5a, 0xe8, 0x1f, 0x81, 0x9b, 0x46, 0x1a, 0xdf, 0xa2, 0x14,
-	0xfc, 0x33, 0xf0, 0xbf, 0xe9, 0x5d, 0xd8, 0x12, 0xa2, 0x48, 0x3d, 0x96, 0x0f, 0xd7, 0x04, 0xa3,
-	0x59, 0x51, 0xb2, 0x72, 0xad, 0x3b, 0x61, 0x3b, 0x06, 0x70, 0x5d, 0xe0, 0x5f, 0xea, 0x5d, 0xa4,
-	0x4e, 0x27, 0x8c, 0x71, 0xe9, 0xc6, 0x9d, 0xb6, 0x3b, 0x9f, 0xf0, 0x55, 0x92, 0x94, 0x4c, 0x5d,
-	0xef, 0xd2, 0xa4, 0xe0, 0xd6, 0xf5, 0x5f, 0x62, 0xdf, 0x10, 0xd8, 0x8b, 0x72, 0x64, 0x07, 0x6e,
-	0x0c, 0xfc, 0x49, 0xcf, 0x33, 0xa7, 0x6b, 0xc2, 0x4d, 0x81, 0x7d, 0xa9, 0x58, 0x42, 0x0a, 0x37,
-	0x17, 0x44, 0x94, 0x61, 0xb8, 0xc5, 0xd9, 0x31, 0x4d, 0xa1, 0x69, 0xb7, 0x96, 0x69, 0xda, 0xd4,
-	0x6d, 0x2e, 0x32, 0x43, 0x2b, 0xf9, 0x72, 0xbb, 0x2b, 0xc9, 0x0e, 0x6e, 0x28, 0x03, 0x7a, 0x02,
-	0x24, 0x84, 0x0e, 0x52, 0xb8, 0xc3, 0x65, 0x4b, 0x21, 0x55, 0xcf, 0x64, 0x5f, 0x1f, 0x70, 0x67,
-	0xe0, 0x5f, 0xe2, 0xed, 0x56, 0xd7, 0x2f, 0xed, 0xf5, 0x50, 0x20, 0xd3, 0xbe, 0x34, 0x50, 0x6e,
-	0x20, 0xb2, 0x65, 0xbe, 0x86, 0xac, 0xce, 0xa2, 0x26, 0x91, 0x64, 0x95, 0xa4, 0x08, 0x77, 0x39,
-	0xb4, 0xf7, 0x72, 0x12, 0x29, 0x41, 0x83, 0x6c, 0x0a, 0x07, 0x82, 0xf2, 0xec, 0x29, 0x77, 0xc3,
-	0xdd, 0x2e, 0x8a, 0x3c, 0x17, 0x29, 0xdc, 0x13, 0xd8, 0x4b, 0xc1, 0x6a, 0x34, 0x54, 0xfb, 0x7d,
-	0x4f, 0x3d, 0x14, 0xee, 0x75, 0x75, 0xd7, 0x1a, 0x10, 0x9a, 0xd4, 0xa3, 0x48, 0x60, 0x9a, 0x76,
-	0xb8, 0xbc, 0x1c, 0x05, 0xed, 0xa9, 0xc2, 0xbc, 0xaf, 0xa0, 0xda, 0xc4, 0x1e, 0xc9, 0x12, 0x57,
-	0xc8, 0xf7, 0x07, 0xa3, 0xab, 0x6a, 0x40, 0x4d, 0x4f, 0x09, 0xc2, 0x52, 0x12, 0x6a, 0x74, 0x1e,
-	0x28, 0x23, 0x57, 0x0f, 0x25, 0x5d, 0x47, 0xab, 0xfa, 0xa0, 0xeb, 0x29, 0x37, 0x1f, 0xcd, 0xdc,
-	0xdc, 0x87, 0x92, 0x44, 0x44, 0x12, 0x38, 0xe8, 0x42, 0xef, 0x70, 0x0d, 0xcb, 0xa2, 0xe0, 0xeb,
-	0x34, 0xc2, 0x08, 0x1e, 0x2a, 0x14, 0x9a, 0xe6, 0xec, 0xa7, 0xb2, 0x6f, 0x31, 0x7f, 0xd8, 0x79,
-	0x6a, 0x95, 0xda, 0xcc, 0x8d, 0xe3, 0x47, 0x8a, 0x2d, 0x6a, 0x02, 0x57, 0xb9, 0xd2, 0x52, 0xf0,
-	0x68, 0x61, 0x2e, 0x14, 0x98, 0x4e, 0xf7, 0x31, 0x37, 0x18, 0xe7, 0x51, 0x16, 0x63, 0xd8, 0x87,
-	0x83, 0x55, 0x14, 0x69, 0x9f, 0x0e, 0xe1, 0xf1, 0x82, 0x79, 0x6d, 0xb3, 0xa8, 0xff, 0x84, 0x0b,
-	0x75, 0x7c, 0x00, 0xea, 0xeb, 0x2a, 0x82, 0x27, 0x0b, 0xb5, 0x5a, 0x8f, 0xd5, 0x9b, 0xf2, 0x29,
-	0x37, 0x33, 0xba, 0x64, 0x1d, 0x0d, 0xe9, 0x69, 0x67, 0x64, 0x2f, 0x4d, 0x47, 0xb3, 0xb7, 0xcd,
-	0x52, 0x49, 0x58, 0x88, 0x29, 0x3c, 0xe3, 0xca, 0x6d, 0x74, 0x48, 0x14, 0xc1, 0xb3, 0x81, 0x7f,
-	0x91, 0x77, 0xbe, 0x4e, 0x50, 0x36, 0xcc, 0xbb, 0xda, 0x4e, 0x6c, 0x8c, 0x1a, 0x5b, 0x5d, 0x32,
-	0x30, 0x55, 0xfe, 0x9c, 0xbb, 0x39, 0x8c, 0x64, 0x6b, 0x73, 0x48, 0x05, 0x46, 0xf0, 0x7c, 0x90,
-	0xbf, 0x7b, 0x14, 0x39, 0x7f, 0xef, 0xbd, 0xe0, 0x8a, 0x46, 0xe5, 0xbc, 0xc9, 0x51, 0x15, 0x4c,
-	0x03, 0x13, 0xce, 0xe2, 0x65, 0x3d, 0x1c, 0xe1, 0xc5, 0xd1, 0x4d, 0x44, 0x34, 0x66, 0x26, 0x8c,
-	0x97, 0xf2, 0x41, 0xe4, 0xdc, 0x9c, 0x4b, 0xc8, 0x3a, 0x17, 0xca, 0xd9, 0x43, 0xae, 0xa8, 0xb7,
-	0x85, 0xa7, 0xb8, 0x87, 0x47, 0x73, 0x2e, 0xe7, 0x1a, 0xcb, 0x85, 0x0b, 0xe8, 0xe5, 0xc0, 0xbf,
-	0xc0, 0x3b, 0xa7, 0x2c, 0x14, 0x72, 0xb5, 0xd5, 0xc8, 0xa2, 0xd8, 0x2b, 0x81, 0xbf, 0xdb, 0x3b,
-	0xaf, 0x28, 0xf6, 0x9d, 0xee, 0x42, 0xc7, 0xbd, 0x56, 0x48, 0x9a, 0x0e, 0xfb, 0x82, 0xa4, 0x98,
-	0xc2, 0xab, 0x2e, 0x8a, 0x0e, 0x97, 0x2d, 0xc6, 0xb3, 0xb8, 0x3f, 0x4b, 0xd2, 0x3e, 0xbc, 0xe6,
-	0x50, 0x51, 0xc9, 0xd0, 0x25, 0x41, 0x25, 0xc5, 0x14, 0x5e, 0x77, 0x79, 0x53, 0x74, 0x85, 0x4c,
-	0x0a, 0x6f, 0x14, 0x45, 0x0b, 0xd7, 0xc2, 0x11, 0x37, 0x39, 0x14, 0xbd, 0xdc, 0xbe, 0x6f, 0x16,
-	0xad, 0x98, 0xe1, 0xf5, 0x96, 0xbb, 0x43, 0x4b, 0x56, 0x8a, 0xb7, 0x63, 0x0a, 0x6f, 0xbb, 0xcb,
-	0x57, 0xcb, 0xe8, 0x74, 0xa5, 0xf0, 0x8e, 0x1b, 0x05, 0xda, 0x53, 0x95, 0x82, 0x14, 0xde, 0x75,
-	0xf6, 0xeb, 0x51, 0x64, 0xe4, 0xe0, 0x3d, 0x17, 0xe7, 0x0a, 0x5b, 0x63, 0x7c, 0x83, 0x35, 0x1b,
-	0x97, 0x51, 0x16, 0xc1, 0xfb, 0x4e, 0xbb, 0xc3, 0xbb, 0x59, 0xd8, 0xef, 0x26, 0x59, 0x0c, 0x1f,
-	0x38, 0xd1, 0xfa, 0x60, 0x95, 0xc6, 0x19, 0xcf, 0x52, 0x4d, 0xfe, 0xd0, 0x25, 0x76, 0x6c, 0xf8,
-	0xab, 0xd4, 0x7d, 0x34, 0xf6, 0xce, 0x31, 0x29, 0x87, 0x8f, 0x5d, 0xb7, 0xaa, 0x18, 0x6d, 0x0d,
-	0xb5, 0x36, 0x69, 0x2a, 0xe1, 0x13, 0x57, 0xcc, 0x1d, 0xae, 0x01, 0x58, 0xd8, 0x50, 0xef, 0xb5,
-	0x4f, 0x5d, 0x7d, 0xd8, 0x32, 0x6e, 0xb3, 0x75, 0x2a, 0x31, 0x6a, 0x33, 0x5d, 0x70, 0x9f, 0x39,
-	0x40, 0x2d, 0x57, 0x11, 0x4d, 0x87, 0xc2, 0xe7, 0xae, 0x77, 0x8c, 0x6f, 0xea, 0x46, 0xb4, 0x42,
-	0xe6, 0xb8, 0x2f, 0xdc, 0xeb, 0xa1, 0xc3, 0xeb, 0xeb, 0x84, 0x26, 0x64, 0x35, 0xc1, 0x6d, 0x35,
-	0x08, 0x5f, 0x06, 0xfe, 0xc5, 0xde, 0x05, 0x7a, 0x21, 0x56, 0xe5, 0xa4, 0xd2, 0x5b, 0x0f, 0x43,
-	0x9e, 0x31, 0x59, 0x98, 0x79, 0x66, 0x10, 0xc2, 0x57, 0xa3, 0x1b, 0x5e, 0xac, 0xa3, 0x06, 0x1f,
-	0x19, 0x5c, 0xb9, 0xdb, 0x6d, 0x92, 0x9a, 0xba, 0x84, 0xb1, 0xa2, 0x8b, 0x79, 0x22, 0x71, 0x83,
-	0x6c, 0xc1, 0x0f, 0x77, 0x5b, 0xb4, 0xd5, 0xe3, 0x6d, 0x2f, 0x8f, 0x63, 0x14, 0xf0, 0x7e, 0xd5,
-	0x19, 0x92, 0x44, 0x48, 0xa5, 0x47, 0x43, 0x84, 0x0f, 0xaa, 0x05, 0x49, 0x63, 0x0c, 0x3e, 0xac,
-	0xba, 0x9b, 0x59, 0xf0, 0x6c, 0xb8, 0x8c, 0x62, 0x40, 0x99, 0xde, 0xaf, 0x3f, 0xaa, 0x16, 0xa6,
-	0x5b, 0x77, 0xc1, 0xac, 0xad, 0x6a, 0x3e, 0xcd, 0x25, 0x24, 0x4e, 0xe1, 0x63, 0x77, 0x42, 0x33,
-	0x1b, 0x0c, 0xf3, 0x9b, 0xe7, 0x93, 0xea, 0xe8, 0xd5, 0xa2, 0x76, 0xcc, 0x1e, 0x87, 0x4f, 0xab,
-	0xa3, 0x0b, 0xad, 0xdb, 0x5d, 0xd8, 0xdf, 0xe7, 0x64, 0x40, 0xe1, 0xb3, 0x32, 0xd5, 0xee, 0xcc,
-	0x9f, 0x97, 0xa9, 0x76, 0x3c, 0x7f, 0x51, 0xb5, 0x09, 0x57, 0x6e, 0x37, 0x79, 0xb8, 0x86, 0xc2,
-	0x2e, 0xd1, 0x5f, 0x56, 0xed, 0x3e, 0xab, 0x39, 0x0d, 0xf8, 0xaa, 0x6a, 0x6b, 0xd9, 0xbc, 0xb5,
-	0x33, 0x81, 0xcd, 0x06, 0xfc, 0xab, 0x5a, 0x7c, 0xdc, 0xba, 0x48, 0xe0, 0xdf, 0xd5, 0xfc, 0xd1,
-	0x49, 0x73, 0x84, 0xfe, 0x53, 0x44, 0x68, 0x59, 0x90, 0x10, 0x05, 0xfc, 0x60, 0xda, 0x96, 0x81,
-	0xee, 0x83, 0xed, 0xaf, 0xfd, 0xa7, 0x6a, 0x76, 0x88, 0xeb, 0x97, 0x54, 0x27, 0xa6, 0x6c, 0x73,
-	0xb4, 0x2c, 0x3c, 0x5d, 0xb3, 0xc5, 0xb7, 0x84, 0x03, 0xbe, 0x8e, 0x63, 0xdc, 0x67, 0x9c, 0xaa,
-	0xde, 0x22, 0xc7, 0x98, 0xcf, 0x3a, 0xa6, 0xce, 0xe1, 0x18, 0xf3, 0xb9, 0x9a, 0x4d, 0x9b, 0x5a,
-	0x10, 0x29, 0x8b, 0xd5, 0x9e, 0x97, 0xa8, 0x5d, 0xed, 0xf9, 0x5a, 0x71, 0xfd, 0xd9, 0xb6, 0x1d,
-	0xbd, 0x50, 0x2b, 0x2e, 0x5f, 0x85, 0xbd, 0xe8, 0xc5, 0x9a, 0x9b, 0xd8, 0xe5, 0x65, 0xe8, 0xa5,
-	0x9a, 0x7b, 0x86, 0xf3, 0xe1, 0x96, 0x73, 0xa2, 0x47, 0xe3, 0xe2, 0x46, 0x74, 0xa8, 0x66, 0x6f,
-	0x3a, 0xcd, 0xef, 0xe0, 0x86, 0x11, 0xd1, 0x78, 0x98, 0x6f, 0x2a, 0x70, 0xb8, 0xe6, 0x5f, 0xe8,
-	0x9d, 0xeb, 0x44, 0xba, 0xc8, 0x22, 0x55, 0xf2, 0x84, 0x45, 0x65, 0x69, 0x78, 0xb9, 0x66, 0x47,
-	0xec, 0x31, 0xe5, 0x0c, 0x90, 0xf0, 0x4a, 0xcd, 0x8e, 0xec, 0x71, 0x41, 0x27, 0x35, 0x4c, 0x48,
-	0x88, 0xf0, 0x6a, 0xcd, 0xf5, 0xe8, 0x98, 0xd8, 0x12, 0x26, 0x3c, 0xff, 0xd6, 0xf0, 0x9a, 0x83,
-	0xda, 0x05, 0xc8, 0x30, 0x94, 0x1d, 0x94, 0x1b, 0x5c, 0xac, 0xc1, 0xeb, 0x35, 0x7b, 0x67, 0xe5,
-	0x01, 0x8f, 0x09, 0xbc, 0xe1, 0xa0, 0xeb, 0x10, 0xb9, 0xc8, 0x85, 0x5c, 0x18, 0x22, 0xa3, 0x2c,
-	0x86, 0x23, 0x35, 0x5b, 0xb7, 0xa5, 0xec, 0xaa, 0xf3, 0xde, 0x74, 0x59, 0x68, 0x6d, 0x62, 0x98,
-	0x99, 0x2d, 0x5d, 0x67, 0xef, 0x2d, 0x77, 0x96, 0x46, 0xbf, 0xb1, 0x25, 0x31, 0x5d, 0xe6, 0x7b,
-	0x48, 0xda, 0xd7, 0x26, 0x50, 0xc0, 0xdb, 0x35, 0xbb, 0xcb, 0x2d, 0x21, 0x89, 0x34, 0x5f, 0xb5,
-	0x64, 0x51, 0xe2, 0x9d, 0x5a, 0xfe, 0xd0, 0x61, 0x28, 0x88, 0xc4, 0x45, 0x81, 0x3d, 0xba, 0xa9,
-	0x44, 0xe0, 0x5d, 0x57, 0x1c, 0xb3, 0x09, 0x12, 0xb6, 0x68, 0x3e, 0x12, 0x8e, 0x1e, 0x03, 0xef,
-	0x15, 0x8b, 0x0a, 0x47, 0x8b, 0x33, 0xbc, 0x5f, 0xb3, 0x43, 0x72, 0x65, 0x38, 0xa6, 0x04, 0x1f,
-	0xd4, 0x6c, 0x1b, 0x99, 0xc7, 0x9a, 0x8e, 0x12, 0x3e, 0x74, 0x91, 0xeb, 0x96, 0x31, 0x9c, 0xae,
-	0x54, 0x01, 0x7e, 0x54, 0xb3, 0xcd, 0x64, 0xfa, 0xb8, 0xbe, 0xd8, 0xce, 0x51, 0x55, 0xd3, 0x0e,
-	0x6e, 0x99, 0xb1, 0xf1, 0x6d, 0xe7, 0xdb, 0xc4, 0xdf, 0x3a, 0x63, 0x3b, 0x2a, 0x97, 0x68, 0x0f,
-	0x48, 0x8c, 0x96, 0x7b, 0xdb, 0xb1, 0xf5, 0xed, 0xc7, 0x8c, 0xdb, 0x67, 0x6c, 0x45, 0x6c, 0x97,
-	0x50, 0xd9, 0xb0, 0x52, 0x77, 0xfc, 0x6f, 0xa9, 0xba, 0x94, 0x24, 0xec, 0xc3, 0x9d, 0x33, 0xf6,
-	0x71, 0x71, 0x74, 0x29, 0xdd, 0xb8, 0x70, 0xd7, 0x8c, 0xad, 0xd4, 0xa3, 0x0b, 0xb5, 0x59, 0x3a,
-	0x54, 0xef, 0xe9, 0x03, 0x33, 0x36, 0x6f, 0xe5, 0xb8, 0x16, 0xb3, 0x24, 0x81, 0xbb, 0x67, 0x6c,
-	0xde, 0xca, 0x3c, 0xa7, 0x7a, 0xcf, 0x36, 0x48, 0x6c, 0x69, 0x6a, 0x48, 0xef, 0x9d, 0x19, 0x87,
-	0xdc, 0x72, 0x6d, 0xa8, 0xf7, 0x1d, 0x8b, 0x6f, 0x21, 0xbd, 0x7f, 0xc6, 0x26, 0x3f, 0xe7, 0xb7,
-	0x36, 0x55, 0x65, 0x44, 0x08, 0x0f, 0x38, 0x96, 0xad, 0xe3, 0x05, 0xa6, 0x8a, 0x66, 0x0f, 0xe7,
-	0x6b, 0x70, 0xd5, 0x9c, 0x2d, 0x01, 0x63, 0xa5, 0x50, 0x4c, 0x57, 0xcf, 0x35, 0xbe, 0x7d, 0xf0,
-	0xa5, 0xa9, 0x1d, 0x07, 0x0e, 0x4d, 0xed, 0x3c, 0x78, 0x68, 0x6a, 0xe7, 0x8b, 0x87, 0xa6, 0x76,
-	0xfe, 0xe8, 0xf0, 0xd4, 0x8e, 0x83, 0x87, 0xa7, 0x76, 0x3c, 0x79, 0x78, 0x6a, 0xc7, 0x77, 0xcf,
-	0x74, 0x5f, 0xaf, 0x13, 0xc2, 0xa2, 0xe9, 0x98, 0x4f, 0x0f, 0xd7, 0xe2, 0x69, 0xfb, 0x25, 0x7b,
-	0xf5, 0x38, 0xfd, 0x85, 0xfa, 0x5b, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x09, 0x82, 0xf0, 0x9e,
-	0xf2, 0x16, 0x00, 0x00,
+	// 3103 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x59, 0x57, 0x70, 0x24, 0x45,
+	0x9a, 0x9e, 0x89, 0xb8, 0x43, 0x41, 0xdd, 0x81, 0x7e, 0x0a, 0x98, 0xc6, 0xaa, 0x04, 0x1c, 0x0c,
+	0xc1, 0x1d, 0x9a, 0x87, 0x8b, 0xe8, 0x08, 0x5e, 0x14, 0xd1, 0x52, 0xab, 0x67, 0x74, 0x8c, 0x5a,
+	0x0a, 0xb5, 0xc4, 0x44, 0xdc, 0x5b, 0xaa, 0xea, 0x57, 0x77, 0x9e, 0xaa, 0x33, 0x9b, 0xac, 0xac,
+	0x96, 0x74, 0x4f, 0x9c, 0xdd, 0x85, 0x7d, 0xd9, 0xc7, 0x8d, 0x7d, 0x5b, 0xbf, 0x78, 0x58, 0x8f,
+	0xf7, 0x30, 0xf8, 0xf1, 0x78, 0x18, 0x03, 0x0c, 0x0c, 0xde, 0x0f, 0x7e, 0x23, 0x5d, 0x75, 0x75,
+	0x49, 0xb3, 0x6f, 0x52, 0xfe, 0x26, 0xff, 0xff, 0xfb, 0x5d, 0xd6, 0xdf, 0xde, 0x29, 0x28, 0x44,
+	0xc8, 0x23, 0x1c, 0xe9, 0x08, 0x2e, 0xb9, 0x3f, 0xd8, 0x21, 0xb2, 0xb5, 0x4c, 0xc4, 0x88, 0x3d,
+	0x3e, 0xe7, 0xf2, 0x26, 0x95, 0xad, 0x74, 0x61, 0x24, 0xe4, 0xed, 0x2d, 0x4d, 0xde, 0xe4, 0x5b,
+	0x34, 0xdf, 0x42, 0xba, 0xa8, 0xff, 0xd3, 0xff, 0xe8, 0xbf, 0x8c, 0xfc, 0x65, 0x3f, 0xb9, 0xc2,
+	0x1b, 0x98, 0x10, 0x62, 0x9c, 0x47, 0xe8, 0x9f, 0xe2, 0x9d, 0x3c, 0xcf, 0x22, 0x5c, 0xa4, 0x0c,
+	0x23, 0xd8, 0xe0, 0x9f, 0xec, 0xfd, 0xdd, 0xdc, 0x74, 0x75, 0x1a, 0x7e, 0xfa, 0xf7, 0xfe, 0x26,
+	0xef, 0xb4, 0x09, 0x21, 0xea, 0x5c, 0x4e, 0xb6, 0x3b, 0x31, 0xb6, 0x91, 0x49, 0x8c, 0xe0, 0xda,
+	0x93, 0x7c, 0xdf, 0x3b, 0x65, 0x42, 0x88, 0x2a, 0x76, 0x04, 0x86, 0x44, 0x9d, 0x1d, 0x3f, 0xc9,
+	0x07, 0xef, 0x1f, 0x26, 0x84, 0x98, 0x64, 0x12, 0x05, 0x23, 0x31, 0x1c, 0x1d, 0xf0, 0x4f, 0xf7,
+	0x06, 0xf5, 0x49, 0x97, 0xc4, 0x34, 0x9a, 0x64, 0x9d, 0x54, 0x02, 0xda, 0xc3, 0x29, 0x9a, 0x24,
+	0x94, 0x35, 0xcd, 0xe1, 0xa2, 0xbf, 0xc9, 0xf3, 0x27, 0x84, 0x98, 0x67, 0x24, 0x95, 0x2d, 0x64,
+	0x92, 0x1a, 0xa5, 0x4d, 0xff, 0x4c, 0x7d, 0xff, 0x2c, 0x26, 0x52, 0xd0, 0x50, 0x62, 0x54, 0x11,
+	0x48, 0xa0, 0x65, 0xaf, 0x6f, 0x34, 0xa6, 0xb7, 0xa2, 0x9c, 0x9e, 0xac, 0x8e, 0xc3, 0xdb, 0x03,
+	0xfe, 0xb9, 0xde, 0x26, 0x73, 0x66, 0xef, 0x9b, 0x49, 0x17, 0x62, 0x1a, 0x5e, 0x89, 0xab, 0x70,
+	0x6c, 0xc0, 0x1f, 0xf6, 0xce, 0x35, 0xc4, 0x1a, 0xa1, 0x31, 0x46, 0x57, 0xe2, 0x6a, 0x18, 0x73,
+	0xb2, 0x34, 0x8b, 0x57, 0xa7, 0x98, 0x48, 0x78, 0x67, 0xc0, 0xbf, 0xd0, 0x3b, 0xbf, 0x4f, 0xbc,
+	0xc7, 0x92, 0x74, 0x38, 0x4b, 0x10, 0xde, 0x1d, 0xf0, 0x4f, 0xf3, 0xfe, 0xd1, 0xf0, 0x6c, 0xe7,
+	0x4d, 0x9e, 0x4a, 0x78, 0x6f, 0xc0, 0x3f, 0xdf, 0x3b, 0xcb, 0x89, 0x51, 0xe9, 0x64, 0xc6, 0x63,
+	0x8a, 0x4c, 0xc2, 0xfb, 0x03, 0xfe, 0x59, 0xde, 0xe9, 0x7d, 0x5a, 0xc7, 0x90, 0x08, 0x14, 0xf0,
+	0x41, 0x8e, 0xe2, 0x84, 0x26, 0x84, 0xe0, 0x02, 0x3e, 0x1c, 0x70, 0xd8, 0x8e, 0xd5, 0xb9, 0xac,
+	0xf1, 0x94, 0x45, 0xb0, 0x7b, 0x30, 0x3b, 0xcb, 0xd0, 0xdd, 0x33, 0xe8, 0x97, 0x34, 0x66, 0xd5,
+	0xb1, 0xd9, 0x94, 0x4d, 0xd1, 0xa6, 0x20, 0x92, 0x72, 0x96, 0xc0, 0xde, 0x41, 0xff, 0x54, 0xef,
+	0x64, 0xcb, 0x4c, 0x25, 0xec, 0x1b, 0xb4, 0x66, 0x57, 0xc7, 0xc6, 0x39, 0x63, 0x18, 0x4a, 0xd8,
+	0x3f, 0xe8, 0x9f, 0xe9, 0x81, 0x3e, 0xaa, 0xa4, 0x92, 0x1b, 0x61, 0x84, 0x03, 0x3d, 0x95, 0x95,
+	0x28, 0xaa, 0x71, 0x81, 0xb4, 0xc9, 0x14, 0x7e, 0xcf, 0x0d, 0xfa, 0xe7, 0x78, 0x67, 0xea, 0x64,
+	0x69, 0x77, 0x78, 0x82, 0x0e, 0x60, 0x22, 0x5b, 0x70, 0x47, 0xc9, 0x62, 0x6b, 0x69, 0x55, 0x2a,
+	0x30, 0x94, 0x5c, 0xac, 0x66, 0xd6, 0xdf, 0x59, 0xf2, 0xcf, 0xf6, 0xce, 0xe8, 0x71, 0xcc, 0x22,
+	0x89, 0xc6, 0x39, 0x5b, 0xa4, 0x4d, 0xb8, 0xab, 0xe4, 0x9f, 0xe7, 0x95, 0xd6, 0x28, 0xb6, 0xd4,
+	0xbb, 0x0b, 0xd4, 0x29, 0x22, 0x92, 0x16, 0x89, 0x2d, 0xf5, 0x9e, 0x92, 0xc5, 0xde, 0x52, 0xc7,
+	0x05, 0x12, 0x89, 0x73, 0xd8, 0xee, 0xd4, 0x68, 0x8c, 0x70, 0x6f, 0x41, 0x78, 0x87, 0xa0, 0x39,
+	0xea, 0x7d, 0x05, 0xea, 0x78, 0xcc, 0x93, 0x1e, 0xf5, 0xfe, 0x92, 0x7f, 0x86, 0x37, 0xd8, 0xa3,
+	0x8e, 0xa5, 0x34, 0x8e, 0xe0, 0x81, 0x92, 0xbf, 0xc9, 0x83, 0xfc, 0x29, 0x8b, 0x62, 0x84, 0x3b,
+	0x8f, 0x6d, 0xb4, 0x55, 0x92, 0xf3, 0xaf, 0x4a, 0x16, 0xe0, 0xa1, 0x92, 0x85, 0xd3, 0x9e, 0xcf,
+	0x10, 0x91, 0xa0, 0x22, 0x3c, 0x5c, 0xea, 0x87, 0x53, 0x13, 0xac, 0x57, 0x8f, 0x14, 0x0d, 0xcb,
+	0xbc, 0xaa, 0x52, 0x01, 0x8f, 0x16, 0x7c, 0x9e, 0xef, 0x44, 0x79, 0x9f, 0x1f, 0x2b, 0xc4, 0xa2,
+	0xc6, 0x45, 0x88, 0xb3, 0x18, 0x6a, 0x1d, 0x55, 0xbe, 0xcc, 0x60, 0x67, 0xc9, 0xe6, 0x9d, 0xb3,
+	0x35, 0x65, 0xe6, 0x06, 0x78, 0xbc, 0xe0, 0xf3, 0x6c, 0xca, 0xe6, 0x3b, 0xf0, 0x84, 0xf3, 0x61,
+	0x2b, 0xca, 0x99, 0x1d, 0x2a, 0x9f, 0xc6, 0x28, 0x23, 0x62, 0x15, 0x9e, 0x74, 0x96, 0x68, 0x5c,
+	0x0d, 0x49, 0xd9, 0xb0, 0x0d, 0x49, 0x84, 0x02, 0x9e, 0x72, 0x72, 0x05, 0x32, 0x3c, 0x5d, 0xf2,
+	0x03, 0xef, 0x1c, 0x55, 0xff, 0x26, 0x98, 0x86, 0x64, 0x9c, 0xd7, 0x0c, 0xcf, 0x94, 0xfc, 0x8b,
+	0xbc, 0xa1, 0x7e, 0xc9, 0x1e, 0xd9, 0xaa, 0x7f, 0x76, 0x9d, 0xdb, 0x73, 0x3a, 0x76, 0x95, 0xfc,
+	0x0b, 0xbc, 0xf3, 0x0a, 0x64, 0x1d, 0x61, 0x62, 0x8e, 0x04, 0xec, 0xee, 0x21, 0xd9, 0x59, 0x35,
+	0x1c, 0x73, 0x7c, 0x9c, 0x33, 0x49, 0x28, 0x43, 0x01, 0x7b, 0x0a, 0x48, 0x6e, 0x45, 0x99, 0x11,
+	0x93, 0x49, 0xb6, 0xc8, 0x61, 0x6f, 0xc9, 0x36, 0x1c, 0xdb, 0xc8, 0x66, 0x96, 0x69, 0x66, 0x04,
+	0xec, 0x73, 0x5e, 0xe6, 0x52, 0x62, 0x26, 0x8d, 0xe3, 0x19, 0xc1, 0x9b, 0x02, 0x93, 0x04, 0xf6,
+	0x17, 0xe2, 0x30, 0x43, 0xd9, 0x64, 0x9b, 0x34, 0x31, 0x81, 0x03, 0x25, 0xff, 0x74, 0xef, 0xd4,
+	0x1e, 0x65, 0x3b, 0x65, 0x12, 0x9e, 0x73, 0x97, 0xf5, 0x65, 0x85, 0x4d, 0xc0, 0xe7, 0xd7, 0x2f,
+	0x22, 0x4b, 0x7d, 0xc1, 0x61, 0xd1, 0x97, 0xb5, 0xdb, 0x48, 0xd2, 0x9a, 0xa2, 0x49, 0x9b, 0xc8,
+	0xb0, 0x05, 0x2f, 0x16, 0xb3, 0x8a, 0x25, 0xb4, 0xc9, 0xd0, 0x69, 0x78, 0xa9, 0xe4, 0x0f, 0x79,
+	0x67, 0xe7, 0xc9, 0x52, 0xa4, 0x89, 0xcc, 0xe8, 0x2f, 0x97, 0xd6, 0xe6, 0xbf, 0xea, 0x1a, 0xaf,
+	0xac, 0x55, 0x9b, 0x76, 0x3a, 0x5c, 0x48, 0xdd, 0x7e, 0xe1, 0xd5, 0x82, 0xda, 0x3a, 0x6f, 0xa4,
+	0x61, 0xab, 0x17, 0x82, 0xd7, 0x0a, 0x86, 0x57, 0xda, 0x0b, 0xb4, 0x99, 0xf2, 0x34, 0xe9, 0xb1,
+	0x1c, 0x2c, 0x36, 0x08, 0x13, 0x8a, 0x06, 0x8f, 0xbb, 0x28, 0xe0, 0xd0, 0x3a, 0x7d, 0xc7, 0x92,
+	0x0e, 0x17, 0xf0, 0x34, 0xc7, 0x66, 0x34, 0xc0, 0x91, 0x75, 0x83, 0x97, 0xb4, 0xb2, 0xe0, 0xbd,
+	0x5e, 0x90, 0x9e, 0xc5, 0x26, 0x4d, 0xa4, 0x58, 0xad, 0xa4, 0xb2, 0x05, 0x6f, 0x14, 0xea, 0x68,
+	0x87, 0x86, 0xf8, 0xcd, 0x42, 0x54, 0xb7, 0x71, 0xbe, 0x04, 0x47, 0x9d, 0xf9, 0x5b, 0x51, 0xce,
+	0x27, 0x28, 0x26, 0xab, 0x35, 0xc1, 0xdb, 0xca, 0x3d, 0x5c, 0x91, 0xf0, 0xb3, 0xc0, 0x8e, 0x24,
+	0xeb, 0xd5, 0x78, 0x8b, 0xc4, 0x31, 0xb2, 0x26, 0x5e, 0xa5, 0xa2, 0xab, 0x9b, 0x3d, 0xfc, 0x3c,
+	0xb0, 0x8d, 0xdc, 0xc6, 0xbc, 0x81, 0x24, 0xe1, 0x0c, 0x7e, 0x11, 0xd8, 0xea, 0x9b, 0x43, 0xd2,
+	0x56, 0xb3, 0x9b, 0x59, 0xc2, 0x2f, 0x03, 0x9b, 0xd6, 0x2a, 0x9f, 0x9d, 0xbe, 0x46, 0xba, 0x90,
+	0x84, 0x82, 0x76, 0xb4, 0xc6, 0x5f, 0xf5, 0x34, 0x52, 0xd9, 0x60, 0x7c, 0x79, 0x31, 0x26, 0x4b,
+	0x08, 0xbf, 0x0e, 0x6c, 0x55, 0x9a, 0x8e, 0xb3, 0xbe, 0xec, 0x6f, 0x02, 0x17, 0x31, 0x81, 0x79,
+	0xa6, 0x9c, 0xc1, 0xbf, 0x0d, 0x6c, 0xd0, 0xf3, 0x06, 0xe4, 0xe8, 0xd7, 0x07, 0x16, 0x27, 0xeb,
+	0x90, 0x72, 0x00, 0x6e, 0x70, 0x48, 0x64, 0x12, 0x95, 0x58, 0x20, 0x89, 0x56, 0xed, 0xed, 0x0b,
+	0x18, 0xc1, 0x8d, 0xce, 0xc0, 0xc2, 0xdd, 0x7d, 0x06, 0xde, 0x14, 0xd8, 0x8c, 0xa8, 0x51, 0x16,
+	0x4d, 0x8b, 0x26, 0x61, 0xf4, 0x3f, 0xed, 0xd4, 0xbc, 0x39, 0xf0, 0xff, 0xc9, 0x0b, 0x8c, 0x61,
+	0x06, 0x2c, 0x15, 0x0b, 0xf3, 0x57, 0xa6, 0x0c, 0x6e, 0x09, 0x6c, 0x4a, 0xdb, 0x88, 0x29, 0xf3,
+	0x7a, 0x7c, 0x70, 0xab, 0xc3, 0xbd, 0x2f, 0x1c, 0x93, 0x55, 0xb8, 0xcd, 0xb9, 0xad, 0x84, 0xb6,
+	0x91, 0xa4, 0xce, 0xb5, 0x24, 0x17, 0x56, 0xf0, 0xf6, 0xc0, 0x66, 0x54, 0x76, 0x7b, 0x76, 0x67,
+	0x02, 0xbf, 0x0b, 0xec, 0x00, 0xcf, 0x88, 0xf0, 0xfb, 0xc0, 0x26, 0x99, 0xf9, 0xbf, 0x8a, 0x8c,
+	0x62, 0x04, 0x7f, 0x08, 0x6c, 0xe2, 0x5a, 0x78, 0xb6, 0x91, 0xa4, 0xff, 0x9a, 0x3f, 0x3a, 0xb1,
+	0x59, 0x4c, 0x50, 0x74, 0x31, 0xaa, 0x93, 0x36, 0xc2, 0x9f, 0x32, 0xe8, 0x5a, 0x18, 0x2e, 0xe5,
+	0x61, 0x99, 0x67, 0xf4, 0xea, 0x14, 0x35, 0xd3, 0x9f, 0x03, 0x37, 0xb3, 0x34, 0xbe, 0x79, 0x2e,
+	0xf8, 0x4b, 0xe0, 0xff, 0xb3, 0x77, 0xc9, 0x84, 0x10, 0xf9, 0xd3, 0x13, 0xd9, 0x70, 0x47, 0xd0,
+	0x9b, 0x28, 0x7d, 0x5a, 0xee, 0x74, 0x37, 0xac, 0xc5, 0x00, 0xee, 0x0a, 0xfc, 0xcb, 0xbd, 0x4b,
+	0xd5, 0xed, 0x84, 0x31, 0x2e, 0xdd, 0x50, 0xd4, 0x7a, 0xb7, 0xc6, 0x7c, 0x81, 0xc4, 0x7d, 0xaa,
+	0xee, 0x76, 0x61, 0x52, 0x70, 0xeb, 0xfc, 0xef, 0x23, 0xdf, 0x13, 0xd8, 0xe7, 0x54, 0x4f, 0x0f,
+	0xdc, 0x1b, 0xf8, 0x83, 0x9e, 0x67, 0x6e, 0xd7, 0x07, 0xf7, 0x05, 0xf6, 0x3d, 0x6b, 0x0f, 0x12,
+	0xb8, 0x3f, 0xc7, 0xa2, 0x14, 0xc3, 0x03, 0x4e, 0x8f, 0x29, 0x0a, 0x7d, 0xf6, 0x60, 0xff, 0x99,
+	0x56, 0xf5, 0x90, 0xf3, 0xcc, 0x9c, 0xf5, 0xd9, 0xf2, 0xb0, 0x4b, 0xc9, 0x3a, 0x2e, 0x2b, 0x05,
+	0xba, 0x03, 0xc4, 0x84, 0xb6, 0x13, 0x78, 0xc4, 0x45, 0x4b, 0x21, 0xa5, 0x7a, 0x8b, 0xbe, 0xe0,
+	0xd1, 0xc0, 0xff, 0x17, 0x6f, 0xb3, 0x7a, 0xa4, 0xd1, 0xc5, 0x45, 0x14, 0xc8, 0xb4, 0x2d, 0x63,
+	0x28, 0x97, 0x11, 0xd9, 0x1c, 0x5f, 0x42, 0x56, 0x61, 0x51, 0x95, 0x48, 0xb2, 0x40, 0x12, 0x84,
+	0xc7, 0x1c, 0xda, 0xdb, 0x39, 0x89, 0x14, 0xa3, 0x41, 0x36, 0x81, 0x9d, 0x41, 0x7f, 0xef, 0xe9,
+	0xaf, 0x86, 0xc7, 0x9d, 0x17, 0x59, 0x2c, 0x12, 0x78, 0x22, 0xb0, 0x23, 0xcb, 0x4a, 0x8c, 0xa9,
+	0xf2, 0xfb, 0x0f, 0xf5, 0x9c, 0x7c, 0xd2, 0xe5, 0xdd, 0x44, 0x9b, 0xd0, 0xb8, 0x12, 0x45, 0xaa,
+	0x4b, 0xd6, 0xb9, 0xbc, 0x0a, 0x05, 0x5d, 0x54, 0x89, 0xf9, 0x54, 0x4e, 0xb4, 0x8a, 0x8b, 0x24,
+	0x8d, 0x5d, 0x22, 0x3f, 0x1d, 0xf4, 0x66, 0x44, 0x9b, 0x9a, 0x9a, 0x12, 0x84, 0x25, 0x24, 0xd4,
+	0xe8, 0x3c, 0xd3, 0x8f, 0x5c, 0x25, 0x94, 0xb4, 0x8b, 0x56, 0xf4, 0x59, 0x57, 0x53, 0xae, 0x3f,
+	0x9a, 0xbe, 0x39, 0x85, 0x92, 0x44, 0x44, 0x12, 0xd8, 0xe5, 0x5c, 0xaf, 0x73, 0x0d, 0xcb, 0x8c,
+	0xe0, 0x5d, 0x1a, 0x61, 0x04, 0xbb, 0x73, 0x89, 0xa6, 0x29, 0x3b, 0xa8, 0x6c, 0x59, 0xcc, 0xf7,
+	0x38, 0x4b, 0xad, 0xd0, 0x24, 0x73, 0xed, 0x78, 0x6f, 0xbe, 0x44, 0x8d, 0xe3, 0x2a, 0x56, 0x9a,
+	0x0b, 0xf6, 0xe5, 0xfa, 0x42, 0x8e, 0xe8, 0x64, 0xf7, 0xbb, 0xc6, 0xb8, 0x15, 0x65, 0xde, 0x87,
+	0x29, 0x6c, 0x2f, 0xa0, 0x48, 0x5a, 0xb4, 0x03, 0x07, 0x72, 0xea, 0xb5, 0xce, 0xbc, 0xfc, 0x73,
+	0xce, 0xd5, 0x62, 0x03, 0xd4, 0x8f, 0x9a, 0x08, 0x9e, 0xcf, 0xe5, 0x6a, 0xa5, 0xa9, 0xbe, 0x3c,
+	0x5e, 0x70, 0x3d, 0xa3, 0x41, 0xba, 0x68, 0x8e, 0x5e, 0x74, 0x4a, 0xb6, 0xd3, 0xa4, 0xd7, 0x7b,
+	0x27, 0x59, 0x22, 0x09, 0x0b, 0x31, 0x81, 0x97, 0x5c, 0xba, 0xf5, 0x2e, 0x89, 0x22, 0x78, 0x39,
+	0xf0, 0x2f, 0xf5, 0x2e, 0x52, 0xa7, 0x3c, 0xed, 0x64, 0x55, 0x6d, 0x3b, 0x36, 0x46, 0x63, 0xab,
+	0x0d, 0xd2, 0x36, 0x59, 0xfe, 0x8a, 0x9b, 0x1c, 0x86, 0x73, 0x62, 0xa5, 0x43, 0x05, 0x46, 0xf0,
+	0x6a, 0x90, 0xbd, 0x0e, 0xd4, 0x71, 0xf6, 0x55, 0xf0, 0x9a, 0x4b, 0x1a, 0x15, 0xf3, 0x2a, 0x47,
+	0x95, 0x30, 0x63, 0x18, 0x73, 0xd6, 0x9c, 0xd3, 0xcd, 0x11, 0x0e, 0xf6, 0x26, 0x11, 0xd1, 0x98,
+	0x19, 0x37, 0x0e, 0x65, 0x8d, 0xc8, 0x99, 0x59, 0x8b, 0x49, 0x97, 0x0b, 0x65, 0xec, 0x61, 0x97,
+	0xd4, 0x6b, 0xdc, 0x53, 0xd4, 0x23, 0xbd, 0x3e, 0x97, 0x51, 0x8d, 0xe6, 0xdc, 0x00, 0x7a, 0x3d,
+	0xf0, 0x2f, 0xf6, 0x86, 0xfb, 0x99, 0x42, 0xae, 0xbe, 0x7d, 0x65, 0x9e, 0xed, 0x8d, 0xc0, 0xdf,
+	0xec, 0x5d, 0x98, 0x67, 0xfb, 0xb7, 0xc6, 0x74, 0xdd, 0xbd, 0x69, 0x49, 0x92, 0x74, 0x5a, 0x82,
+	0x24, 0x98, 0xc0, 0x9b, 0xce, 0x8b, 0x3a, 0x97, 0x13, 0x8c, 0xa7, 0xcd, 0xd6, 0x38, 0x49, 0x5a,
+	0x70, 0xd4, 0xa1, 0xa2, 0x82, 0xa1, 0x53, 0x82, 0x4a, 0x8a, 0x09, 0xbc, 0xe5, 0xe2, 0xa6, 0xce,
+	0x15, 0x32, 0x09, 0xbc, 0x9d, 0x67, 0xcd, 0x8d, 0x85, 0x63, 0xae, 0x73, 0xa8, 0xf3, 0xfe, 0xf2,
+	0x7d, 0x27, 0xaf, 0xc5, 0x34, 0xaf, 0x77, 0xdd, 0x0c, 0xed, 0xd3, 0x92, 0x9f, 0x8e, 0x09, 0xbc,
+	0xe7, 0x86, 0xaf, 0xe6, 0xd1, 0xe1, 0x4a, 0xe0, 0x7d, 0xd7, 0x0a, 0xb4, 0xa5, 0x2a, 0x04, 0x09,
+	0x7c, 0xe0, 0xf4, 0x57, 0xa2, 0xc8, 0xf0, 0xc1, 0x87, 0xce, 0xcf, 0x79, 0xb6, 0xc4, 0xf8, 0x32,
+	0xab, 0x8e, 0x5d, 0x49, 0x59, 0x04, 0x1f, 0x39, 0x69, 0xf3, 0xba, 0x6b, 0xc4, 0x69, 0x13, 0x3e,
+	0x76, 0xac, 0xd9, 0x8b, 0x4e, 0x1f, 0x7f, 0xe2, 0x02, 0x5b, 0x68, 0xfe, 0x2a, 0x74, 0x9f, 0x16,
+	0xde, 0x39, 0x26, 0xe4, 0xf0, 0x99, 0xab, 0x56, 0xe5, 0xa3, 0xcd, 0xa1, 0x89, 0x15, 0x9a, 0x48,
+	0xf8, 0xdc, 0x25, 0x73, 0x9d, 0x6b, 0x00, 0xa6, 0x97, 0x19, 0x0a, 0xf8, 0xc2, 0xe5, 0x87, 0x4d,
+	0xe3, 0x49, 0xd6, 0xa5, 0x12, 0xa3, 0x49, 0xa6, 0x13, 0xee, 0xb8, 0x03, 0xd4, 0x52, 0xd5, 0xa1,
+	0xa9, 0x50, 0xf8, 0xd2, 0xd5, 0x8e, 0xb1, 0x4d, 0x4d, 0x44, 0xcb, 0x64, 0xae, 0xfb, 0xca, 0xbd,
+	0x1e, 0xea, 0xbc, 0xd2, 0x25, 0x34, 0x26, 0x0b, 0x31, 0xae, 0xc9, 0x41, 0xf8, 0x3a, 0xf0, 0x2f,
+	0xf3, 0x2e, 0xd6, 0x6b, 0x13, 0x95, 0x4e, 0x2a, 0xbc, 0x95, 0x30, 0xe4, 0x29, 0x93, 0xb9, 0x9e,
+	0x67, 0x1a, 0x21, 0x7c, 0xe3, 0xd0, 0x70, 0xdf, 0xda, 0x82, 0xaf, 0xac, 0xce, 0xf0, 0x98, 0x86,
+	0xab, 0xf0, 0xad, 0x03, 0xb5, 0x2a, 0x08, 0x65, 0xa6, 0x2c, 0xbe, 0x73, 0xc6, 0x67, 0xd7, 0x9a,
+	0x57, 0x29, 0x0a, 0xf8, 0xbe, 0xa0, 0x4a, 0xe7, 0x8b, 0x0d, 0xf9, 0x35, 0xc3, 0xb6, 0x49, 0xf6,
+	0xc6, 0x55, 0x23, 0xe4, 0x02, 0xe1, 0xbf, 0x86, 0x6d, 0x3f, 0xb2, 0x42, 0xea, 0x54, 0x35, 0xd9,
+	0x54, 0x74, 0x11, 0xfe, 0x7b, 0xd8, 0xe2, 0xde, 0x93, 0x9a, 0xc2, 0x88, 0xc4, 0x09, 0xfc, 0xcf,
+	0xb0, 0x45, 0x78, 0xa2, 0x4b, 0xe2, 0x54, 0xb7, 0xec, 0x16, 0xc5, 0xae, 0x5e, 0x1c, 0x25, 0xf0,
+	0xbf, 0xc3, 0xbd, 0x39, 0x50, 0xe7, 0x92, 0x2e, 0xaa, 0x35, 0x8f, 0xb6, 0xe3, 0xff, 0x86, 0x6d,
+	0x03, 0x9d, 0x22, 0x62, 0xa9, 0x8f, 0xa4, 0x5e, 0xdf, 0xf0, 0xff, 0xc3, 0x7d, 0xaf, 0x8f, 0x3c,
+	0x03, 0xfc, 0x60, 0xd8, 0x36, 0xd7, 0x59, 0xec, 0x52, 0x5c, 0x5e, 0xef, 0x55, 0xf9, 0xc3, 0x61,
+	0x0b, 0xfc, 0x3a, 0x44, 0x1b, 0x47, 0x23, 0xac, 0x76, 0x5b, 0xc3, 0xae, 0x73, 0xea, 0x50, 0xab,
+	0xec, 0xbb, 0xce, 0x99, 0x6d, 0x8e, 0x1a, 0x28, 0x2d, 0x1a, 0xf0, 0xa3, 0xe1, 0xec, 0x1d, 0x26,
+	0xba, 0xa8, 0x4b, 0x04, 0x19, 0x5c, 0xbb, 0xd9, 0x6d, 0x85, 0xf4, 0xa9, 0x0b, 0xc4, 0x56, 0x22,
+	0x71, 0x99, 0xac, 0xc2, 0x75, 0x9b, 0x6d, 0xf8, 0xd4, 0x13, 0x7b, 0x3b, 0x6f, 0x36, 0x51, 0xc0,
+	0x47, 0x23, 0x4e, 0x91, 0x24, 0x42, 0x2a, 0x39, 0x1a, 0x22, 0x7c, 0x3c, 0x92, 0xe3, 0x34, 0xca,
+	0xe0, 0x93, 0x11, 0xf7, 0x7e, 0x12, 0x3c, 0xed, 0xcc, 0xa1, 0x68, 0x53, 0xa6, 0x77, 0x65, 0x9f,
+	0x8e, 0xe4, 0x66, 0x50, 0x63, 0xda, 0xac, 0xa0, 0xd4, 0x14, 0xa9, 0xc5, 0xa4, 0x99, 0xc0, 0x67,
+	0xee, 0x86, 0x6a, 0xda, 0xee, 0x64, 0xef, 0x83, 0xcf, 0x47, 0x7a, 0x6f, 0x4b, 0xb5, 0x2f, 0x5a,
+	0xe4, 0xf0, 0xc5, 0x48, 0xef, 0xd9, 0xd1, 0x68, 0x4c, 0xef, 0x68, 0x71, 0xd2, 0xa6, 0x70, 0xbc,
+	0xff, 0xd4, 0xee, 0xbf, 0xbe, 0xec, 0x3f, 0xb5, 0x43, 0xf4, 0xab, 0x11, 0x8b, 0x97, 0x32, 0xbb,
+	0xca, 0xc3, 0x25, 0x14, 0xc6, 0x1a, 0xf8, 0x7a, 0xc4, 0xee, 0xa6, 0x34, 0x65, 0x0c, 0xbe, 0x19,
+	0xc9, 0x3e, 0x8b, 0xd4, 0x77, 0x73, 0x2a, 0xb0, 0x3a, 0x06, 0xdf, 0x8e, 0xe4, 0x3f, 0x41, 0x9c,
+	0x27, 0xf0, 0xdd, 0x48, 0xf6, 0x69, 0x40, 0x33, 0x84, 0xbe, 0xcf, 0x23, 0x34, 0x27, 0x48, 0x88,
+	0x02, 0xae, 0xd9, 0x62, 0x8b, 0x55, 0x57, 0xc6, 0xda, 0x2f, 0xf7, 0x17, 0xca, 0xee, 0xf3, 0x4d,
+	0xbd, 0x77, 0xeb, 0x4d, 0xca, 0x56, 0x32, 0x0e, 0x78, 0xb1, 0x6c, 0x13, 0x78, 0x16, 0xdb, 0xbc,
+	0x8b, 0x05, 0xea, 0x4b, 0x4e, 0x54, 0x6f, 0x84, 0x0a, 0xc4, 0x97, 0x1d, 0x51, 0xc7, 0xb0, 0x40,
+	0x7c, 0xa5, 0x6c, 0xc3, 0xa6, 0x96, 0x3d, 0x94, 0x35, 0xd5, 0xce, 0x26, 0x56, 0x7b, 0x97, 0x57,
+	0xcb, 0xf9, 0x55, 0xc6, 0x9a, 0x4d, 0xc7, 0x6b, 0xe5, 0xfc, 0x22, 0xa5, 0x47, 0x86, 0x83, 0x65,
+	0x37, 0x57, 0xfb, 0x17, 0x1b, 0x87, 0xca, 0xee, 0x63, 0x89, 0x77, 0x56, 0x9d, 0x11, 0x8b, 0xb4,
+	0x99, 0xdf, 0x6e, 0x1c, 0x2e, 0xdb, 0x92, 0xd1, 0xf4, 0x3a, 0x2e, 0x1b, 0x16, 0x8d, 0x87, 0xfb,
+	0x08, 0x2e, 0xfb, 0x97, 0x78, 0x17, 0x38, 0x96, 0x06, 0xb2, 0x48, 0x35, 0x26, 0xc2, 0xa2, 0x7e,
+	0x6e, 0x78, 0xbd, 0x6c, 0x07, 0xe1, 0x09, 0xf9, 0x0c, 0x90, 0xf0, 0x46, 0xd9, 0x0e, 0xd6, 0x22,
+	0xa3, 0xe3, 0xea, 0xc4, 0x24, 0x44, 0x78, 0xb3, 0xec, 0x3a, 0x69, 0x81, 0x6d, 0x16, 0x63, 0x9e,
+	0xed, 0x0d, 0x8f, 0x3a, 0xa8, 0x9d, 0x83, 0x6a, 0xad, 0x59, 0x47, 0xb9, 0xcc, 0xc5, 0x12, 0xbc,
+	0x55, 0xce, 0xbe, 0xdf, 0xad, 0xc3, 0x05, 0x86, 0xb7, 0x1d, 0x74, 0x75, 0x22, 0x67, 0xb8, 0x90,
+	0xd3, 0x1d, 0x64, 0xaa, 0x9a, 0x8f, 0x95, 0x6d, 0xde, 0xf6, 0x45, 0x57, 0xdd, 0xf7, 0x8e, 0x8b,
+	0xc2, 0xc4, 0x0a, 0x86, 0xa9, 0xc4, 0x2c, 0x7a, 0xef, 0xba, 0xbb, 0x34, 0xfa, 0x63, 0xab, 0x12,
+	0x93, 0x39, 0xae, 0x96, 0x2b, 0x5a, 0x05, 0x0a, 0x78, 0xaf, 0x6c, 0xbf, 0xb8, 0x55, 0x1f, 0xd3,
+	0x74, 0x55, 0x92, 0x79, 0x8e, 0xf7, 0xcb, 0xd9, 0x73, 0x94, 0xa1, 0x20, 0x12, 0x67, 0x04, 0x2e,
+	0xd2, 0x15, 0xc5, 0x02, 0x1f, 0xb8, 0xe4, 0x18, 0x8f, 0x91, 0xb0, 0x19, 0xb3, 0xf0, 0xef, 0x3d,
+	0xd9, 0x3e, 0xcc, 0x27, 0x15, 0xf6, 0x96, 0x60, 0xf0, 0x51, 0xd9, 0x4e, 0x83, 0xf9, 0x4e, 0x41,
+	0x08, 0x3e, 0x2e, 0xdb, 0x32, 0x32, 0xbd, 0x5b, 0x7b, 0x09, 0x9f, 0x38, 0xcf, 0x75, 0xc9, 0x18,
+	0x4a, 0x43, 0x2a, 0x07, 0x3f, 0x75, 0x14, 0x7d, 0x45, 0x7e, 0x0a, 0x7d, 0xe6, 0x5c, 0x57, 0x9e,
+	0xe5, 0x13, 0xcd, 0x61, 0xf3, 0x79, 0x39, 0xdb, 0xa1, 0xc5, 0x31, 0x86, 0x72, 0xae, 0x25, 0xb8,
+	0x94, 0x31, 0x65, 0x2a, 0xd8, 0x5c, 0xc8, 0x04, 0xbe, 0x70, 0xae, 0xeb, 0x6b, 0x67, 0x04, 0x76,
+	0xd2, 0x38, 0xb6, 0x7b, 0xb0, 0xe3, 0x2e, 0xc4, 0xa6, 0x8a, 0x89, 0x58, 0x20, 0x4d, 0xb4, 0x9a,
+	0xe0, 0xcb, 0xb2, 0x2d, 0x7b, 0x4d, 0xd4, 0x63, 0x10, 0xbe, 0x2a, 0xbb, 0x47, 0x89, 0x56, 0x16,
+	0x93, 0x55, 0xf8, 0xba, 0x6c, 0x3b, 0x81, 0x69, 0x42, 0x95, 0x99, 0xc9, 0x2c, 0x25, 0x54, 0xab,
+	0x86, 0x07, 0x46, 0xad, 0x85, 0x6b, 0xe9, 0x36, 0x6b, 0x1f, 0x1c, 0xb5, 0xed, 0x20, 0xe3, 0xd0,
+	0xe6, 0x59, 0xea, 0x43, 0x27, 0x96, 0xb7, 0x5b, 0xd5, 0x87, 0x47, 0x6d, 0x3a, 0xaf, 0xe5, 0x50,
+	0xa9, 0x64, 0xb9, 0x1e, 0xf9, 0xdb, 0x5c, 0x15, 0x29, 0x49, 0xd8, 0x82, 0x47, 0x47, 0xed, 0xfb,
+	0x75, 0x7d, 0x2e, 0xdd, 0x75, 0xe0, 0xb1, 0x51, 0x5b, 0x66, 0xeb, 0x33, 0x4d, 0xb2, 0xa4, 0xa3,
+	0x00, 0xdc, 0x39, 0x6a, 0x91, 0xef, 0xf7, 0x4b, 0xed, 0x28, 0xe1, 0xf1, 0x51, 0x9b, 0x74, 0xfd,
+	0x34, 0x27, 0xfa, 0xc4, 0x1a, 0x48, 0x6c, 0x5d, 0x69, 0x48, 0x9f, 0x1c, 0x2d, 0x42, 0x6e, 0xa9,
+	0xd6, 0xd5, 0xa7, 0x4e, 0x44, 0xb7, 0x90, 0x3e, 0x3d, 0x6a, 0x33, 0x37, 0xa3, 0x4f, 0xac, 0xa8,
+	0xb4, 0x8e, 0x10, 0x9e, 0x59, 0xdf, 0x66, 0x7d, 0xed, 0xb3, 0xa3, 0x36, 0x5b, 0x32, 0xda, 0x55,
+	0x3c, 0x4e, 0xdb, 0x86, 0xb8, 0x6b, 0x8d, 0x43, 0x86, 0x68, 0xaf, 0xdc, 0x3d, 0x7a, 0xe2, 0x2c,
+	0xe1, 0xcd, 0x04, 0xf6, 0x8c, 0xda, 0x6e, 0xb9, 0x96, 0xee, 0x30, 0xd9, 0x3b, 0x6a, 0x6b, 0x61,
+	0x2d, 0x8b, 0x09, 0xcb, 0xbe, 0x13, 0xdf, 0xb1, 0x83, 0x50, 0x09, 0xfb, 0x4f, 0x14, 0x8f, 0xa4,
+	0x05, 0x07, 0x1c, 0x24, 0xb6, 0xf9, 0x4c, 0x33, 0x55, 0xe9, 0x7a, 0x83, 0x78, 0x7d, 0xcd, 0x56,
+	0xa7, 0x71, 0x25, 0xd7, 0x01, 0x6e, 0xa8, 0xb9, 0xaf, 0x06, 0xce, 0x97, 0xd2, 0x8e, 0xa2, 0xe8,
+	0xf5, 0xc1, 0x8d, 0x35, 0x77, 0x91, 0xe0, 0xfa, 0x74, 0x46, 0xd0, 0x2e, 0x8d, 0x51, 0x95, 0xdc,
+	0x4d, 0x4e, 0x66, 0xbc, 0xc5, 0x97, 0x99, 0x5b, 0xd9, 0x27, 0x70, 0x73, 0x2d, 0x37, 0xcf, 0x1b,
+	0x18, 0x2f, 0x56, 0x31, 0x91, 0x22, 0x0d, 0x25, 0xdc, 0xe2, 0xb4, 0xcd, 0x22, 0x8b, 0xd0, 0x0c,
+	0x61, 0x57, 0xfe, 0xb7, 0xd6, 0xfa, 0x7b, 0x66, 0x66, 0xf4, 0x6d, 0x35, 0xb7, 0x9d, 0xe9, 0x2d,
+	0x84, 0xcd, 0x06, 0xbe, 0x22, 0xc2, 0x16, 0xdc, 0x5e, 0x1b, 0xbb, 0x62, 0xd7, 0xa1, 0xa1, 0x0d,
+	0x3b, 0x0f, 0x0f, 0x6d, 0xdc, 0x75, 0x78, 0x68, 0xe3, 0xc1, 0xc3, 0x43, 0x1b, 0x7f, 0x7c, 0x64,
+	0x68, 0xc3, 0xae, 0x23, 0x43, 0x1b, 0x9e, 0x3f, 0x32, 0xb4, 0xe1, 0xdf, 0xcf, 0x75, 0x3f, 0x7c,
+	0xc6, 0x84, 0x45, 0x5b, 0xd4, 0xef, 0x9c, 0x4b, 0xcd, 0x2d, 0xf6, 0x47, 0xd0, 0x85, 0x93, 0xf4,
+	0x8f, 0x9b, 0xff, 0xfa, 0xd7, 0x01, 0x00, 0x41, 0x09, 0x1c, 0xbc, 0x2d, 0x1d, 0x00, 0x00,
 }
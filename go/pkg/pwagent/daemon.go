@@ -2,7 +2,10 @@ package pwagent
 
 import (
 	"context"
+	"crypto/ed25519"
 	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
 	"github.com/docker/docker/client"
@@ -15,19 +18,33 @@ import (
 )
 
 type Opts struct {
-	DomainSuffix      string
-	HostIP            string
-	HostPort          string
-	ModeratorPassword string
-	AuthSalt          string
-	ForceRecreate     bool
-	NginxDockerImage  string
-	Cleanup           bool
-	RunOnce           bool
-	LoopDelay         time.Duration
-	DefaultAgent      bool
-	Name              string
-	NoRun             bool
+	DomainSuffix        string
+	HostIP              string
+	HostPort            string
+	ModeratorPassword   string
+	AuthSalt            string
+	ForceRecreate       bool
+	NginxDockerImage    string
+	NginxConfigTemplate string
+	RateLimit           int64
+	RateLimitBurst      int64
+	ConnLimit           int64
+	Cleanup             bool
+	RunOnce             bool
+	LoopDelay           time.Duration
+	DefaultAgent        bool
+	Name                string
+	NoRun               bool
+	MaxStartupAttempts  int
+	StartupBackoff      time.Duration
+	PrepullImages       bool
+	PrepullInterval     time.Duration
+	GCInterval          time.Duration
+	DrainTimeout        time.Duration
+	// TrustedKeys are the keys allowed to sign the compose bundles, unsigned bundles are refused if set
+	TrustedKeys []ed25519.PublicKey
+	// RegistryAuth is used to pull the images of a private registry
+	RegistryAuth pwcompose.RegistryAuth
 
 	Logger *zap.Logger
 }
@@ -55,47 +72,107 @@ func Run(ctx context.Context, cli *client.Client, apiClient *pwapi.HTTPClient, o
 		return nil
 	}
 
+	// on SIGTERM, the agent asks the API to drain it, keeps serving the current players until
+	// the deadline, then stops its instances and exits. A second signal exits immediately.
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGTERM, os.Interrupt)
+	defer signal.Stop(signals)
+	var drainDeadline *time.Time
+
 	iteration := 0
+	lastGC := started
+	var lastPrepull time.Time
 	for {
+		iterationStarted := time.Now()
 		if !opts.RunOnce {
 			logger.Debug("daemon iteration", zap.Int("number", iteration), zap.Duration("uptime", time.Since(started)))
 		}
 
-		err := runOnce(ctx, cli, apiClient, opts)
+		// the bundles are verified and the images inspected for every flavor, so it is not done at each iteration
+		prepull := opts.PrepullImages && time.Since(lastPrepull) >= opts.PrepullInterval
+		if prepull {
+			lastPrepull = iterationStarted
+		}
+
+		err := runOnce(ctx, cli, apiClient, opts, prepull)
 		if err != nil {
 			logger.Error("daemon iteration", zap.Error(err))
 		}
 
+		if opts.GCInterval > 0 && time.Since(lastGC) >= opts.GCInterval {
+			if err := garbageCollect(ctx, cli, apiClient, opts); err != nil {
+				logger.Error("garbage collect", zap.Error(err))
+			}
+			lastGC = time.Now()
+		}
+
 		if opts.RunOnce {
 			break
 		}
+		if drainDeadline != nil && iterationStarted.After(*drainDeadline) && err == nil {
+			logger.Info("agent drained", zap.Duration("uptime", time.Since(started)))
+			break
+		}
 
 		opts.ForceRecreate = false // only do it once
 		opts.Cleanup = false       // only do it once
-
-		time.Sleep(opts.LoopDelay)
+		iteration++
+
+		select {
+		case <-time.After(opts.LoopDelay):
+		case <-ctx.Done():
+			return ctx.Err()
+		case sig := <-signals:
+			if drainDeadline != nil {
+				logger.Warn("received a second signal, exiting without waiting for the drain", zap.Stringer("signal", sig))
+				return nil
+			}
+			deadline := time.Now().Add(opts.DrainTimeout)
+			logger.Info("received signal, draining agent", zap.Stringer("signal", sig), zap.Time("deadline", deadline))
+			ret, err := apiClient.AgentDrain(ctx, &pwapi.AgentDrain_Input{AgentName: opts.Name, Deadline: &deadline})
+			if err != nil {
+				return errcode.ErrAgentDrain.Wrap(err)
+			}
+			drainDeadline = ret.Agent.DrainDeadline
+			if drainDeadline == nil {
+				drainDeadline = &deadline
+			}
+		}
 	}
 	return nil
 }
 
-func runOnce(ctx context.Context, cli *client.Client, apiClient *pwapi.HTTPClient, opts Opts) error {
+func runOnce(ctx context.Context, cli *client.Client, apiClient *pwapi.HTTPClient, opts Opts, prepull bool) error {
 	instances, err := apiClient.AgentListInstances(ctx, &pwapi.AgentListInstances_Input{AgentName: opts.Name})
 	opts.Logger.Debug("api response", zap.Any("instances", instances.GetInstances()))
 	if err != nil {
 		return errcode.TODO.Wrap(err)
 	}
 
-	if errs := applyDockerConfig(ctx, &instances, cli, opts); err != nil {
+	if prepull {
+		if errs := prepullImages(ctx, &instances, cli, opts); errs != nil {
+			for _, err := range multierr.Errors(errs) {
+				opts.Logger.Warn("prepull images", zap.Error(err))
+			}
+		}
+	}
+
+	if errs := applyDockerConfig(ctx, &instances, cli, opts); errs != nil {
 		for _, err := range multierr.Errors(errs) {
 			opts.Logger.Error("apply docker config", zap.Error(err))
 		}
 	}
 
+	throttlingReports, err := collectThrottlingReports(ctx, &instances, cli, opts)
+	if err != nil {
+		opts.Logger.Warn("collect throttling reports", zap.Error(err))
+	}
+
 	if err := applyNginxConfig(ctx, &instances, cli, opts); err != nil {
 		return errcode.TODO.Wrap(err)
 	}
 
-	if err := updateAPIState(ctx, &instances, cli, apiClient, opts); err != nil {
+	if err := updateAPIState(ctx, &instances, throttlingReports, cli, apiClient, opts); err != nil {
 		return errcode.TODO.Wrap(err)
 	}
 
@@ -104,18 +181,27 @@ func runOnce(ctx context.Context, cli *client.Client, apiClient *pwapi.HTTPClien
 
 func NewOpts() Opts {
 	return Opts{
-		Cleanup:           false,
-		RunOnce:           false,
-		NoRun:             false,
-		LoopDelay:         10 * time.Second,
-		DefaultAgent:      true,
-		Name:              getHostname(),
-		DomainSuffix:      "local",
-		NginxDockerImage:  "docker.io/library/nginx:stable-alpine",
-		HostIP:            "0.0.0.0",
-		HostPort:          "8001",
-		ModeratorPassword: "",
-		AuthSalt:          "",
+		Cleanup:            false,
+		RunOnce:            false,
+		NoRun:              false,
+		LoopDelay:          10 * time.Second,
+		DefaultAgent:       true,
+		Name:               getHostname(),
+		DomainSuffix:       "local",
+		NginxDockerImage:   "docker.io/library/nginx:stable-alpine",
+		HostIP:             "0.0.0.0",
+		HostPort:           "8001",
+		ModeratorPassword:  "",
+		AuthSalt:           "",
+		MaxStartupAttempts: 5,
+		RateLimit:          20,
+		RateLimitBurst:     40,
+		ConnLimit:          20,
+		StartupBackoff:     30 * time.Second,
+		PrepullImages:      true,
+		PrepullInterval:    10 * time.Minute,
+		GCInterval:         time.Hour,
+		DrainTimeout:       5 * time.Minute,
 	}
 }
 
@@ -123,6 +209,9 @@ func (opts *Opts) applyDefaults() {
 	if opts.Logger == nil {
 		opts.Logger = zap.NewNop()
 	}
+	if opts.MaxStartupAttempts <= 0 {
+		opts.MaxStartupAttempts = 1
+	}
 	if opts.AuthSalt == "" {
 		opts.AuthSalt = randstring.RandString(10)
 		opts.Logger.Warn("random salt generated", zap.String("salt", opts.AuthSalt))
@@ -1,6 +1,7 @@
 package pwagent
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -18,6 +19,8 @@ import (
 	"pathwar.land/pathwar/v2/go/pkg/pwinit"
 )
 
+const maxStartupBackoff = time.Hour
+
 func applyDockerConfig(ctx context.Context, apiInstances *pwapi.AgentListInstances_Output, dockerClient *client.Client, opts Opts) error {
 	logger := opts.Logger
 	logger.Debug("apply docker", zap.Any("opts", opts))
@@ -34,32 +37,13 @@ func applyDockerConfig(ctx context.Context, apiInstances *pwapi.AgentListInstanc
 	}
 
 	var (
-		started        = 0
-		ignored        = 0
-		proxyNetworkID string
+		started = 0
+		ignored = 0
 	)
 
-	{ // configure proxy network
-		networkResources, err := dockerClient.NetworkList(ctx, types.NetworkListOptions{})
-		if err != nil {
-			return errcode.ErrDockerAPINetworkList.Wrap(err)
-		}
-		for _, networkResource := range networkResources {
-			if networkResource.Name == pwcompose.ProxyNetworkName {
-				proxyNetworkID = networkResource.ID
-				break
-			}
-		}
-		if proxyNetworkID == "" {
-			response, err := dockerClient.NetworkCreate(ctx, pwcompose.ProxyNetworkName, types.NetworkCreate{
-				CheckDuplicate: true,
-			})
-			if err != nil {
-				return errcode.ErrDockerAPINetworkCreate.Wrap(err)
-			}
-			proxyNetworkID = response.ID
-			logger.Info("proxy network created", zap.String("name", pwcompose.ProxyNetworkName))
-		}
+	proxyNetworkID, err := ensureProxyNetwork(ctx, dockerClient, logger)
+	if err != nil {
+		return err
 	}
 
 	var errs error
@@ -72,31 +56,86 @@ func applyDockerConfig(ctx context.Context, apiInstances *pwapi.AgentListInstanc
 		isRunning := runningDockerInstances[instanceID]
 
 		if instance.Status == pwdb.ChallengeInstance_Disabled {
+			if isRunning {
+				if err := stopInstance(ctx, containersInfo, instanceID, dockerClient, opts); err != nil {
+					errs = multierr.Append(errs, err)
+					continue
+				}
+				l.Info("stopped disabled instance")
+			}
 			l.Debug("instance disabled")
 			ignored++
 			continue
 		}
 
+		switch {
+		case isRunning && instance.Status == pwdb.ChallengeInstance_NeedReset:
+			err := runInstanceHook(ctx, instanceID, pwcompose.ResetHook, dockerClient, opts)
+			if err == nil {
+				l.Info("instance reset")
+				instance.Status = pwdb.ChallengeInstance_Available
+				ignored++
+				continue
+			}
+			l.Warn("reset hook failed, redumping the instance", zap.Error(err))
+		case isRunning && instance.Status == pwdb.ChallengeInstance_Validated:
+			// the hook can clean up after the validation, the instance is redumped anyway
+			if err := runInstanceHook(ctx, instanceID, pwcompose.ValidateHook, dockerClient, opts); err != nil {
+				l.Warn("validate hook failed", zap.Error(err))
+			}
+		}
+
 		if isRunning && instance.Status == pwdb.ChallengeInstance_Available {
-			l.Debug("instance running")
+			// the containers of a self-destructed pwinit are not restarted by docker, the instance is recreated instead
+			if !containersInfo.InstanceHasExited(instanceID) {
+				l.Debug("instance running")
+				ignored++
+				continue
+			}
+			l.Info("instance exited, recreating it")
+		}
+
+		if instance.Status == pwdb.ChallengeInstance_StartupFailed {
+			l.Debug("instance startup failed too many times, waiting for a redump", zap.String("startup-error", instance.StartupError))
 			ignored++
 			continue
 		}
 
-		// parse pwinit config
-		configData := pwinit.InitConfig{
-			Passphrases: make([]string, instance.Flavor.Passphrases),
+		if nextAttempt := nextStartupAttempt(instance, opts); time.Now().Before(nextAttempt) {
+			l.Debug("instance startup backoff", zap.Int64("attempts", instance.StartupAttempts), zap.Time("next-attempt", nextAttempt))
+			ignored++
+			continue
 		}
-		for i := 0; i < int(instance.Flavor.Passphrases); i++ {
-			configData.Passphrases[i] = randstring.RandString(14)
+
+		// never run a bundle that was modified or signed by an unknown key
+		bundle, err := pwcompose.OpenBundle(instance.GetFlavor().GetComposeBundle(), opts.TrustedKeys)
+		if err != nil {
+			instance.Status = pwdb.ChallengeInstance_StartupFailed
+			instance.StartupError = err.Error()
+			l.Error("invalid compose bundle", zap.Error(err))
+			errs = multierr.Append(errs, errcode.ErrUpPathwarInstance.Wrap(err))
+			continue
+		}
+
+		// parse pwinit config
+		configData := pwinit.InitConfig{}
+		if instance.Flavor.PerUserPassphrases {
+			// the passphrases are derived for each player by the challenge, and by the API on validation
+			configData.PassphraseSecret = randstring.RandString(32)
+		} else {
+			configData.Passphrases = make([]string, instance.Flavor.Passphrases)
+			for i := 0; i < int(instance.Flavor.Passphrases); i++ {
+				configData.Passphrases[i] = randstring.RandString(14)
+			}
 		}
 
-		bundle := instance.GetFlavor().GetComposeBundle()
 		before := time.Now()
 		started++
 
 		upOpts := pwcompose.UpOpts{
-			PreparedCompose: bundle,
+			PreparedCompose: bundle.Compose,
+			OnInit:          bundle.OnInit,
+			Attachments:     bundle.Attachments,
 			InstanceKey:     instanceID, // WARN -> normal?
 			ForceRecreate:   true,
 			ProxyNetworkID:  proxyNetworkID,
@@ -104,10 +143,19 @@ func applyDockerConfig(ctx context.Context, apiInstances *pwapi.AgentListInstanc
 			Logger:          opts.Logger,
 		}
 		containers, err := pwcompose.Up(ctx, dockerClient, upOpts)
+		instance.LastStartupAttemptAt = &before
 		if err != nil {
+			instance.StartupAttempts++
+			instance.StartupError = err.Error()
+			if instance.StartupAttempts >= int64(opts.MaxStartupAttempts) {
+				instance.Status = pwdb.ChallengeInstance_StartupFailed
+				l.Warn("instance startup failed too many times", zap.Int64("attempts", instance.StartupAttempts))
+			}
 			errs = multierr.Append(errs, errcode.ErrUpPathwarInstance.Wrap(err))
 			continue
 		}
+		instance.StartupAttempts = 0
+		instance.StartupError = ""
 
 		out, err := json.Marshal(configData)
 		if err != nil {
@@ -127,3 +175,83 @@ func applyDockerConfig(ctx context.Context, apiInstances *pwapi.AgentListInstanc
 
 	return errs
 }
+
+// ensureProxyNetwork returns the ID of the network shared by nginx and the challenges, and creates it if needed
+func ensureProxyNetwork(ctx context.Context, dockerClient *client.Client, logger *zap.Logger) (string, error) {
+	networkResources, err := dockerClient.NetworkList(ctx, types.NetworkListOptions{})
+	if err != nil {
+		return "", errcode.ErrDockerAPINetworkList.Wrap(err)
+	}
+	for _, networkResource := range networkResources {
+		if networkResource.Name == pwcompose.ProxyNetworkName {
+			return networkResource.ID, nil
+		}
+	}
+	response, err := dockerClient.NetworkCreate(ctx, pwcompose.ProxyNetworkName, types.NetworkCreate{
+		CheckDuplicate: true,
+	})
+	if err != nil {
+		return "", errcode.ErrDockerAPINetworkCreate.Wrap(err)
+	}
+	logger.Info("proxy network created", zap.String("name", pwcompose.ProxyNetworkName))
+	return response.ID, nil
+}
+
+// openComposeBundle verifies the compose bundle of a flavor against the trusted keys and returns its prepared compose file.
+func openComposeBundle(flavor *pwdb.ChallengeFlavor, opts Opts) (string, error) {
+	bundle, err := pwcompose.OpenBundle(flavor.GetComposeBundle(), opts.TrustedKeys)
+	if err != nil {
+		return "", err
+	}
+	return bundle.Compose, nil
+}
+
+// runInstanceHook runs a pwinit hook in the running containers of an instance, its output is logged
+func runInstanceHook(ctx context.Context, instanceID string, hook string, dockerClient *client.Client, opts Opts) error {
+	var output bytes.Buffer
+	hookOpts := pwcompose.NewHookOpts()
+	hookOpts.ID = instanceID
+	hookOpts.Hook = hook
+	hookOpts.Stdout = &output
+	hookOpts.Stderr = &output
+	hookOpts.Logger = opts.Logger
+	err := pwcompose.RunHook(ctx, dockerClient, hookOpts)
+	opts.Logger.Debug("hook output", zap.String("instance", instanceID), zap.String("hook", hook), zap.String("output", output.String()))
+	return err
+}
+
+// stopInstance removes the containers of an instance, i.e., when it was moved away from a draining agent.
+func stopInstance(ctx context.Context, containersInfo *pwcompose.ContainersInfo, instanceID string, dockerClient *client.Client, opts Opts) error {
+	containerIDs := []string{}
+	for _, container := range containersInfo.RunningContainers {
+		if container.Labels[pwcompose.InstanceKeyLabel] == instanceID {
+			containerIDs = append(containerIDs, container.ID)
+		}
+	}
+	if len(containerIDs) == 0 {
+		return nil
+	}
+	err := pwcompose.Clean(ctx, dockerClient, pwcompose.CleanOpts{
+		ContainerIDs:  containerIDs,
+		RemoveVolumes: true,
+		Logger:        opts.Logger,
+	})
+	if err != nil {
+		return errcode.ErrCleanPathwarInstances.Wrap(err)
+	}
+	return nil
+}
+
+// nextStartupAttempt returns the earliest time the agent is allowed to retry
+// starting an instance, using an exponential backoff based on the number of
+// consecutive failed attempts.
+func nextStartupAttempt(instance *pwdb.ChallengeInstance, opts Opts) time.Time {
+	if instance.StartupAttempts == 0 || instance.LastStartupAttemptAt == nil {
+		return time.Time{}
+	}
+	backoff := opts.StartupBackoff << uint(instance.StartupAttempts-1)
+	if backoff <= 0 || backoff > maxStartupBackoff {
+		backoff = maxStartupBackoff
+	}
+	return instance.LastStartupAttemptAt.Add(backoff)
+}
@@ -0,0 +1,109 @@
+package pwagent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/docker/docker/client"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+	"pathwar.land/pathwar/v2/go/pkg/errcode"
+	"pathwar.land/pathwar/v2/go/pkg/pwapi"
+	"pathwar.land/pathwar/v2/go/pkg/pwcompose"
+	"pathwar.land/pathwar/v2/go/pkg/pwdb"
+)
+
+// prepullFlavors returns the flavors whose images are pre-pulled: the ones of the instances that are not disabled
+// and the upcoming versions of their challenges, each bundle only once.
+func prepullFlavors(apiInstances *pwapi.AgentListInstances_Output) []*pwdb.ChallengeFlavor {
+	var (
+		flavors = []*pwdb.ChallengeFlavor{}
+		bundles = map[string]bool{}
+	)
+	add := func(flavor *pwdb.ChallengeFlavor) {
+		bundle := flavor.GetComposeBundle()
+		if bundle == "" || bundles[bundle] {
+			return
+		}
+		bundles[bundle] = true
+		flavors = append(flavors, flavor)
+	}
+	for _, instance := range apiInstances.GetInstances() {
+		if instance.Status == pwdb.ChallengeInstance_Disabled {
+			continue
+		}
+		add(instance.GetFlavor())
+	}
+	for _, flavor := range apiInstances.GetUpcomingFlavors() {
+		add(flavor)
+	}
+	return flavors
+}
+
+// prepullImages pulls the images of every instance assigned to the agent, including the ones
+// that are not started yet, and of the upcoming flavor versions, so the next startups, redumps
+// and upgrades do not wait for the registry.
+func prepullImages(ctx context.Context, apiInstances *pwapi.AgentListInstances_Output, dockerClient *client.Client, opts Opts) error {
+	logger := opts.Logger
+
+	var errs error
+	for _, flavor := range prepullFlavors(apiInstances) {
+		preparedCompose, err := openComposeBundle(flavor, opts)
+		if err != nil {
+			errs = multierr.Append(errs, errcode.ErrAgentPrepullImages.Wrap(fmt.Errorf("%s: %w", flavor.NameAndVersion(), err)))
+			continue
+		}
+
+		l := logger.With(zap.String("flavor", flavor.NameAndVersion()))
+		pullOpts := pwcompose.NewPullOpts()
+		pullOpts.PreparedCompose = preparedCompose
+		pullOpts.RegistryAuth = opts.RegistryAuth
+		pullOpts.Logger = l
+		pinned, err := pwcompose.Pull(ctx, dockerClient, pullOpts)
+		if err != nil {
+			errs = multierr.Append(errs, errcode.ErrAgentPrepullImages.Wrap(fmt.Errorf("%s: %w", flavor.NameAndVersion(), err)))
+			continue
+		}
+		l.Debug("images ready", zap.Any("images", pinned))
+	}
+	return errs
+}
+
+// garbageCollect removes the pathwar images and volumes not referenced anymore by an instance of the agent.
+func garbageCollect(ctx context.Context, dockerClient *client.Client, apiClient *pwapi.HTTPClient, opts Opts) error {
+	before := time.Now()
+	instances, err := apiClient.AgentListInstances(ctx, &pwapi.AgentListInstances_Input{AgentName: opts.Name})
+	if err != nil {
+		return errcode.ErrAgentGarbageCollect.Wrap(err)
+	}
+
+	// the images of the upcoming flavors are kept too, else they would be pulled again right after
+	flavors := []*pwdb.ChallengeFlavor{}
+	for _, instance := range instances.GetInstances() {
+		flavors = append(flavors, instance.GetFlavor())
+	}
+	flavors = append(flavors, instances.GetUpcomingFlavors()...)
+
+	var keepImages []string
+	for _, flavor := range flavors {
+		// the images are only listed, the signature is checked before pulling or running them
+		bundle, err := pwcompose.OpenBundle(flavor.GetComposeBundle(), nil)
+		if err != nil {
+			return errcode.ErrAgentGarbageCollect.Wrap(err)
+		}
+		images, err := pwcompose.Images(bundle.Compose)
+		if err != nil {
+			// do not risk removing images of a challenge we cannot parse
+			return errcode.ErrAgentGarbageCollect.Wrap(err)
+		}
+		keepImages = append(keepImages, images...)
+	}
+
+	err = pwcompose.GC(ctx, dockerClient, pwcompose.GCOpts{KeepImages: keepImages, Logger: opts.Logger})
+	if err != nil {
+		return errcode.ErrAgentGarbageCollect.Wrap(err)
+	}
+	opts.Logger.Debug("garbage collection done", zap.Duration("duration", time.Since(before)))
+	return nil
+}
@@ -0,0 +1,34 @@
+package pwagent
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pathwar.land/pathwar/v2/go/pkg/pwapi"
+	"pathwar.land/pathwar/v2/go/pkg/pwdb"
+)
+
+func TestPrepullFlavors(t *testing.T) {
+	flavor := func(id int64, bundle string) *pwdb.ChallengeFlavor {
+		return &pwdb.ChallengeFlavor{ID: id, ComposeBundle: bundle}
+	}
+	apiInstances := &pwapi.AgentListInstances_Output{
+		Instances: []*pwdb.ChallengeInstance{
+			{Status: pwdb.ChallengeInstance_Available, Flavor: flavor(1, "bundle-1")},
+			{Status: pwdb.ChallengeInstance_IsNew, Flavor: flavor(2, "bundle-2")},
+			{Status: pwdb.ChallengeInstance_Disabled, Flavor: flavor(3, "bundle-3")},
+			{Status: pwdb.ChallengeInstance_Available, Flavor: flavor(4, "")},
+			{Status: pwdb.ChallengeInstance_NeedRedump, Flavor: flavor(1, "bundle-1")},
+		},
+		UpcomingFlavors: []*pwdb.ChallengeFlavor{
+			flavor(5, "bundle-5"),
+			flavor(6, "bundle-2"),
+		},
+	}
+
+	ids := []int64{}
+	for _, flavor := range prepullFlavors(apiInstances) {
+		ids = append(ids, flavor.ID)
+	}
+	assert.Equal(t, []int64{1, 2, 5}, ids)
+}
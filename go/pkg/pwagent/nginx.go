@@ -37,10 +37,7 @@ func applyNginxConfig(ctx context.Context, apiInstances *pwapi.AgentListInstance
 	if err != nil {
 		return errcode.ErrComposeGetContainersInfo.Wrap(err)
 	}
-	if opts.DomainSuffix == "local" {
-		proxyNetworkIP := containersInfo.NginxContainer.NetworkSettings.Networks[pwcompose.ProxyNetworkName].IPAddress
-		opts.DomainSuffix = proxyNetworkIP + ".xip.io"
-	}
+	opts.DomainSuffix = domainSuffix(containersInfo, opts)
 	config, err := genNginxConfig(apiInstances, containersInfo, opts)
 	if err != nil {
 		return errcode.TODO.Wrap(err)
@@ -50,7 +47,15 @@ func applyNginxConfig(ctx context.Context, apiInstances *pwapi.AgentListInstance
 	}*/
 
 	// configure nginx binary
-	buf, err := buildNginxConfigTar(config, logger)
+	configTemplate := nginxConfigTemplate
+	if opts.NginxConfigTemplate != "" {
+		b, err := ioutil.ReadFile(opts.NginxConfigTemplate)
+		if err != nil {
+			return errcode.ErrReadNginxConfigTemplate.Wrap(err)
+		}
+		configTemplate = string(b)
+	}
+	buf, err := buildNginxConfigTar(configTemplate, config, logger)
 	if err != nil {
 		return errcode.ErrBuildNginxConfig.Wrap(err)
 	}
@@ -86,6 +91,15 @@ func applyNginxConfig(ctx context.Context, apiInstances *pwapi.AgentListInstance
 	return nil
 }
 
+// domainSuffix returns the domain suffix of the instances, "local" uses the IP of nginx on the proxy network
+func domainSuffix(containersInfo *pwcompose.ContainersInfo, opts Opts) string {
+	if opts.DomainSuffix != "local" {
+		return opts.DomainSuffix
+	}
+	proxyNetworkIP := containersInfo.NginxContainer.NetworkSettings.Networks[pwcompose.ProxyNetworkName].IPAddress
+	return proxyNetworkIP + ".xip.io"
+}
+
 func ensureNginxContainer(ctx context.Context, dockerClient *client.Client, opts Opts) error {
 	logger := opts.Logger
 
@@ -160,16 +174,30 @@ func ensureNginxContainer(ctx context.Context, dockerClient *client.Client, opts
 
 func genNginxConfig(apiInstances *pwapi.AgentListInstances_Output, containersInfo *pwcompose.ContainersInfo, opts Opts) (*nginxConfig, error) {
 	config := nginxConfig{
-		Opts:      opts,
-		Upstreams: map[string]nginxUpstream{},
+		Opts:              opts,
+		Upstreams:         map[string]nginxUpstream{},
+		ThrottlingLogPath: nginxThrottlingLogPath,
 	}
 
-	// compute allowed users by instance
+	// compute allowed users and proxy policies by instance
 	allowedUsers := map[string][]int64{}
+	proxyPolicies := map[string]*pwdb.ChallengeFlavor_ProxyPolicy{}
 	for _, apiInstance := range apiInstances.GetInstances() {
 		if apiInstance.Status == pwdb.ChallengeInstance_Disabled {
 			continue
 		}
+		instanceID := fmt.Sprintf("%d", apiInstance.ID)
+		if flavor := apiInstance.GetFlavor(); flavor != nil {
+			policy, err := flavor.ParseProxyPolicy()
+			if err == nil {
+				err = policy.Validate()
+			}
+			if err != nil {
+				opts.Logger.Warn("invalid proxy policy, using defaults", zap.String("instance", instanceID), zap.Error(err))
+			} else {
+				proxyPolicies[instanceID] = policy
+			}
+		}
 		uniqueUsers := map[int64]bool{}
 		for _, seasonChallenge := range apiInstance.GetFlavor().GetSeasonChallenges() {
 			for _, subscription := range seasonChallenge.GetActiveSubscriptions() {
@@ -178,7 +206,6 @@ func genNginxConfig(apiInstances *pwapi.AgentListInstances_Output, containersInf
 				}
 			}
 		}
-		instanceID := fmt.Sprintf("%d", apiInstance.ID)
 		allowedUsers[instanceID] = make([]int64, len(uniqueUsers))
 		i := 0
 		for user := range uniqueUsers {
@@ -193,12 +220,21 @@ func genNginxConfig(apiInstances *pwapi.AgentListInstances_Output, containersInf
 			for idx, port := range container.Ports {
 				if port.PublicPort != 0 {
 					upstream := nginxUpstream{
-						Name:         fmt.Sprintf("%s.%d", container.Names[0][1:], idx),
-						InstanceID:   flavor.InstanceKey,
-						AllowedUsers: allowedUsers[flavor.InstanceKey],
-						Host:         container.NetworkSettings.Networks[pwcompose.ProxyNetworkName].IPAddress,
-						Port:         strconv.Itoa(int(port.PrivatePort)),
+						Name:              fmt.Sprintf("%s.%d", container.Names[0][1:], idx),
+						InstanceID:        flavor.InstanceKey,
+						AllowedUsers:      allowedUsers[flavor.InstanceKey],
+						Host:              container.NetworkSettings.Networks[pwcompose.ProxyNetworkName].IPAddress,
+						Port:              strconv.Itoa(int(port.PrivatePort)),
+						ClientMaxBodySize: defaultClientMaxBodySize,
+						ConnectTimeout:    defaultProxyTimeout,
+						SendTimeout:       defaultProxyTimeout,
+						ReadTimeout:       defaultProxyTimeout,
+						Websocket:         true,
+						RateLimit:         opts.RateLimit,
+						RateLimitBurst:    opts.RateLimitBurst,
+						ConnLimit:         opts.ConnLimit,
 					}
+					upstream.applyProxyPolicy(proxyPolicies[flavor.InstanceKey])
 					config.Upstreams[upstream.Name] = upstream
 				}
 			}
@@ -220,8 +256,8 @@ func genNginxConfig(apiInstances *pwapi.AgentListInstances_Output, containersInf
 	return &config, nil
 }
 
-func buildNginxConfigTar(config *nginxConfig, logger *zap.Logger) (*bytes.Buffer, error) {
-	configTemplate, err := template.New("nginx-config").Parse(nginxConfigTemplate)
+func buildNginxConfigTar(configTemplateText string, config *nginxConfig, logger *zap.Logger) (*bytes.Buffer, error) {
+	configTemplate, err := template.New("nginx-config").Parse(configTemplateText)
 	if err != nil {
 		return nil, errcode.ErrParsingTemplate.Wrap(err)
 	}
@@ -314,6 +350,11 @@ func checkNginxContainer(ctx context.Context, cli *client.Client) (*types.Contai
 }
 
 func nginxSendCommand(ctx context.Context, cli *client.Client, nginxContainerID string, logger *zap.Logger, args ...string) error {
+	_, err := nginxExec(ctx, cli, nginxContainerID, logger, args...)
+	return err
+}
+
+func nginxExec(ctx context.Context, cli *client.Client, nginxContainerID string, logger *zap.Logger, args ...string) ([]byte, error) {
 	execConfig := types.ExecConfig{
 		AttachStdout: true,
 		AttachStderr: true,
@@ -321,12 +362,12 @@ func nginxSendCommand(ctx context.Context, cli *client.Client, nginxContainerID
 	}
 	execRes, err := cli.ContainerExecCreate(ctx, nginxContainerID, execConfig)
 	if err != nil {
-		return errcode.ErrDockerAPIContainerExecCreate.Wrap(err)
+		return nil, errcode.ErrDockerAPIContainerExecCreate.Wrap(err)
 	}
 
 	res, err := cli.ContainerExecAttach(ctx, execRes.ID, execConfig)
 	if err != nil {
-		return errcode.ErrDockerAPIContainerExecAttach.Wrap(err)
+		return nil, errcode.ErrDockerAPIContainerExecAttach.Wrap(err)
 	}
 	defer res.Close()
 
@@ -339,24 +380,24 @@ func nginxSendCommand(ctx context.Context, cli *client.Client, nginxContainerID
 	select {
 	case err := <-outputDone:
 		if err != nil {
-			return errcode.TODO.Wrap(err)
+			return nil, errcode.TODO.Wrap(err)
 		}
 		break
 	case <-ctx.Done():
-		return errcode.TODO.Wrap(ctx.Err())
+		return nil, errcode.TODO.Wrap(ctx.Err())
 	}
 	stdout, err := ioutil.ReadAll(&outbuf)
 	if err != nil {
-		return errcode.TODO.Wrap(err)
+		return nil, errcode.TODO.Wrap(err)
 	}
 	stderr, err := ioutil.ReadAll(&errbuf)
 	if err != nil {
-		return errcode.TODO.Wrap(err)
+		return nil, errcode.TODO.Wrap(err)
 	}
 
 	inspect, err := cli.ContainerExecInspect(ctx, execRes.ID)
 	if err != nil {
-		return errcode.ErrDockerAPIContainerExecInspect.Wrap(err)
+		return nil, errcode.ErrDockerAPIContainerExecInspect.Wrap(err)
 	}
 
 	if len(stderr) > 0 {
@@ -373,27 +414,86 @@ func nginxSendCommand(ctx context.Context, cli *client.Client, nginxContainerID
 	)
 
 	if inspect.ExitCode != 0 {
-		return errcode.ErrDockerAPIExitCode
+		return nil, errcode.ErrDockerAPIExitCode
 	}
 
-	return nil
+	return stdout, nil
 }
 
 type nginxConfig struct {
-	Opts      Opts
-	Upstreams map[string]nginxUpstream
+	Opts              Opts
+	Upstreams         map[string]nginxUpstream
+	ThrottlingLogPath string
 }
 
 type nginxUpstream struct {
-	InstanceID   string
-	Name         string
-	Host         string
-	Port         string
-	Hashes       []string
-	AllowedUsers []int64
+	InstanceID        string
+	Name              string
+	Host              string
+	Port              string
+	Hashes            []string
+	AllowedUsers      []int64
+	ClientMaxBodySize string
+	ConnectTimeout    string
+	SendTimeout       string
+	ReadTimeout       string
+	Headers           map[string]string
+	Websocket         bool
+	RateLimit         int64
+	RateLimitBurst    int64
+	ConnLimit         int64
+}
+
+const (
+	defaultClientMaxBodySize = "10m"
+	defaultProxyTimeout      = "90s"
+)
+
+// applyProxyPolicy overrides the upstream's defaults with the flavor's proxy policy.
+func (u *nginxUpstream) applyProxyPolicy(policy *pwdb.ChallengeFlavor_ProxyPolicy) {
+	if policy == nil {
+		return
+	}
+	if policy.ClientMaxBodySize != "" {
+		u.ClientMaxBodySize = policy.ClientMaxBodySize
+	}
+	if policy.ConnectTimeout != "" {
+		u.ConnectTimeout = policy.ConnectTimeout
+	}
+	if policy.SendTimeout != "" {
+		u.SendTimeout = policy.SendTimeout
+	}
+	if policy.ReadTimeout != "" {
+		u.ReadTimeout = policy.ReadTimeout
+	}
+	if policy.RateLimit != 0 {
+		u.RateLimit = policy.RateLimit
+	}
+	if policy.RateLimitBurst != 0 {
+		u.RateLimitBurst = policy.RateLimitBurst
+	}
+	if policy.ConnLimit != 0 {
+		u.ConnLimit = policy.ConnLimit
+	}
+	u.Headers = policy.Headers
+	u.Websocket = !policy.DisableWebsocket
 }
 
 const nginxConfigTemplate = `
+{{- define "upstream-location"}}
+      client_max_body_size  {{.ClientMaxBodySize}};
+      proxy_connect_timeout {{.ConnectTimeout}};
+      proxy_send_timeout    {{.SendTimeout}};
+      proxy_read_timeout    {{.ReadTimeout}};
+      {{- range $key, $value := .Headers}}
+      add_header {{$key}} "{{$value}}" always;
+      {{- end}}
+      {{- if .Websocket}}
+      proxy_set_header Upgrade             $http_upgrade;
+      proxy_set_header Connection          "upgrade";
+      {{- end}}
+      proxy_pass http://upstream_{{.Name}};
+{{- end}}
 {{$root := .}}
 #user                 www www;
 worker_processes     5;
@@ -434,6 +534,13 @@ http {
   sendfile                      on;
   tcp_nopush                    on;
   server_names_hash_bucket_size 128;
+  limit_req_status              429;
+  limit_conn_status             429;
+  log_format                    throttled '$host $remote_addr';
+  map $status $throttled {
+    429     1;
+    default 0;
+  }
 
   server {
     listen      80 default_server;
@@ -445,6 +552,14 @@ http {
 
   {{range .Upstreams -}}
   upstream upstream_{{.Name}} { server {{.Host}}:{{.Port}}; }
+  {{- if gt .RateLimit 0}}
+  limit_req_zone $host zone=req_user_{{.Name}}:1m rate={{.RateLimit}}r/s;
+  limit_req_zone $binary_remote_addr zone=req_ip_{{.Name}}:1m rate={{.RateLimit}}r/s;
+  {{- end}}
+  {{- if gt .ConnLimit 0}}
+  limit_conn_zone $host zone=conn_user_{{.Name}}:1m;
+  limit_conn_zone $binary_remote_addr zone=conn_ip_{{.Name}}:1m;
+  {{- end}}
   server {
     listen      80;
     server_name moderator-{{.Name}}.{{$root.Opts.DomainSuffix}};
@@ -463,9 +578,7 @@ http {
       proxy_set_header X-Forwarded-Proto   $scheme;
       proxy_set_header X-Frame-Options     SAMEORIGIN;
       proxy_set_header X-Pathwar-Mode      "moderator";
-      proxy_set_header Upgrade             $http_upgrade;
-      proxy_set_header Connection          "upgrade";
-      proxy_pass http://upstream_{{.Name}};
+      {{- template "upstream-location" .}}
     }
   }
   {{- if not (eq (len .Hashes) 0) }}
@@ -473,11 +586,17 @@ http {
     listen      80;
     server_name{{range .Hashes}} {{.}}.{{$root.Opts.DomainSuffix}}{{end}};
     access_log  /proc/self/fd/1;
+    access_log  {{$root.ThrottlingLogPath}} throttled if=$throttled;
     error_log   /proc/self/fd/2;
+    error_page  429 @throttled;
     location = /robots.txt {
        add_header Content-Type text/plain;
        return 200 "User-agent: *\nDisallow: /\n";
     }
+    location @throttled {
+      default_type text/html;
+      return 429 "<html><head><title>429 Too Many Requests</title></head><body><h1>429 Too Many Requests</h1><p>Slow down, this challenge is shared with other players.</p></body></html>\n";
+    }
     location / {
       proxy_http_version  1.1;
       proxy_set_header Host                $http_host;
@@ -486,9 +605,15 @@ http {
       proxy_set_header X-Forwarded-Proto   $scheme;
       proxy_set_header X-Frame-Options     SAMEORIGIN;
       proxy_set_header X-Pathwar-Mode      "authenticated";
-      proxy_set_header Upgrade             $http_upgrade;
-      proxy_set_header Connection          "upgrade";
-      proxy_pass http://upstream_{{.Name}};
+      {{- if gt .RateLimit 0}}
+      limit_req zone=req_user_{{.Name}} burst={{.RateLimitBurst}} nodelay;
+      limit_req zone=req_ip_{{.Name}} burst={{.RateLimitBurst}} nodelay;
+      {{- end}}
+      {{- if gt .ConnLimit 0}}
+      limit_conn conn_user_{{.Name}} {{.ConnLimit}};
+      limit_conn conn_ip_{{.Name}} {{.ConnLimit}};
+      {{- end}}
+      {{- template "upstream-location" .}}
     }
   }
   {{end}}
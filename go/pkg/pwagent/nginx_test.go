@@ -0,0 +1,121 @@
+package pwagent
+
+import (
+	"archive/tar"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pathwar.land/pathwar/v2/go/internal/testutil"
+	"pathwar.land/pathwar/v2/go/pkg/pwdb"
+)
+
+func TestNginxUpstream_ApplyProxyPolicy(t *testing.T) {
+	defaults := nginxUpstream{
+		ClientMaxBodySize: defaultClientMaxBodySize,
+		ConnectTimeout:    defaultProxyTimeout,
+		SendTimeout:       defaultProxyTimeout,
+		ReadTimeout:       defaultProxyTimeout,
+		Websocket:         true,
+		RateLimit:         20,
+		RateLimitBurst:    40,
+		ConnLimit:         20,
+	}
+	tests := []struct {
+		name     string
+		policy   *pwdb.ChallengeFlavor_ProxyPolicy
+		expected func(u *nginxUpstream)
+	}{
+		{"nil", nil, func(u *nginxUpstream) {}},
+		{"empty", &pwdb.ChallengeFlavor_ProxyPolicy{}, func(u *nginxUpstream) {}},
+		{"overrides", &pwdb.ChallengeFlavor_ProxyPolicy{
+			ClientMaxBodySize: "1m",
+			ConnectTimeout:    "5s",
+			SendTimeout:       "10s",
+			ReadTimeout:       "300s",
+			Headers:           map[string]string{"X-Frame-Options": "DENY"},
+			DisableWebsocket:  true,
+			RateLimit:         -1,
+			RateLimitBurst:    5,
+			ConnLimit:         -1,
+		}, func(u *nginxUpstream) {
+			u.ClientMaxBodySize = "1m"
+			u.ConnectTimeout = "5s"
+			u.SendTimeout = "10s"
+			u.ReadTimeout = "300s"
+			u.Headers = map[string]string{"X-Frame-Options": "DENY"}
+			u.Websocket = false
+			u.RateLimit = -1
+			u.RateLimitBurst = 5
+			u.ConnLimit = -1
+		}},
+	}
+	for _, test := range tests {
+		upstream := defaults
+		upstream.applyProxyPolicy(test.policy)
+		expected := defaults
+		test.expected(&expected)
+		assert.Equal(t, expected, upstream, test.name)
+	}
+}
+
+func TestBuildNginxConfigTar(t *testing.T) {
+	opts := NewOpts()
+	opts.DomainSuffix = "pathwar.test"
+	config := nginxConfig{
+		Opts:              opts,
+		ThrottlingLogPath: nginxThrottlingLogPath,
+		Upstreams: map[string]nginxUpstream{
+			"front.42.0": {
+				Name:              "front.42.0",
+				Host:              "172.18.0.2",
+				Port:              "80",
+				Hashes:            []string{"abcdef"},
+				ClientMaxBodySize: "1m",
+				ConnectTimeout:    "5s",
+				SendTimeout:       "10s",
+				ReadTimeout:       "300s",
+				Headers:           map[string]string{"X-Frame-Options": "DENY"},
+				RateLimit:         10,
+				RateLimitBurst:    5,
+				ConnLimit:         -1,
+			},
+		},
+	}
+
+	buf, err := buildNginxConfigTar(nginxConfigTemplate, &config, testutil.Logger(t))
+	require.NoError(t, err)
+	reader := tar.NewReader(buf)
+	header, err := reader.Next()
+	require.NoError(t, err)
+	assert.Equal(t, "nginx.conf", header.Name)
+	content, err := ioutil.ReadAll(reader)
+	require.NoError(t, err)
+	rendered := string(content)
+
+	for _, expected := range []string{
+		"upstream upstream_front.42.0 { server 172.18.0.2:80; }",
+		"server_name moderator-front.42.0.pathwar.test;",
+		"server_name abcdef.pathwar.test;",
+		"client_max_body_size  1m;",
+		"proxy_connect_timeout 5s;",
+		"proxy_send_timeout    10s;",
+		"proxy_read_timeout    300s;",
+		`add_header X-Frame-Options "DENY" always;`,
+		"limit_req zone=req_user_front.42.0 burst=5 nodelay;",
+		"access_log  " + nginxThrottlingLogPath + " throttled if=$throttled;",
+	} {
+		assert.Contains(t, rendered, expected)
+	}
+	for _, unexpected := range []string{
+		`proxy_set_header X-Frame-Options "DENY"`,
+		"proxy_set_header Upgrade",
+		"limit_conn ",
+	} {
+		assert.NotContains(t, rendered, unexpected)
+	}
+	// the headers are added in both the moderator and the authenticated servers
+	assert.Equal(t, 2, strings.Count(rendered, `add_header X-Frame-Options "DENY" always;`))
+}
@@ -0,0 +1,181 @@
+package pwagent
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/docker/docker/client"
+	"go.uber.org/zap"
+	"gopkg.in/yaml.v3"
+	"pathwar.land/pathwar/v2/go/internal/randstring"
+	"pathwar.land/pathwar/v2/go/pkg/errcode"
+	"pathwar.land/pathwar/v2/go/pkg/pwapi"
+	"pathwar.land/pathwar/v2/go/pkg/pwcompose"
+	"pathwar.land/pathwar/v2/go/pkg/pwdb"
+	"pathwar.land/pathwar/v2/go/pkg/pwinit"
+)
+
+const (
+	// playInstanceID is the instance ID of the playground, the database never uses it
+	playInstanceID = 0
+	playUserID     = 1
+)
+
+// Playground is a challenge started locally with its proxy, without API nor database.
+type Playground struct {
+	InstanceID    string
+	ChallengeID   string
+	Passphrases   []string
+	PlayerURL     string
+	ModeratorURLs []string
+}
+
+// Play starts a prepared challenge and configures the nginx proxy as an agent would do for a single player.
+//
+// The nginx container is shared with the agent, a playground should not be started on an agent host.
+func Play(ctx context.Context, cli *client.Client, preparedCompose string, opts Opts) (*Playground, error) {
+	opts.applyDefaults()
+	logger := opts.Logger
+
+	instance, err := playInstance(preparedCompose)
+	if err != nil {
+		return nil, err
+	}
+	instances := pwapi.AgentListInstances_Output{Instances: []*pwdb.ChallengeInstance{instance}}
+
+	// start the challenge
+	proxyNetworkID, err := ensureProxyNetwork(ctx, cli, logger)
+	if err != nil {
+		return nil, err
+	}
+	playground := Playground{InstanceID: fmt.Sprintf("%d", instance.ID)}
+	passphrases, pwinitConfig, err := playPassphrases(*instance.Flavor, playground.InstanceID, opts.AuthSalt)
+	if err != nil {
+		return nil, err
+	}
+	playground.Passphrases = passphrases
+	upOpts := pwcompose.NewUpOpts()
+	upOpts.PreparedCompose = preparedCompose
+	upOpts.InstanceKey = playground.InstanceID
+	upOpts.ForceRecreate = true
+	upOpts.Build = true
+	upOpts.ProxyNetworkID = proxyNetworkID
+	upOpts.PwinitConfig = &pwinitConfig
+	upOpts.Logger = logger
+	services, err := pwcompose.Up(ctx, cli, upOpts)
+	if err != nil {
+		return nil, errcode.ErrUpPathwarInstance.Wrap(err)
+	}
+	for _, service := range services {
+		playground.ChallengeID = service.ChallengeID()
+		break
+	}
+
+	// configure the proxy
+	if err := applyNginxConfig(ctx, &instances, cli, opts); err != nil {
+		return nil, errcode.ErrUpdateNginx.Wrap(err)
+	}
+	containersInfo, err := pwcompose.GetContainersInfo(ctx, cli)
+	if err != nil {
+		return nil, errcode.ErrComposeGetContainersInfo.Wrap(err)
+	}
+	nginx, err := genNginxConfig(&instances, containersInfo, opts)
+	if err != nil {
+		return nil, errcode.ErrAgentPlay.Wrap(err)
+	}
+	playground.setURLs(nginx, domainSuffix(containersInfo, opts), opts.HostPort)
+	logger.Debug("playground started", zap.Any("playground", playground))
+	if playground.PlayerURL == "" {
+		logger.Warn("no service exposing a port, the challenge is not reachable through the proxy")
+	}
+	return &playground, nil
+}
+
+// playInstance fakes the API response of a single player subscribed to the challenge
+func playInstance(preparedCompose string) (*pwdb.ChallengeInstance, error) {
+	config := pwcompose.PathwarConfig{}
+	if err := yaml.Unmarshal([]byte(preparedCompose), &config); err != nil {
+		return nil, errcode.ErrComposeParseConfig.Wrap(err)
+	}
+
+	flavor := config.Pathwar.Flavor
+	flavor.Challenge = &config.Pathwar.Challenge
+	flavor.ComposeBundle = preparedCompose
+	if flavor.ProxyPolicy != nil {
+		proxyPolicy, err := json.Marshal(flavor.ProxyPolicy)
+		if err != nil {
+			return nil, errcode.ErrAgentPlay.Wrap(err)
+		}
+		flavor.ProxyPolicyConfig = string(proxyPolicy)
+	}
+	flavor.SeasonChallenges = []*pwdb.SeasonChallenge{{
+		Subscriptions: []*pwdb.ChallengeSubscription{{
+			Status: pwdb.ChallengeSubscription_Active,
+			Team:   &pwdb.Team{Members: []*pwdb.TeamMember{{UserID: playUserID}}},
+		}},
+	}}
+	instance := pwdb.ChallengeInstance{
+		ID:     playInstanceID,
+		Status: pwdb.ChallengeInstance_Available,
+		Flavor: &flavor,
+	}
+	return &instance, nil
+}
+
+// playPassphrases generates the passphrases of the player and the pwinit configuration injecting them
+func playPassphrases(flavor pwdb.ChallengeFlavor, instanceID string, authSalt string) ([]string, pwinit.InitConfig, error) {
+	passphrases := make([]string, flavor.PassphrasesCount())
+	config := pwinit.InitConfig{}
+	if flavor.PerUserPassphrases {
+		// the player gets the passphrases derived from its prefix hash, as on an agent
+		config.PassphraseSecret = randstring.RandString(32)
+		prefixHash, err := pwdb.ChallengeInstancePrefixHash(instanceID, playUserID, authSalt)
+		if err != nil {
+			return nil, config, errcode.ErrGeneratePrefixHash.Wrap(err)
+		}
+		for idx := range passphrases {
+			passphrases[idx] = pwinit.DerivePassphrase(config.PassphraseSecret, idx, prefixHash)
+		}
+		return passphrases, config, nil
+	}
+	for idx := range passphrases {
+		passphrases[idx] = randstring.RandString(14)
+	}
+	config.Passphrases = passphrases
+	return passphrases, config, nil
+}
+
+// setURLs sets the URLs of the upstreams of the playground, the player URL is the first one with a prefix hash
+func (p *Playground) setURLs(nginx *nginxConfig, suffix string, hostPort string) {
+	names := make([]string, 0, len(nginx.Upstreams))
+	for name, upstream := range nginx.Upstreams {
+		if upstream.InstanceID == p.InstanceID {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		upstream := nginx.Upstreams[name]
+		p.ModeratorURLs = append(p.ModeratorURLs, fmt.Sprintf("http://moderator-%s.%s:%s/", upstream.Name, suffix, hostPort))
+		if p.PlayerURL == "" && len(upstream.Hashes) > 0 {
+			p.PlayerURL = fmt.Sprintf("http://%s.%s:%s/", upstream.Hashes[0], suffix, hostPort)
+		}
+	}
+}
+
+// Validate returns the number of valid passphrases among the submitted ones, and the number of expected passphrases,
+// a submission is accepted by the API only if all the passphrases are valid.
+func (p Playground) Validate(submitted []string) (int, int) {
+	valid := 0
+	for _, passphrase := range p.Passphrases {
+		for _, candidate := range submitted {
+			if passphrase == candidate {
+				valid++
+				break
+			}
+		}
+	}
+	return valid, len(p.Passphrases)
+}
@@ -0,0 +1,119 @@
+package pwagent
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pathwar.land/pathwar/v2/go/pkg/errcode"
+	"pathwar.land/pathwar/v2/go/pkg/pwdb"
+	"pathwar.land/pathwar/v2/go/pkg/pwinit"
+)
+
+func TestPlayInstance(t *testing.T) {
+	compose := `services:
+  front:
+    image: nginx
+x-pathwar:
+  challenge:
+    name: Testing
+  flavor:
+    version: dev
+    passphrases: 2
+    proxy-policy:
+      read_timeout: 300s
+`
+	instance, err := playInstance(compose)
+	require.NoError(t, err)
+	assert.Equal(t, int64(playInstanceID), instance.ID)
+	assert.Equal(t, pwdb.ChallengeInstance_Available, instance.Status)
+	flavor := instance.Flavor
+	require.NotNil(t, flavor)
+	assert.Equal(t, "Testing@dev", flavor.NameAndVersion())
+	assert.Equal(t, compose, flavor.ComposeBundle)
+	assert.Equal(t, int64(2), flavor.Passphrases)
+	policy, err := flavor.ParseProxyPolicy()
+	require.NoError(t, err)
+	require.NotNil(t, policy)
+	assert.Equal(t, "300s", policy.ReadTimeout)
+
+	// the player is the only member allowed by the proxy
+	require.Len(t, flavor.SeasonChallenges, 1)
+	subscriptions := flavor.SeasonChallenges[0].GetActiveSubscriptions()
+	require.Len(t, subscriptions, 1)
+	require.Len(t, subscriptions[0].Team.Members, 1)
+	assert.Equal(t, int64(playUserID), subscriptions[0].Team.Members[0].UserID)
+
+	_, err = playInstance("services: [")
+	assert.Equal(t, errcode.Code(errcode.ErrComposeParseConfig), errcode.Code(err))
+}
+
+func TestPlayPassphrases(t *testing.T) {
+	// a flavor declaring no passphrases has one, as registered by the API
+	passphrases, config, err := playPassphrases(pwdb.ChallengeFlavor{}, "0", "salt")
+	require.NoError(t, err)
+	assert.Len(t, passphrases, 1)
+	assert.Equal(t, passphrases, config.Passphrases)
+
+	passphrases, config, err = playPassphrases(pwdb.ChallengeFlavor{Passphrases: 3}, "0", "salt")
+	require.NoError(t, err)
+	assert.Len(t, passphrases, 3)
+	assert.Equal(t, passphrases, config.Passphrases)
+	assert.Empty(t, config.PassphraseSecret)
+
+	// the per-user passphrases are derived from the prefix hash of the player, as on an agent
+	passphrases, config, err = playPassphrases(pwdb.ChallengeFlavor{Passphrases: 2, PerUserPassphrases: true}, "0", "salt")
+	require.NoError(t, err)
+	require.Len(t, passphrases, 2)
+	assert.Empty(t, config.Passphrases)
+	prefixHash, err := pwdb.ChallengeInstancePrefixHash("0", playUserID, "salt")
+	require.NoError(t, err)
+	for idx, passphrase := range passphrases {
+		assert.Equal(t, pwinit.DerivePassphrase(config.PassphraseSecret, idx, prefixHash), passphrase)
+	}
+}
+
+func TestPlayground_SetURLs(t *testing.T) {
+	nginx := nginxConfig{Upstreams: map[string]nginxUpstream{
+		"testing.front.local.0.1": {Name: "testing.front.local.0.1", InstanceID: "0", Hashes: []string{"hash2"}},
+		"testing.front.local.0.0": {Name: "testing.front.local.0.0", InstanceID: "0", Hashes: []string{"hash1"}},
+		"testing.db.local.0.0":    {Name: "testing.db.local.0.0", InstanceID: "0"},
+		"other.front.local.42.0":  {Name: "other.front.local.42.0", InstanceID: "42", Hashes: []string{"other"}},
+	}}
+
+	playground := Playground{InstanceID: "0"}
+	playground.setURLs(&nginx, "pathwar.test", "8001")
+	assert.Equal(t, "http://hash1.pathwar.test:8001/", playground.PlayerURL)
+	assert.Equal(t, []string{
+		"http://moderator-testing.db.local.0.0.pathwar.test:8001/",
+		"http://moderator-testing.front.local.0.0.pathwar.test:8001/",
+		"http://moderator-testing.front.local.0.1.pathwar.test:8001/",
+	}, playground.ModeratorURLs)
+
+	// no service exposing a port
+	playground = Playground{InstanceID: "1"}
+	playground.setURLs(&nginx, "pathwar.test", "8001")
+	assert.Empty(t, playground.PlayerURL)
+	assert.Empty(t, playground.ModeratorURLs)
+}
+
+func TestPlayground_Validate(t *testing.T) {
+	playground := Playground{Passphrases: []string{"a", "b", "c"}}
+	tests := []struct {
+		name          string
+		submitted     []string
+		expectedValid int
+	}{
+		{"none", nil, 0},
+		{"all", []string{"a", "b", "c"}, 3},
+		{"any-order", []string{"c", "a", "b"}, 3},
+		{"partial", []string{"b", "wrong"}, 1},
+		{"duplicates", []string{"a", "a", "a"}, 1},
+		{"case-sensitive", []string{"A", "B", "C"}, 0},
+	}
+	for _, test := range tests {
+		valid, expected := playground.Validate(test.submitted)
+		assert.Equal(t, test.expectedValid, valid, test.name)
+		assert.Equal(t, 3, expected, test.name)
+	}
+}
@@ -0,0 +1,92 @@
+package pwagent
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/docker/docker/client"
+	"go.uber.org/zap"
+	"pathwar.land/pathwar/v2/go/pkg/errcode"
+	"pathwar.land/pathwar/v2/go/pkg/pwapi"
+	"pathwar.land/pathwar/v2/go/pkg/pwdb"
+)
+
+const nginxThrottlingLogPath = "/var/log/nginx/throttled.log"
+
+type throttlingKey struct {
+	instanceID int64
+	userID     int64
+}
+
+// collectThrottlingReports flushes the throttled requests logged by nginx
+// until the previous call and aggregates them by instance and by user.
+func collectThrottlingReports(ctx context.Context, apiInstances *pwapi.AgentListInstances_Output, dockerClient *client.Client, opts Opts) ([]*pwapi.AgentUpdateState_ThrottlingReport, error) {
+	logger := opts.Logger
+
+	nginxContainer, err := checkNginxContainer(ctx, dockerClient)
+	if err != nil {
+		return nil, errcode.ErrCheckNginxContainer.Wrap(err)
+	}
+	if nginxContainer == nil || nginxContainer.State != "running" {
+		return nil, nil
+	}
+
+	// rotate the log file, then read the one rotated by the previous call: nginx workers keep writing
+	// to the rotated file until they handle the reopen signal, so it is only complete one call later
+	script := fmt.Sprintf("cat %[1]s.1 2>/dev/null; rm -f %[1]s.1; if [ -f %[1]s ]; then mv %[1]s %[1]s.1 && nginx -s reopen; fi", nginxThrottlingLogPath)
+	out, err := nginxExec(ctx, dockerClient, nginxContainer.ID, logger, "sh", "-c", script)
+	if err != nil {
+		return nil, errcode.ErrCollectThrottlingReports.Wrap(err)
+	}
+
+	// map prefix hashes to their instance and user
+	hashes := map[string]throttlingKey{}
+	for _, instance := range apiInstances.GetInstances() {
+		instanceID := fmt.Sprintf("%d", instance.ID)
+		for _, seasonChallenge := range instance.GetFlavor().GetSeasonChallenges() {
+			for _, subscription := range seasonChallenge.GetActiveSubscriptions() {
+				for _, member := range subscription.GetTeam().GetMembers() {
+					hash, err := pwdb.ChallengeInstancePrefixHash(instanceID, member.UserID, opts.AuthSalt)
+					if err != nil {
+						return nil, errcode.ErrGeneratePrefixHash.Wrap(err)
+					}
+					hashes[hash] = throttlingKey{instanceID: instance.ID, userID: member.UserID}
+				}
+			}
+		}
+	}
+
+	counts := map[throttlingKey]int64{}
+	scanner := bufio.NewScanner(bytes.NewReader(out))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 1 {
+			continue
+		}
+		prefix := strings.SplitN(fields[0], ".", 2)[0]
+		key, found := hashes[prefix]
+		if !found {
+			logger.Debug("throttled request on unknown host", zap.String("line", scanner.Text()))
+			continue
+		}
+		counts[key]++
+	}
+
+	reports := make([]*pwapi.AgentUpdateState_ThrottlingReport, 0, len(counts))
+	for key, count := range counts {
+		reports = append(reports, &pwapi.AgentUpdateState_ThrottlingReport{
+			ChallengeInstanceID: key.instanceID,
+			UserID:              key.userID,
+			Count:               count,
+		})
+		logger.Info("throttled requests",
+			zap.Int64("instance", key.instanceID),
+			zap.Int64("user", key.userID),
+			zap.Int64("count", count),
+		)
+	}
+	return reports, nil
+}
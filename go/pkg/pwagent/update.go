@@ -12,27 +12,15 @@ import (
 	"pathwar.land/pathwar/v2/go/pkg/pwdb"
 )
 
-func updateAPIState(ctx context.Context, apiInstances *pwapi.AgentListInstances_Output, cli *client.Client, apiClient *pwapi.HTTPClient, opts Opts) error {
+func updateAPIState(ctx context.Context, apiInstances *pwapi.AgentListInstances_Output, throttlingReports []*pwapi.AgentUpdateState_ThrottlingReport, cli *client.Client, apiClient *pwapi.HTTPClient, opts Opts) error {
 	containersInfo, err := pwcompose.GetContainersInfo(ctx, cli)
 	if err != nil {
 		return errcode.TODO.Wrap(err)
 	}
 
 	for _, apiInstance := range apiInstances.Instances {
-		for _, flavor := range containersInfo.RunningFlavors {
-			isSame := false
-			for _, container := range flavor.Containers {
-				if container.Labels[pwcompose.InstanceKeyLabel] == fmt.Sprintf("%d", apiInstance.ID) {
-					isSame = true
-					break
-				}
-			}
-
-			if isSame {
-				// FIXME: check if state is "up"
-				apiInstance.Status = pwdb.ChallengeInstance_Available
-			}
-		}
+		isUp := containersInfo.InstanceIsUp(fmt.Sprintf("%d", apiInstance.ID))
+		apiInstance.Status = reportedStatus(apiInstance, isUp)
 
 		// cleanup
 		apiInstance.Flavor = nil
@@ -40,7 +28,11 @@ func updateAPIState(ctx context.Context, apiInstances *pwapi.AgentListInstances_
 	}
 
 	// FIXME: update state only if it changed
-	input := pwapi.AgentUpdateState_Input{Instances: apiInstances.Instances}
+	input := pwapi.AgentUpdateState_Input{
+		Instances:         apiInstances.Instances,
+		ThrottlingReports: throttlingReports,
+		AgentName:         opts.Name,
+	}
 	// if logger.Check(zap.DebugLevel, "") != nil {
 	//	fmt.Println(godev.PrettyJSONPB(&input))
 	//}
@@ -52,3 +44,17 @@ func updateAPIState(ctx context.Context, apiInstances *pwapi.AgentListInstances_
 
 	return nil
 }
+
+// reportedStatus returns the status of an instance after a docker pass, an instance is only available once all
+// its containers run, and the disabled or failed instances keep their status until a redump
+func reportedStatus(instance *pwdb.ChallengeInstance, isUp bool) pwdb.ChallengeInstance_Status {
+	switch {
+	case instance.Status == pwdb.ChallengeInstance_Disabled, instance.Status == pwdb.ChallengeInstance_StartupFailed:
+		return instance.Status
+	case instance.StartupError != "": // the last startup attempt failed, the running containers are leftovers
+		return instance.Status
+	case isUp:
+		return pwdb.ChallengeInstance_Available
+	}
+	return instance.Status
+}
@@ -0,0 +1,31 @@
+package pwagent
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pathwar.land/pathwar/v2/go/pkg/pwdb"
+)
+
+func TestReportedStatus(t *testing.T) {
+	tests := []struct {
+		name         string
+		status       pwdb.ChallengeInstance_Status
+		startupError string
+		isUp         bool
+		expected     pwdb.ChallengeInstance_Status
+	}{
+		{"new-up", pwdb.ChallengeInstance_IsNew, "", true, pwdb.ChallengeInstance_Available},
+		{"new-down", pwdb.ChallengeInstance_IsNew, "", false, pwdb.ChallengeInstance_IsNew},
+		{"redumped-up", pwdb.ChallengeInstance_NeedRedump, "", true, pwdb.ChallengeInstance_Available},
+		{"redump-failed", pwdb.ChallengeInstance_NeedRedump, "exit status 1", true, pwdb.ChallengeInstance_NeedRedump},
+		{"startup-failed", pwdb.ChallengeInstance_StartupFailed, "exit status 1", true, pwdb.ChallengeInstance_StartupFailed},
+		{"startup-failed-without-error", pwdb.ChallengeInstance_StartupFailed, "", true, pwdb.ChallengeInstance_StartupFailed},
+		{"disabled", pwdb.ChallengeInstance_Disabled, "", true, pwdb.ChallengeInstance_Disabled},
+		{"available-down", pwdb.ChallengeInstance_Available, "", false, pwdb.ChallengeInstance_Available},
+	}
+	for _, test := range tests {
+		instance := &pwdb.ChallengeInstance{Status: test.status, StartupError: test.startupError}
+		assert.Equal(t, test.expected, reportedStatus(instance, test.isUp), test.name)
+	}
+}
@@ -0,0 +1,334 @@
+package pwapi
+
+import (
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"go.uber.org/zap"
+	"pathwar.land/pathwar/v2/go/pkg/pwdb"
+)
+
+// achievementThreshold grants an achievement once a counter reaches a value
+type achievementThreshold struct {
+	count int64
+	kind  pwdb.Achievement_Type
+}
+
+var (
+	buyAchievements = []achievementThreshold{
+		{1, pwdb.Achievement_Buy1Challenge},
+		{5, pwdb.Achievement_Buy5Challenges},
+		{10, pwdb.Achievement_Buy10Challenges},
+		{20, pwdb.Achievement_Buy20Challenges},
+		{50, pwdb.Achievement_Buy50Challenges},
+	}
+	validateAchievements = []achievementThreshold{
+		{1, pwdb.Achievement_Validate1Challenge},
+		{5, pwdb.Achievement_Validate5Challenges},
+		{10, pwdb.Achievement_Validate10Challenges},
+		{20, pwdb.Achievement_Validate20Challenges},
+		{50, pwdb.Achievement_Validate50Challenges},
+	}
+	couponAchievements = []achievementThreshold{
+		{1, pwdb.Achievement_Validate1Coupon},
+		{5, pwdb.Achievement_Validate5Coupons},
+		{10, pwdb.Achievement_Validate10Coupons},
+		{20, pwdb.Achievement_Validate20Coupons},
+		{50, pwdb.Achievement_Validate50Coupons},
+		{100, pwdb.Achievement_Validate100Coupons},
+	}
+	// the count is the maximum registration rank of the user
+	earlyAdopterAchievements = []achievementThreshold{
+		{100, pwdb.Achievement_EarlyAdopterTop100},
+		{1000, pwdb.Achievement_EarlyAdopterTop1000},
+	}
+	// the count is the minimum age of the account, in days
+	seniorityAchievements = []achievementThreshold{
+		{365, pwdb.Achievement_Old1Year},
+		{2 * 365, pwdb.Achievement_Old2Years},
+	}
+)
+
+func reachedAchievements(thresholds []achievementThreshold, count int64) []pwdb.Achievement_Type {
+	types := []pwdb.Achievement_Type{}
+	for _, threshold := range thresholds {
+		if count >= threshold.count {
+			types = append(types, threshold.kind)
+		}
+	}
+	return types
+}
+
+// teamAchievements returns the achievements a team deserves for its buys, validations and coupons
+func teamAchievements(db *gorm.DB, teamID int64) ([]pwdb.Achievement_Type, error) {
+	var buys, validations, coupons int64
+	err := db.
+		Model(&pwdb.ChallengeSubscription{}).
+		Where(pwdb.ChallengeSubscription{TeamID: teamID}).
+		Count(&buys).
+		Error
+	if err != nil {
+		return nil, err
+	}
+	err = db.
+		Table("challenge_validation").
+		Joins("JOIN challenge_subscription ON challenge_subscription.id = challenge_validation.challenge_subscription_id").
+		Where("challenge_subscription.team_id = ?", teamID).
+		Where("challenge_validation.status IN (?)", scoringValidationStatuses).
+		Select("COUNT(DISTINCT challenge_subscription.season_challenge_id)").
+		Count(&validations).
+		Error
+	if err != nil {
+		return nil, err
+	}
+	err = db.
+		Model(&pwdb.CouponValidation{}).
+		Where(pwdb.CouponValidation{TeamID: teamID}).
+		Count(&coupons).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	types := reachedAchievements(buyAchievements, buys)
+	types = append(types, reachedAchievements(validateAchievements, validations)...)
+	types = append(types, reachedAchievements(couponAchievements, coupons)...)
+	return types, nil
+}
+
+// userAchievements returns the time-based achievements a user deserves at a given time
+func userAchievements(db *gorm.DB, user *pwdb.User, now time.Time) ([]pwdb.Achievement_Type, error) {
+	if user.CreatedAt == nil {
+		return nil, nil
+	}
+
+	var registeredBefore int64
+	err := db.
+		Model(&pwdb.User{}).
+		Where("created_at < ?", *user.CreatedAt).
+		Count(&registeredBefore).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	types := []pwdb.Achievement_Type{}
+	for _, threshold := range earlyAdopterAchievements {
+		if registeredBefore < threshold.count {
+			types = append(types, threshold.kind)
+		}
+	}
+	days := int64(now.Sub(*user.CreatedAt) / (24 * time.Hour))
+	types = append(types, reachedAchievements(seniorityAchievements, days)...)
+	return types, nil
+}
+
+// grantAchievements creates the achievements a team doesn't have yet, then bumps its counter and notifies its members
+func grantAchievements(tx *gorm.DB, teamID int64, authorID int64, types []pwdb.Achievement_Type) ([]*pwdb.Achievement, error) {
+	if len(types) == 0 {
+		return nil, nil
+	}
+	var existing []pwdb.Achievement_Type
+	err := tx.
+		Model(&pwdb.Achievement{}).
+		Where(pwdb.Achievement{TeamID: teamID}).
+		Pluck("type", &existing).
+		Error
+	if err != nil {
+		return nil, err
+	}
+	owned := map[pwdb.Achievement_Type]bool{}
+	for _, kind := range existing {
+		owned[kind] = true
+	}
+
+	created := []*pwdb.Achievement{}
+	for _, kind := range types {
+		if owned[kind] {
+			continue
+		}
+		owned[kind] = true
+		achievement := pwdb.Achievement{
+			Type:     kind,
+			TeamID:   teamID,
+			AuthorID: authorID,
+		}
+		inserted, err := createAchievementIfAbsent(tx, &achievement)
+		if err != nil {
+			return nil, err
+		}
+		if inserted {
+			created = append(created, &achievement)
+		}
+	}
+	if len(created) == 0 {
+		return created, nil
+	}
+
+	err = tx.
+		Model(&pwdb.Team{}).
+		Where("id = ?", teamID).
+		UpdateColumn("nb_achievements", gorm.Expr("nb_achievements + ?", len(created))).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	userIDs, err := teamMemberIDs(tx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	for _, achievement := range created {
+		args := map[string]interface{}{
+			"achievement_id": achievement.ID,
+			"type":           achievement.Type.String(),
+			"team_id":        teamID,
+		}
+		if err := notifyUsers(tx, userIDs, achievementUnlockedMsg, teamURL(teamID), args); err != nil {
+			return nil, err
+		}
+	}
+	return created, nil
+}
+
+// createAchievementIfAbsent inserts an achievement in a savepoint, so a concurrent grant of the same type,
+// rejected by the unique (team_id, type) index, is skipped without aborting the transaction
+func createAchievementIfAbsent(tx *gorm.DB, achievement *pwdb.Achievement) (bool, error) {
+	if err := tx.Exec("SAVEPOINT grant_achievement").Error; err != nil {
+		return false, err
+	}
+	createErr := tx.Create(achievement).Error
+	if createErr == nil {
+		return true, tx.Exec("RELEASE SAVEPOINT grant_achievement").Error
+	}
+	if err := tx.Exec("ROLLBACK TO SAVEPOINT grant_achievement").Error; err != nil {
+		return false, err
+	}
+	if err := tx.Exec("RELEASE SAVEPOINT grant_achievement").Error; err != nil {
+		return false, err
+	}
+
+	var count int
+	err := tx.
+		Model(&pwdb.Achievement{}).
+		Where(pwdb.Achievement{TeamID: achievement.TeamID, Type: achievement.Type}).
+		Count(&count).
+		Error
+	if err != nil {
+		return false, err
+	}
+	if count == 0 {
+		return false, createErr
+	}
+	return false, nil
+}
+
+// evaluateTeamAchievements grants the achievements unlocked by the activities of a team
+func evaluateTeamAchievements(db *gorm.DB, teamID int64, authorID int64) ([]*pwdb.Achievement, error) {
+	var created []*pwdb.Achievement
+	err := db.Transaction(func(tx *gorm.DB) error {
+		types, err := teamAchievements(tx, teamID)
+		if err != nil {
+			return err
+		}
+		created, err = grantAchievements(tx, teamID, authorID, types)
+		return err
+	})
+	return created, err
+}
+
+// evaluateTimeAchievements grants the time-based achievements of every active user to their team of the global season
+func evaluateTimeAchievements(db *gorm.DB, now time.Time) ([]*pwdb.Achievement, error) {
+	var season pwdb.Season
+	err := db.Where(pwdb.Season{IsGlobal: true}).First(&season).Error
+	if err != nil {
+		return nil, err
+	}
+	var users []*pwdb.User
+	err = db.Where(pwdb.User{DeletionStatus: pwdb.DeletionStatus_Active}).Find(&users).Error
+	if err != nil {
+		return nil, err
+	}
+
+	created := []*pwdb.Achievement{}
+	for _, user := range users {
+		team, err := userTeamForSeason(db, user.ID, season.ID)
+		if err == gorm.ErrRecordNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		err = db.Transaction(func(tx *gorm.DB) error {
+			types, err := userAchievements(tx, user, now)
+			if err != nil {
+				return err
+			}
+			achievements, err := grantAchievements(tx, team.ID, user.ID, types)
+			created = append(created, achievements...)
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return created, nil
+}
+
+// backfillAchievements grants every achievement deserved by the existing teams and users
+func backfillAchievements(db *gorm.DB, authorID int64, now time.Time) ([]*pwdb.Achievement, error) {
+	var teamIDs []int64
+	err := db.
+		Model(&pwdb.Team{}).
+		Where(pwdb.Team{DeletionStatus: pwdb.DeletionStatus_Active}).
+		Order("id asc").
+		Pluck("id", &teamIDs).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	created := []*pwdb.Achievement{}
+	for _, teamID := range teamIDs {
+		achievements, err := evaluateTeamAchievements(db, teamID, authorID)
+		if err != nil {
+			return nil, err
+		}
+		created = append(created, achievements...)
+	}
+	achievements, err := evaluateTimeAchievements(db, now)
+	if err != nil {
+		return nil, err
+	}
+	return append(created, achievements...), nil
+}
+
+// checkTeamAchievements evaluates the achievements of a team after one of its activities,
+// errors are only logged since the activity itself succeeded
+func (svc *service) checkTeamAchievements(teamID int64, authorID int64) {
+	if _, err := evaluateTeamAchievements(svc.db, teamID, authorID); err != nil {
+		svc.logger.Warn("evaluate team achievements", zap.Int64("team", teamID), zap.Error(err))
+	}
+}
+
+// achievementsLoop evaluates the time-based achievements periodically, until the service is closed
+func (svc *service) achievementsLoop(interval time.Duration) {
+	defer close(svc.achievementsStopped)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-svc.achievementsDone:
+			return
+		case now := <-ticker.C:
+			created, err := evaluateTimeAchievements(svc.db, now)
+			if err != nil {
+				svc.logger.Warn("evaluate time achievements", zap.Error(err))
+				continue
+			}
+			if len(created) > 0 {
+				svc.logger.Debug("time achievements granted", zap.Int("count", len(created)))
+			}
+		}
+	}
+}
@@ -57,7 +57,7 @@ func TestActivity(t *testing.T) {
 	{
 		gs := testingGlobalSeason(t, svc)
 		activeTeam := session.User.ActiveTeamMember.Team
-		challenges, err := svc.SeasonChallengeList(ctx, &SeasonChallengeList_Input{gs.ID})
+		challenges, err := svc.SeasonChallengeList(ctx, &SeasonChallengeList_Input{SeasonID: gs.ID})
 		require.NoError(t, err)
 
 		for _, challenge := range challenges.Items {
@@ -112,7 +112,7 @@ func TestActivity(t *testing.T) {
 		require.NoError(t, err)
 
 		activities = testingActivities(t, svc)
-		require.Len(t, activities.Items, 3)
+		require.Len(t, activities.Items, 4)
 		activity := activities.Items[2]
 		assert.Equal(t, activity.Kind, pwdb.Activity_ChallengeSubscriptionValidate)
 		assert.Equal(t, activity.AuthorID, session.User.ID)
@@ -122,6 +122,12 @@ func TestActivity(t *testing.T) {
 		assert.Equal(t, activity.Season.Name, "Global")
 		assert.Equal(t, activity.TeamID, session.User.ActiveTeamMember.Team.ID)
 		// fmt.Println(godev.PrettyJSON(activity))
+
+		activity = activities.Items[3]
+		assert.Equal(t, activity.Kind, pwdb.Activity_TeamScoreChange)
+		assert.Equal(t, activity.TeamID, session.User.ActiveTeamMember.Team.ID)
+		assert.Equal(t, activity.SeasonChallengeID, subscription.ChallengeSubscription.SeasonChallenge.ID)
+		assert.Equal(t, activity.ScoreDelta, int64(10))
 	}
 
 	// get session again
@@ -142,8 +148,8 @@ func TestActivity(t *testing.T) {
 		// fmt.Println(godev.PrettyJSON(ret))
 
 		activities = testingActivities(t, svc)
-		require.Len(t, activities.Items, 4)
-		activity := activities.Items[3]
+		require.Len(t, activities.Items, 5)
+		activity := activities.Items[4]
 		// fmt.Println(godev.PrettyJSON(activity))
 		assert.Equal(t, activity.Kind, pwdb.Activity_CouponValidate)
 		assert.Equal(t, activity.AuthorID, session.User.ID)
@@ -170,8 +176,8 @@ func TestActivity(t *testing.T) {
 		require.NoError(t, err)
 
 		activities = testingActivities(t, svc)
-		require.Len(t, activities.Items, 5)
-		activity := activities.Items[4]
+		require.Len(t, activities.Items, 6)
+		activity := activities.Items[5]
 		// fmt.Println(godev.PrettyJSON(activity))
 		assert.Equal(t, activity.Kind, pwdb.Activity_SeasonChallengeBuy)
 		assert.Equal(t, activity.AuthorID, session.User.ID)
@@ -194,8 +200,8 @@ func TestActivity(t *testing.T) {
 		require.NoError(t, err)
 
 		activities = testingActivities(t, svc)
-		require.Len(t, activities.Items, 6)
-		activity := activities.Items[5]
+		require.Len(t, activities.Items, 7)
+		activity := activities.Items[6]
 		// fmt.Println(godev.PrettyJSON(activity))
 		assert.Equal(t, activity.Kind, pwdb.Activity_UserDeleteAccount)
 		assert.Equal(t, activity.AuthorID, session.User.ID)
@@ -0,0 +1,52 @@
+package pwapi
+
+import (
+	"context"
+
+	"pathwar.land/pathwar/v2/go/pkg/errcode"
+	"pathwar.land/pathwar/v2/go/pkg/pwdb"
+)
+
+func (svc *service) AdminAgentDrain(ctx context.Context, in *AdminAgentDrain_Input) (*AdminAgentDrain_Output, error) {
+	if !isAdminContext(ctx) {
+		return nil, errcode.ErrRestrictedArea
+	}
+	if in == nil || in.Agent == "" {
+		return nil, errcode.ErrMissingInput
+	}
+
+	userID, _ := userIDFromContext(ctx, svc.db)
+	// userID is only used for activity logging, we don't care that it returns an error
+
+	agentID, err := pwdb.GetIDBySlugAndKind(svc.db, in.Agent, "agent")
+	if err != nil {
+		return nil, err
+	}
+	var agent pwdb.Agent
+	if err = svc.db.First(&agent, agentID).Error; err != nil {
+		return nil, errcode.ErrGetAgent.Wrap(err)
+	}
+
+	if in.Cancel {
+		// instances already moved by an expired drain stay disabled
+		err = svc.db.
+			Model(&agent).
+			Updates(map[string]interface{}{
+				"status":         pwdb.Agent_Active,
+				"drain_deadline": nil,
+			}).
+			Error
+		if err != nil {
+			return nil, errcode.ErrDrainAgent.Wrap(err)
+		}
+	} else if err = svc.drainAgent(&agent, in.Deadline, userID); err != nil {
+		return nil, err
+	}
+
+	if err = svc.db.First(&agent, agentID).Error; err != nil {
+		return nil, errcode.ErrGetAgent.Wrap(err)
+	}
+
+	out := AdminAgentDrain_Output{Agent: &agent}
+	return &out, nil
+}
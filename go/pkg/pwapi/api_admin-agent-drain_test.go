@@ -0,0 +1,93 @@
+package pwapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pathwar.land/pathwar/v2/go/internal/testutil"
+	"pathwar.land/pathwar/v2/go/pkg/errcode"
+	"pathwar.land/pathwar/v2/go/pkg/pwdb"
+)
+
+func TestService_AdminAgentDrain(t *testing.T) {
+	svc, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t)})
+	defer cleanup()
+	ctx := testingSetContextToken(context.Background(), t)
+	db := testingSvcDB(t, svc)
+
+	// invalid input
+	_, err := svc.AdminAgentDrain(ctx, nil)
+	testSameErrcodes(t, "", errcode.ErrMissingInput, err)
+	_, err = svc.AdminAgentDrain(ctx, &AdminAgentDrain_Input{Agent: "unknown"})
+	testSameErrcodes(t, "", errcode.ErrNoSuchSlug, err)
+
+	require.NoError(t, db.Model(pwdb.Agent{}).Where(pwdb.Agent{Name: "dummy-agent-1"}).Update("default_agent", true).Error)
+
+	// drain with a deadline, instances are kept
+	deadline := time.Now().Add(time.Hour)
+	ret, err := svc.AdminAgentDrain(ctx, &AdminAgentDrain_Input{Agent: "dummy-agent-1", Deadline: &deadline})
+	require.NoError(t, err)
+	assert.Equal(t, pwdb.Agent_Draining, ret.Agent.Status)
+	require.NotNil(t, ret.Agent.DrainDeadline)
+	agentID := ret.Agent.ID
+
+	instances, err := svc.AgentListInstances(ctx, &AgentListInstances_Input{AgentName: "dummy-agent-1"})
+	require.NoError(t, err)
+	assert.Equal(t, pwdb.Agent_Draining, instances.Agent.Status)
+	available := 0
+	var flavorID int64
+	for _, instance := range instances.Instances {
+		if instance.Status == pwdb.ChallengeInstance_Available {
+			available++
+			flavorID = instance.FlavorID
+		}
+	}
+	assert.Equal(t, 1, available)
+
+	// a draining agent does not receive new flavors
+	flavor, err := svc.AdminChallengeFlavorAdd(ctx, &AdminChallengeFlavorAdd_Input{
+		ChallengeFlavor: &pwdb.ChallengeFlavor{ChallengeID: instances.Instances[0].Flavor.ChallengeID, Version: "drain-test"},
+	})
+	require.NoError(t, err)
+	var count int
+	require.NoError(t, db.Model(pwdb.ChallengeInstance{}).Where(pwdb.ChallengeInstance{AgentID: agentID, FlavorID: flavor.ChallengeFlavor.ID}).Count(&count).Error)
+	assert.Zero(t, count)
+
+	// drain without deadline, instances are disabled and moved to another agent
+	_, err = svc.AdminAgentDrain(ctx, &AdminAgentDrain_Input{Agent: "dummy-agent-1"})
+	require.NoError(t, err)
+	require.NoError(t, db.Model(pwdb.ChallengeInstance{}).Where(pwdb.ChallengeInstance{AgentID: agentID}).Where("status <> ?", pwdb.ChallengeInstance_Disabled).Count(&count).Error)
+	assert.Zero(t, count)
+	var replacements []*pwdb.ChallengeInstance
+	require.NoError(t, db.Where(pwdb.ChallengeInstance{FlavorID: flavorID, Status: pwdb.ChallengeInstance_IsNew}).Find(&replacements).Error)
+	require.Len(t, replacements, 1)
+	assert.NotEqual(t, agentID, replacements[0].AgentID)
+
+	// cancel
+	ret, err = svc.AdminAgentDrain(ctx, &AdminAgentDrain_Input{Agent: "dummy-agent-1", Cancel: true})
+	require.NoError(t, err)
+	assert.Equal(t, pwdb.Agent_Active, ret.Agent.Status)
+	assert.Nil(t, ret.Agent.DrainDeadline)
+}
+
+func TestService_DrainsLoop(t *testing.T) {
+	svc, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t), DrainsInterval: 10 * time.Millisecond})
+	defer cleanup()
+	ctx := testingSetContextToken(context.Background(), t)
+	db := testingSvcDB(t, svc)
+
+	// the drain of an agent that never calls the API again expires from the loop
+	deadline := time.Now().Add(50 * time.Millisecond)
+	ret, err := svc.AdminAgentDrain(ctx, &AdminAgentDrain_Input{Agent: "dummy-agent-1", Deadline: &deadline})
+	require.NoError(t, err)
+	agentID := ret.Agent.ID
+
+	assert.Eventually(t, func() bool {
+		var count int
+		require.NoError(t, db.Model(pwdb.ChallengeInstance{}).Where(pwdb.ChallengeInstance{AgentID: agentID}).Where("status <> ?", pwdb.ChallengeInstance_Disabled).Count(&count).Error)
+		return count == 0
+	}, 5*time.Second, 10*time.Millisecond)
+}
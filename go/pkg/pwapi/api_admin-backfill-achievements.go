@@ -0,0 +1,28 @@
+package pwapi
+
+import (
+	"context"
+	"time"
+
+	"pathwar.land/pathwar/v2/go/pkg/errcode"
+)
+
+func (svc *service) AdminBackfillAchievements(ctx context.Context, in *AdminBackfillAchievements_Input) (*AdminBackfillAchievements_Output, error) {
+	if !isAdminContext(ctx) {
+		return nil, errcode.ErrRestrictedArea
+	}
+	if in == nil {
+		return nil, errcode.ErrMissingInput
+	}
+
+	userID, _ := userIDFromContext(ctx, svc.db)
+	// userID is only used as the author of the achievements, we don't care that it returns an error
+
+	achievements, err := backfillAchievements(svc.db, userID, time.Now())
+	if err != nil {
+		return nil, errcode.ErrEvaluateAchievements.Wrap(err)
+	}
+
+	out := AdminBackfillAchievements_Output{Achievements: achievements}
+	return &out, nil
+}
@@ -0,0 +1,98 @@
+package pwapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pathwar.land/pathwar/v2/go/internal/testutil"
+	"pathwar.land/pathwar/v2/go/pkg/errcode"
+	"pathwar.land/pathwar/v2/go/pkg/pwdb"
+)
+
+func TestService_AdminBackfillAchievements(t *testing.T) {
+	svc, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t)})
+	defer cleanup()
+	ctx := testingSetContextToken(context.Background(), t)
+	db := testingSvcDB(t, svc)
+
+	// activities unlock achievements
+	solve := testingSolveChallenge(ctx, t, svc)
+	session, activeTeam := solve.session, solve.team
+
+	var achievements []*pwdb.Achievement
+	require.NoError(t, db.Where(pwdb.Achievement{TeamID: activeTeam.ID}).Order("id asc").Find(&achievements).Error)
+	require.Len(t, achievements, 3)
+	assert.Equal(t, pwdb.Achievement_Validate1Coupon, achievements[0].Type)
+	assert.Equal(t, pwdb.Achievement_Buy1Challenge, achievements[1].Type)
+	assert.Equal(t, pwdb.Achievement_Validate1Challenge, achievements[2].Type)
+	team, err := svc.TeamGet(ctx, &TeamGet_Input{TeamID: activeTeam.ID})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), team.Item.NbAchievements)
+	var notifications []*pwdb.Notification
+	require.NoError(t, db.Where(pwdb.Notification{UserID: session.User.ID, Msg: achievementUnlockedMsg}).Find(&notifications).Error)
+	assert.Len(t, notifications, 3)
+
+	// backfill the time-based ones
+	_, err = svc.AdminBackfillAchievements(ctx, nil)
+	testSameErrcodes(t, "", errcode.ErrMissingInput, err)
+	ret, err := svc.AdminBackfillAchievements(ctx, &AdminBackfillAchievements_Input{})
+	require.NoError(t, err)
+	types := map[int64][]pwdb.Achievement_Type{}
+	for _, achievement := range ret.Achievements {
+		types[achievement.TeamID] = append(types[achievement.TeamID], achievement.Type)
+	}
+	assert.Equal(t, []pwdb.Achievement_Type{pwdb.Achievement_EarlyAdopterTop100, pwdb.Achievement_EarlyAdopterTop1000}, types[activeTeam.ID])
+
+	// idempotent
+	ret, err = svc.AdminBackfillAchievements(ctx, &AdminBackfillAchievements_Input{})
+	require.NoError(t, err)
+	assert.Empty(t, ret.Achievements)
+	team, err = svc.TeamGet(ctx, &TeamGet_Input{TeamID: activeTeam.ID})
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), team.Item.NbAchievements)
+
+	// seniority
+	created, err := evaluateTimeAchievements(db, time.Now().Add(2*366*24*time.Hour))
+	require.NoError(t, err)
+	types = map[int64][]pwdb.Achievement_Type{}
+	for _, achievement := range created {
+		types[achievement.TeamID] = append(types[achievement.TeamID], achievement.Type)
+	}
+	assert.Equal(t, []pwdb.Achievement_Type{pwdb.Achievement_Old1Year, pwdb.Achievement_Old2Years}, types[activeTeam.ID])
+}
+
+func TestCreateAchievementIfAbsent(t *testing.T) {
+	svc, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t)})
+	defer cleanup()
+	ctx := testingSetContextToken(context.Background(), t)
+	db := testingSvcDB(t, svc)
+
+	session, err := svc.UserGetSession(ctx, nil)
+	require.NoError(t, err)
+	teamID := session.User.ActiveTeamMember.Team.ID
+	userID := session.User.ID
+
+	// a concurrent evaluation granted the achievement first
+	require.NoError(t, db.Create(&pwdb.Achievement{Type: pwdb.Achievement_Buy1Challenge, TeamID: teamID, AuthorID: userID}).Error)
+
+	err = db.Transaction(func(tx *gorm.DB) error {
+		inserted, err := createAchievementIfAbsent(tx, &pwdb.Achievement{Type: pwdb.Achievement_Buy1Challenge, TeamID: teamID, AuthorID: userID})
+		require.NoError(t, err)
+		assert.False(t, inserted)
+
+		// the transaction is still usable
+		inserted, err = createAchievementIfAbsent(tx, &pwdb.Achievement{Type: pwdb.Achievement_Buy5Challenges, TeamID: teamID, AuthorID: userID})
+		require.NoError(t, err)
+		assert.True(t, inserted)
+		return nil
+	})
+	require.NoError(t, err)
+
+	var count int
+	require.NoError(t, db.Model(&pwdb.Achievement{}).Where(pwdb.Achievement{TeamID: teamID}).Count(&count).Error)
+	assert.Equal(t, 2, count)
+}
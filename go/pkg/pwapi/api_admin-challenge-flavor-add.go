@@ -19,6 +19,14 @@ func (svc *service) AdminChallengeFlavorAdd(ctx context.Context, in *AdminChalle
 		return nil, errcode.ErrMissingInput
 	}
 
+	proxyPolicy, err := in.ChallengeFlavor.ParseProxyPolicy()
+	if err != nil {
+		return nil, errcode.ErrInvalidProxyPolicy.Wrap(err)
+	}
+	if err := proxyPolicy.Validate(); err != nil {
+		return nil, err
+	}
+
 	if in.ChallengeID != "" && in.ChallengeFlavor.ChallengeID == 0 {
 		var err error
 		in.ChallengeFlavor.ChallengeID, err = pwdb.GetIDBySlugAndKind(svc.db, in.ChallengeID, "challenge")
@@ -27,7 +35,7 @@ func (svc *service) AdminChallengeFlavorAdd(ctx context.Context, in *AdminChalle
 		}
 	}
 
-	err := svc.db.Transaction(func(tx *gorm.DB) error {
+	err = svc.db.Transaction(func(tx *gorm.DB) error {
 		err := tx.Create(in.ChallengeFlavor).Error
 		switch {
 		case err != nil && strings.Contains(err.Error(), "Error 1062: Duplicate entry"):
@@ -52,45 +60,7 @@ func (svc *service) AdminChallengeFlavorAdd(ctx context.Context, in *AdminChalle
 			return errcode.ErrChallengeFlavorAdd.Wrap(err)
 		}
 
-		// testing seasons
-		{
-			var testingSeasons []*pwdb.Season
-			if err = tx.Where(pwdb.Season{IsTesting: true}).Find(&testingSeasons).Error; err != nil {
-				return err
-			}
-
-			for _, season := range testingSeasons {
-				seasonChallenge := pwdb.SeasonChallenge{
-					SeasonID: season.ID,
-					FlavorID: in.ChallengeFlavor.ID,
-				}
-				err = tx.Create(&seasonChallenge).Error
-				if err != nil {
-					return pwdb.GormToErrcode(err)
-				}
-			}
-		}
-
-		// default agents
-		{
-			var agentsToInstanciate []*pwdb.Agent
-			if err = tx.Where(pwdb.Agent{DefaultAgent: true}).Find(&agentsToInstanciate).Error; err != nil {
-				return err
-			}
-
-			for _, agent := range agentsToInstanciate {
-				instance := pwdb.ChallengeInstance{
-					Status:   pwdb.ChallengeInstance_IsNew,
-					AgentID:  agent.ID,
-					FlavorID: in.ChallengeFlavor.ID,
-				}
-				err = tx.Create(&instance).Error
-				if err != nil {
-					return pwdb.GormToErrcode(err)
-				}
-			}
-		}
-		return nil
+		return setupNewChallengeFlavor(tx, in.ChallengeFlavor.ID)
 	})
 	if err != nil {
 		return nil, err
@@ -122,3 +92,52 @@ func (in *AdminChallengeFlavorAdd_Input) ApplyDefaults() {
 		in.ChallengeFlavor.Passphrases = 1
 	}
 }
+
+// setupNewChallengeFlavor adds a new flavor to the testing seasons and creates an instance on each default agent
+func setupNewChallengeFlavor(tx *gorm.DB, flavorID int64) error {
+	var err error
+	// testing seasons
+	{
+		var testingSeasons []*pwdb.Season
+		if err = tx.Where(pwdb.Season{IsTesting: true}).Find(&testingSeasons).Error; err != nil {
+			return err
+		}
+
+		for _, season := range testingSeasons {
+			seasonChallenge := pwdb.SeasonChallenge{
+				SeasonID: season.ID,
+				FlavorID: flavorID,
+			}
+			err = tx.Create(&seasonChallenge).Error
+			if err != nil {
+				return pwdb.GormToErrcode(err)
+			}
+		}
+	}
+
+	// default agents
+	{
+		var agentsToInstanciate []*pwdb.Agent
+		err = tx.
+			Where(pwdb.Agent{DefaultAgent: true}).
+			Where("status <> ?", pwdb.Agent_Draining).
+			Find(&agentsToInstanciate).
+			Error
+		if err != nil {
+			return err
+		}
+
+		for _, agent := range agentsToInstanciate {
+			instance := pwdb.ChallengeInstance{
+				Status:   pwdb.ChallengeInstance_IsNew,
+				AgentID:  agent.ID,
+				FlavorID: flavorID,
+			}
+			err = tx.Create(&instance).Error
+			if err != nil {
+				return pwdb.GormToErrcode(err)
+			}
+		}
+	}
+	return nil
+}
@@ -32,9 +32,11 @@ func (svc *service) AdminChallengeRedump(ctx context.Context, in *AdminChallenge
 	err = svc.db.
 		Model(pwdb.ChallengeInstance{}).
 		Where("flavor_id IN (?)", flavorIDs).
-		Update(&pwdb.ChallengeInstance{
-			Status:         pwdb.ChallengeInstance_NeedRedump,
-			InstanceConfig: []byte{},
+		Updates(map[string]interface{}{
+			"status":           pwdb.ChallengeInstance_NeedRedump,
+			"instance_config":  []byte{},
+			"startup_error":    "",
+			"startup_attempts": 0,
 		}).
 		Find(&out.ChallengeInstances).
 		Error
@@ -0,0 +1,171 @@
+package pwapi
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/jinzhu/gorm"
+	"pathwar.land/pathwar/v2/go/pkg/errcode"
+	"pathwar.land/pathwar/v2/go/pkg/pwdb"
+)
+
+// AdminChallengeRegister creates or updates a challenge and its flavor from a prepared compose file.
+// It is idempotent: calling it twice with the same input does not change anything, and the instances
+// are only redumped if the compose bundle changed.
+func (svc *service) AdminChallengeRegister(ctx context.Context, in *AdminChallengeRegister_Input) (*AdminChallengeRegister_Output, error) {
+	if !isAdminContext(ctx) {
+		return nil, errcode.ErrRestrictedArea
+	}
+	if in == nil || in.Challenge == nil || in.Challenge.Slug == "" || in.ChallengeFlavor == nil {
+		return nil, errcode.ErrMissingInput
+	}
+
+	challengeInput := AdminChallengeAdd_Input{Challenge: in.Challenge}
+	challengeInput.ApplyDefaults()
+
+	// convert the fields using the yaml format to their database format
+	flavor := in.ChallengeFlavor
+	if len(flavor.RedumpPolicy) > 0 {
+		config, err := json.Marshal(flavor.RedumpPolicy)
+		if err != nil {
+			return nil, errcode.ErrChallengeRegister.Wrap(err)
+		}
+		flavor.RedumpPolicyConfig = string(config)
+		flavor.RedumpPolicy = nil
+	}
+	if flavor.ProxyPolicy != nil {
+		config, err := json.Marshal(flavor.ProxyPolicy)
+		if err != nil {
+			return nil, errcode.ErrChallengeRegister.Wrap(err)
+		}
+		flavor.ProxyPolicyConfig = string(config)
+		flavor.ProxyPolicy = nil
+	}
+	if len(flavor.Tags) > 0 {
+		flavor.TagList = strings.Join(flavor.Tags, ",")
+		flavor.Tags = nil
+	}
+	flavorInput := AdminChallengeFlavorAdd_Input{ChallengeFlavor: flavor}
+	flavorInput.ApplyDefaults()
+	proxyPolicy, err := flavor.ParseProxyPolicy()
+	if err != nil {
+		return nil, errcode.ErrInvalidProxyPolicy.Wrap(err)
+	}
+	if err := proxyPolicy.Validate(); err != nil {
+		return nil, err
+	}
+
+	seasonIDs := make([]int64, 0, len(in.Seasons))
+	for _, season := range in.Seasons {
+		seasonID, err := pwdb.GetIDBySlugAndKind(svc.db, season, "season")
+		if err != nil {
+			return nil, err
+		}
+		seasonIDs = append(seasonIDs, seasonID)
+	}
+
+	out := AdminChallengeRegister_Output{}
+	err = svc.db.Transaction(func(tx *gorm.DB) error {
+		// challenge
+		challenge := pwdb.Challenge{
+			Slug:        in.Challenge.Slug,
+			Name:        in.Challenge.Name,
+			Description: in.Challenge.Description,
+			Author:      in.Challenge.Author,
+			Locale:      in.Challenge.Locale,
+			IsDraft:     in.Challenge.IsDraft,
+			PreviewURL:  in.Challenge.PreviewURL,
+			Homepage:    in.Challenge.Homepage,
+		}
+		var existingChallenge pwdb.Challenge
+		err := tx.Where(pwdb.Challenge{Slug: challenge.Slug}).First(&existingChallenge).Error
+		switch {
+		case err == nil:
+			challenge.ID = existingChallenge.ID
+			challenge.CreatedAt = existingChallenge.CreatedAt
+		case !pwdb.IsRecordNotFoundError(err):
+			return pwdb.GormToErrcode(err)
+		}
+		if err = tx.Save(&challenge).Error; err != nil {
+			return errcode.ErrChallengeAdd.Wrap(err)
+		}
+		out.Challenge = &challenge
+
+		// flavor
+		flavor.ChallengeID = challenge.ID
+		flavor.Challenge = nil
+		var existingFlavor pwdb.ChallengeFlavor
+		err = tx.
+			Where(pwdb.ChallengeFlavor{ChallengeID: challenge.ID, Version: flavor.Version}).
+			First(&existingFlavor).
+			Error
+		switch {
+		case pwdb.IsRecordNotFoundError(err):
+			flavor.ID = 0
+			flavor.Slug = ""
+			if err = tx.Create(flavor).Error; err != nil {
+				return errcode.ErrChallengeFlavorAdd.Wrap(err)
+			}
+			if err = setupNewChallengeFlavor(tx, flavor.ID); err != nil {
+				return err
+			}
+			out.FlavorCreated = true
+		case err != nil:
+			return pwdb.GormToErrcode(err)
+		default:
+			flavor.ID = existingFlavor.ID
+			flavor.Slug = existingFlavor.Slug
+			flavor.CreatedAt = existingFlavor.CreatedAt
+			if err = tx.Save(flavor).Error; err != nil {
+				return errcode.ErrChallengeFlavorAdd.Wrap(err)
+			}
+			out.BundleChanged = existingFlavor.ComposeBundle != flavor.ComposeBundle
+		}
+		out.ChallengeFlavor = flavor
+
+		// redump the running instances of the flavor
+		if out.BundleChanged {
+			err = tx.
+				Model(pwdb.ChallengeInstance{}).
+				Where(pwdb.ChallengeInstance{FlavorID: flavor.ID}).
+				Where("status <> ?", pwdb.ChallengeInstance_Disabled).
+				Updates(map[string]interface{}{
+					"status":           pwdb.ChallengeInstance_NeedRedump,
+					"instance_config":  []byte{},
+					"startup_error":    "",
+					"startup_attempts": 0,
+				}).
+				Error
+			if err != nil {
+				return pwdb.GormToErrcode(err)
+			}
+			err = tx.
+				Where(pwdb.ChallengeInstance{FlavorID: flavor.ID, Status: pwdb.ChallengeInstance_NeedRedump}).
+				Find(&out.RedumpedInstances).
+				Error
+			if err != nil {
+				return pwdb.GormToErrcode(err)
+			}
+		}
+
+		// seasons
+		for _, seasonID := range seasonIDs {
+			var seasonChallenge pwdb.SeasonChallenge
+			err = tx.
+				Where(pwdb.SeasonChallenge{SeasonID: seasonID, FlavorID: flavor.ID}).
+				FirstOrCreate(&seasonChallenge).
+				Error
+			if err != nil {
+				return errcode.ErrSeasonChallengeAdd.Wrap(err)
+			}
+			out.SeasonChallenges = append(out.SeasonChallenges, &seasonChallenge)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errcode.ErrChallengeRegister.Wrap(err)
+	}
+
+	return &out, nil
+}
@@ -0,0 +1,85 @@
+package pwapi
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pathwar.land/pathwar/v2/go/internal/testutil"
+	"pathwar.land/pathwar/v2/go/pkg/errcode"
+	"pathwar.land/pathwar/v2/go/pkg/pwdb"
+)
+
+func TestService_AdminChallengeRegister(t *testing.T) {
+	svc, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t)})
+	defer cleanup()
+	ctx := testingSetContextToken(context.Background(), t)
+	db := testingSvcDB(t, svc)
+
+	// invalid input
+	_, err := svc.AdminChallengeRegister(ctx, nil)
+	testSameErrcodes(t, "", errcode.ErrMissingInput, err)
+	_, err = svc.AdminChallengeRegister(ctx, &AdminChallengeRegister_Input{Challenge: &pwdb.Challenge{Slug: "register-test"}})
+	testSameErrcodes(t, "", errcode.ErrMissingInput, err)
+	_, err = svc.AdminChallengeRegister(ctx, &AdminChallengeRegister_Input{
+		Challenge:       &pwdb.Challenge{Slug: "register-test"},
+		ChallengeFlavor: &pwdb.ChallengeFlavor{},
+		Seasons:         []string{"unknown-season"},
+	})
+	testSameErrcodes(t, "", errcode.ErrNoSuchSlug, err)
+
+	input := func(bundle string) *AdminChallengeRegister_Input {
+		return &AdminChallengeRegister_Input{
+			Challenge:       &pwdb.Challenge{Slug: "register-test", Name: "Register Test"},
+			ChallengeFlavor: &pwdb.ChallengeFlavor{Version: "1.0.0", ComposeBundle: bundle, Tags: []string{"a", "b"}},
+			Seasons:         []string{"global", "unit-test-season"},
+		}
+	}
+
+	// first registration creates the challenge and the flavor
+	ret, err := svc.AdminChallengeRegister(ctx, input("bundle-1"))
+	require.NoError(t, err)
+	assert.True(t, ret.FlavorCreated)
+	assert.False(t, ret.BundleChanged)
+	assert.Equal(t, "register-test", ret.Challenge.Slug)
+	assert.Equal(t, "a,b", ret.ChallengeFlavor.TagList)
+	assert.Len(t, ret.SeasonChallenges, 2)
+	challengeID := ret.Challenge.ID
+	flavorID := ret.ChallengeFlavor.ID
+	flavorSlug := ret.ChallengeFlavor.Slug
+	var seasonChallenges int
+	require.NoError(t, db.Model(pwdb.SeasonChallenge{}).Where(pwdb.SeasonChallenge{FlavorID: flavorID}).Count(&seasonChallenges).Error)
+
+	var agent pwdb.Agent
+	require.NoError(t, db.Where(pwdb.Agent{Name: "dummy-agent-1"}).First(&agent).Error)
+	instance := pwdb.ChallengeInstance{AgentID: agent.ID, FlavorID: flavorID, Status: pwdb.ChallengeInstance_Available, InstanceConfig: []byte("{}")}
+	require.NoError(t, db.Create(&instance).Error)
+
+	// same input, nothing changes
+	ret, err = svc.AdminChallengeRegister(ctx, input("bundle-1"))
+	require.NoError(t, err)
+	assert.False(t, ret.FlavorCreated)
+	assert.False(t, ret.BundleChanged)
+	assert.Empty(t, ret.RedumpedInstances)
+	assert.Equal(t, challengeID, ret.Challenge.ID)
+	assert.Equal(t, flavorID, ret.ChallengeFlavor.ID)
+	assert.Equal(t, flavorSlug, ret.ChallengeFlavor.Slug)
+	assert.Len(t, ret.SeasonChallenges, 2)
+	var count int
+	require.NoError(t, db.Model(pwdb.SeasonChallenge{}).Where(pwdb.SeasonChallenge{FlavorID: flavorID}).Count(&count).Error)
+	assert.Equal(t, seasonChallenges, count)
+	require.NoError(t, db.First(&instance, instance.ID).Error)
+	assert.Equal(t, pwdb.ChallengeInstance_Available, instance.Status)
+
+	// the compose bundle changed, instances are redumped
+	ret, err = svc.AdminChallengeRegister(ctx, input("bundle-2"))
+	require.NoError(t, err)
+	assert.False(t, ret.FlavorCreated)
+	assert.True(t, ret.BundleChanged)
+	require.Len(t, ret.RedumpedInstances, 1)
+	assert.Equal(t, instance.ID, ret.RedumpedInstances[0].ID)
+	require.NoError(t, db.First(&instance, instance.ID).Error)
+	assert.Equal(t, pwdb.ChallengeInstance_NeedRedump, instance.Status)
+	assert.Empty(t, instance.InstanceConfig)
+}
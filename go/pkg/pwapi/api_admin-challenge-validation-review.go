@@ -0,0 +1,114 @@
+package pwapi
+
+import (
+	"context"
+
+	"github.com/jinzhu/gorm"
+	"pathwar.land/pathwar/v2/go/pkg/errcode"
+	"pathwar.land/pathwar/v2/go/pkg/pwdb"
+)
+
+func (svc *service) AdminChallengeValidationReview(ctx context.Context, in *AdminChallengeValidationReview_Input) (*AdminChallengeValidationReview_Output, error) {
+	if !isAdminContext(ctx) {
+		return nil, errcode.ErrRestrictedArea
+	}
+	if in == nil || in.ChallengeValidationID == 0 {
+		return nil, errcode.ErrMissingInput
+	}
+
+	userID, _ := userIDFromContext(ctx, svc.db)
+	// userID is only used as the author of the score activities, we don't care that it returns an error
+
+	var validation pwdb.ChallengeValidation
+	err := svc.db.
+		Preload("ChallengeSubscription").
+		Preload("ChallengeSubscription.SeasonChallenge").
+		Preload("ChallengeSubscription.SeasonChallenge.Season").
+		First(&validation, in.ChallengeValidationID).
+		Error
+	if err != nil {
+		return nil, errcode.ErrGetChallengeValidation.Wrap(err)
+	}
+	subscription := validation.ChallengeSubscription
+
+	status := pwdb.ChallengeValidation_Accepted
+	if in.Refuse {
+		status = pwdb.ChallengeValidation_Refused
+	}
+
+	err = svc.db.Transaction(func(tx *gorm.DB) error {
+		err := lockSeasonChallenge(tx, subscription.SeasonChallengeID)
+		if err != nil {
+			return errcode.ErrReviewChallengeValidation.Wrap(err)
+		}
+
+		// only the pending validations can be reviewed, the status is checked again once the season challenge is locked
+		result := tx.
+			Model(&pwdb.ChallengeValidation{}).
+			Where("id = ? AND status = ?", validation.ID, pwdb.ChallengeValidation_NeedReview).
+			UpdateColumns(pwdb.ChallengeValidation{Status: status, CorrectorComment: in.CorrectorComment})
+		if result.Error != nil {
+			return errcode.ErrReviewChallengeValidation.Wrap(result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return errcode.ErrChallengeValidationAlreadyReviewed
+		}
+
+		if status == pwdb.ChallengeValidation_Accepted {
+			if err := updateSeasonChallengeScores(tx, subscription.SeasonChallenge, validation.TeamID, userID); err != nil {
+				return errcode.ErrUpdateTeamScore.Wrap(err)
+			}
+			if _, err := awardMedals(tx, subscription.SeasonChallengeID); err != nil {
+				return errcode.ErrUpdateTeamMedals.Wrap(err)
+			}
+		} else {
+			// reopen the subscription so the team can submit its passphrases again
+			err = tx.
+				Model(subscription).
+				Updates(map[string]interface{}{
+					"status":    pwdb.ChallengeSubscription_Active,
+					"closed_at": nil,
+					"closer_id": 0,
+				}).
+				Error
+			if err != nil {
+				return errcode.ErrUpdateChallengeSubscription.Wrap(err)
+			}
+		}
+
+		userIDs, err := teamMemberIDs(tx, validation.TeamID)
+		if err != nil {
+			return errcode.ErrCreateNotification.Wrap(err)
+		}
+		args := map[string]interface{}{
+			"challenge_validation_id": validation.ID,
+			"season_challenge_id":     subscription.SeasonChallengeID,
+			"status":                  status.String(),
+		}
+		if err := notifyUsers(tx, userIDs, challengeValidationReviewedMsg, seasonChallengeURL(subscription.SeasonChallengeID), args); err != nil {
+			return errcode.ErrCreateNotification.Wrap(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if status == pwdb.ChallengeValidation_Accepted {
+		svc.checkTeamAchievements(validation.TeamID, userID)
+	}
+
+	err = svc.db.
+		Preload("Author").
+		Preload("ChallengeSubscription").
+		Preload("ChallengeSubscription.SeasonChallenge").
+		Preload("ChallengeSubscription.Team").
+		First(&validation, validation.ID).
+		Error
+	if err != nil {
+		return nil, errcode.ErrGetChallengeValidation.Wrap(err)
+	}
+
+	out := AdminChallengeValidationReview_Output{ChallengeValidation: &validation}
+	return &out, nil
+}
@@ -0,0 +1,94 @@
+package pwapi
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pathwar.land/pathwar/v2/go/internal/testutil"
+	"pathwar.land/pathwar/v2/go/pkg/errcode"
+	"pathwar.land/pathwar/v2/go/pkg/pwdb"
+)
+
+func TestService_AdminChallengeValidationReview(t *testing.T) {
+	svc, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t)})
+	defer cleanup()
+	ctx := testingSetContextToken(context.Background(), t)
+	db := testingSvcDB(t, svc)
+	gs := testingGlobalSeason(t, svc)
+
+	solve := testingBuyChallenge(ctx, t, svc)
+	session, activeTeam, flavor := solve.session, solve.team, solve.seasonChallenge.Flavor
+	var instances []*pwdb.ChallengeInstance
+	require.NoError(t, db.Where(pwdb.ChallengeInstance{FlavorID: flavor.ID}).Find(&instances).Error)
+
+	// the validations of the other seasons need a review
+	require.NoError(t, db.Model(&pwdb.Season{}).Where("id = ?", gs.ID).UpdateColumn("is_global", false).Error)
+	validate := func() *pwdb.ChallengeValidation {
+		validation := testingValidateChallenge(ctx, t, svc, solve.subscription.ID)
+		assert.Equal(t, pwdb.ChallengeValidation_NeedReview, validation.Status)
+		return validation
+	}
+	reviewed := func() []*pwdb.Notification {
+		var notifications []*pwdb.Notification
+		require.NoError(t, db.Where(pwdb.Notification{UserID: session.User.ID, Msg: challengeValidationReviewedMsg}).Order("id asc").Find(&notifications).Error)
+		return notifications
+	}
+	score := func() int64 {
+		team, err := svc.TeamGet(ctx, &TeamGet_Input{TeamID: activeTeam.ID})
+		require.NoError(t, err)
+		return team.Item.Score
+	}
+	validation := validate()
+	assert.Empty(t, reviewed())
+	assert.Equal(t, int64(0), score())
+
+	var tests = []struct {
+		name        string
+		ctx         context.Context
+		input       *AdminChallengeValidationReview_Input
+		expectedErr error
+	}{
+		{"not-admin", context.Background(), &AdminChallengeValidationReview_Input{ChallengeValidationID: validation.ID}, errcode.ErrRestrictedArea},
+		{"nil", ctx, nil, errcode.ErrMissingInput},
+		{"empty", ctx, &AdminChallengeValidationReview_Input{}, errcode.ErrMissingInput},
+		{"unknown-validation", ctx, &AdminChallengeValidationReview_Input{ChallengeValidationID: -42}, errcode.ErrGetChallengeValidation},
+	}
+	for _, test := range tests {
+		_, err := svc.AdminChallengeValidationReview(test.ctx, test.input)
+		testSameErrcodes(t, test.name, test.expectedErr, err)
+	}
+
+	// a refused validation reopens the subscription
+	ret, err := svc.AdminChallengeValidationReview(ctx, &AdminChallengeValidationReview_Input{ChallengeValidationID: validation.ID, Refuse: true, CorrectorComment: "nope"})
+	require.NoError(t, err)
+	assert.Equal(t, pwdb.ChallengeValidation_Refused, ret.ChallengeValidation.Status)
+	assert.Equal(t, "nope", ret.ChallengeValidation.CorrectorComment)
+	assert.Equal(t, pwdb.ChallengeSubscription_Active, ret.ChallengeValidation.ChallengeSubscription.Status)
+	assert.Nil(t, ret.ChallengeValidation.ChallengeSubscription.ClosedAt)
+	assert.Equal(t, int64(0), score())
+	notifications := reviewed()
+	require.Len(t, notifications, 1)
+	assert.Contains(t, notifications[0].Args, `"status":"Refused"`)
+
+	// an accepted validation scores
+	for _, instance := range instances {
+		// the agent redumped the validated instances
+		require.NoError(t, db.Save(instance).Error)
+	}
+	validation = validate()
+	ret, err = svc.AdminChallengeValidationReview(ctx, &AdminChallengeValidationReview_Input{ChallengeValidationID: validation.ID})
+	require.NoError(t, err)
+	assert.Equal(t, pwdb.ChallengeValidation_Accepted, ret.ChallengeValidation.Status)
+	assert.Equal(t, pwdb.ChallengeSubscription_Closed, ret.ChallengeValidation.ChallengeSubscription.Status)
+	assert.Equal(t, flavor.ValidationReward, score())
+	notifications = reviewed()
+	require.Len(t, notifications, 2)
+	assert.Contains(t, notifications[1].Args, `"status":"Accepted"`)
+
+	// a validation is reviewed once
+	_, err = svc.AdminChallengeValidationReview(ctx, &AdminChallengeValidationReview_Input{ChallengeValidationID: validation.ID, Refuse: true})
+	testSameErrcodes(t, "", errcode.ErrChallengeValidationAlreadyReviewed, err)
+	assert.Equal(t, flavor.ValidationReward, score())
+}
@@ -15,8 +15,17 @@ func (svc *service) AdminListActivities(ctx context.Context, in *AdminListActivi
 		return nil, errcode.ErrMissingInput
 	}
 
+	query, total, err := listQuery(svc.db, in.ListOptions, listFilter{seasonID: in.SeasonID}, listConfig{
+		table:        "activity",
+		orderFields:  map[string]string{"kind": "activity.kind"},
+		seasonFilter: "activity.season_id = ?",
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	var activities []*pwdb.Activity
-	err := svc.db.
+	err = query.
 		Preload("Author").
 		Preload("Team").
 		Preload("User").
@@ -35,6 +44,10 @@ func (svc *service) AdminListActivities(ctx context.Context, in *AdminListActivi
 		return nil, errcode.ErrListActivities.Wrap(err)
 	}
 
-	out := AdminListActivities_Output{Activities: activities}
+	out := AdminListActivities_Output{
+		Activities: activities,
+		Total:      total,
+		NextOffset: listNextOffset(in.ListOptions, len(activities), total),
+	}
 	return &out, nil
 }
@@ -15,14 +15,28 @@ func (svc *service) AdminListAgents(ctx context.Context, in *AdminListAgents_Inp
 		return nil, errcode.ErrMissingInput
 	}
 
+	query, total, err := listQuery(svc.db, in.ListOptions, listFilter{status: in.Status}, listConfig{
+		table:        "agent",
+		orderFields:  map[string]string{"name": "agent.name"},
+		statusColumn: "status",
+		statusValues: pwdb.Agent_Status_value,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	var agents []*pwdb.Agent
-	err := svc.db.
+	err = query.
 		Preload("ChallengeInstances").
 		Find(&agents).Error
 	if err != nil {
 		return nil, errcode.ErrListAgents.Wrap(err)
 	}
 
-	out := AdminListAgents_Output{Agents: agents}
+	out := AdminListAgents_Output{
+		Agents:     agents,
+		Total:      total,
+		NextOffset: listNextOffset(in.ListOptions, len(agents), total),
+	}
 	return &out, nil
 }
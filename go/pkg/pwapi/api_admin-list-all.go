@@ -2,6 +2,7 @@ package pwapi
 
 import (
 	"context"
+	"reflect"
 
 	"pathwar.land/pathwar/v2/go/pkg/errcode"
 	"pathwar.land/pathwar/v2/go/pkg/pwdb"
@@ -11,112 +12,53 @@ func (svc *service) AdminListAll(ctx context.Context, in *AdminListAll_Input) (*
 	if !isAdminContext(ctx) {
 		return nil, errcode.ErrRestrictedArea
 	}
-
-	out := AdminListAll_Output{}
-	err := svc.db.
-		Find(&out.Challenges).Error
-	if err != nil {
-		return nil, pwdb.GormToErrcode(err)
-	}
-	err = svc.db.
-		Find(&out.ChallengeFlavors).Error
-	if err != nil {
-		return nil, pwdb.GormToErrcode(err)
-	}
-	err = svc.db.
-		Find(&out.SeasonChallenges).Error
-	if err != nil {
-		return nil, pwdb.GormToErrcode(err)
-	}
-	err = svc.db.
-		Find(&out.ChallengeInstances).Error
-	if err != nil {
-		return nil, pwdb.GormToErrcode(err)
-	}
-	err = svc.db.
-		Find(&out.Agents).Error
-	if err != nil {
-		return nil, pwdb.GormToErrcode(err)
-	}
-	err = svc.db.
-		Find(&out.OrganizationMembers).Error
-	if err != nil {
-		return nil, pwdb.GormToErrcode(err)
-	}
-	err = svc.db.
-		Find(&out.TeamMembers).Error
-	if err != nil {
-		return nil, pwdb.GormToErrcode(err)
-	}
-	err = svc.db.
-		Find(&out.TeamInvites).Error
-	if err != nil {
-		return nil, pwdb.GormToErrcode(err)
-	}
-	err = svc.db.
-		Find(&out.Users).Error
-	if err != nil {
-		return nil, pwdb.GormToErrcode(err)
-	}
-	err = svc.db.
-		Find(&out.Organizations).Error
-	if err != nil {
-		return nil, pwdb.GormToErrcode(err)
-	}
-	err = svc.db.
-		Find(&out.Seasons).Error
-	if err != nil {
-		return nil, pwdb.GormToErrcode(err)
+	if in == nil {
+		return nil, errcode.ErrMissingInput
 	}
-	err = svc.db.
-		Find(&out.Teams).Error
-	if err != nil {
-		return nil, pwdb.GormToErrcode(err)
-	}
-	err = svc.db.
-		Find(&out.WhoswhoAttempts).Error
-	if err != nil {
-		return nil, pwdb.GormToErrcode(err)
-	}
-	err = svc.db.
-		Find(&out.ChallengeValidations).Error
-	if err != nil {
-		return nil, pwdb.GormToErrcode(err)
-	}
-	err = svc.db.
-		Find(&out.ChallengeSubscriptions).Error
-	if err != nil {
-		return nil, pwdb.GormToErrcode(err)
-	}
-	err = svc.db.
-		Find(&out.InventoryItems).Error
-	if err != nil {
-		return nil, pwdb.GormToErrcode(err)
-	}
-	err = svc.db.
-		Find(&out.Notifications).Error
-	if err != nil {
-		return nil, pwdb.GormToErrcode(err)
-	}
-	err = svc.db.
-		Find(&out.Coupons).Error
-	if err != nil {
-		return nil, pwdb.GormToErrcode(err)
-	}
-	err = svc.db.
-		Find(&out.CouponValidations).Error
-	if err != nil {
-		return nil, pwdb.GormToErrcode(err)
-	}
-	err = svc.db.
-		Find(&out.Achievements).Error
-	if err != nil {
-		return nil, pwdb.GormToErrcode(err)
-	}
-	err = svc.db.
-		Find(&out.Activities).Error
-	if err != nil {
-		return nil, pwdb.GormToErrcode(err)
+
+	// the page, sort order and created range apply to each table
+	out := AdminListAll_Output{Totals: make(map[string]int64)}
+	lists := []interface{}{
+		&out.Challenges,
+		&out.ChallengeFlavors,
+		&out.SeasonChallenges,
+		&out.ChallengeInstances,
+		&out.Agents,
+		&out.OrganizationMembers,
+		&out.TeamMembers,
+		&out.TeamInvites,
+		&out.Users,
+		&out.Organizations,
+		&out.Seasons,
+		&out.Teams,
+		&out.WhoswhoAttempts,
+		&out.ChallengeValidations,
+		&out.ChallengeSubscriptions,
+		&out.InventoryItems,
+		&out.Notifications,
+		&out.Coupons,
+		&out.CouponValidations,
+		&out.Achievements,
+		&out.Activities,
+	}
+	for _, list := range lists {
+		table := svc.db.NewScope(list).TableName()
+		query, total, err := listQuery(svc.db, in.ListOptions, listFilter{}, listConfig{table: table})
+		if err != nil {
+			return nil, err
+		}
+		err = query.
+			Find(list).Error
+		if err != nil {
+			return nil, pwdb.GormToErrcode(err)
+		}
+		out.Totals[table] = total
+
+		// the next page is the same for every table, and exists while one of them has more items
+		count := reflect.ValueOf(list).Elem().Len()
+		if next := listNextOffset(in.ListOptions, count, total); next > out.NextOffset {
+			out.NextOffset = next
+		}
 	}
 
 	return &out, nil
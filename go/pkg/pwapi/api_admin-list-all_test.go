@@ -0,0 +1,51 @@
+package pwapi
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pathwar.land/pathwar/v2/go/internal/testutil"
+	"pathwar.land/pathwar/v2/go/pkg/errcode"
+)
+
+func TestService_AdminListAll(t *testing.T) {
+	svc, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t)})
+	defer cleanup()
+	ctx := testingSetContextToken(context.Background(), t)
+
+	_, err := svc.AdminListAll(context.Background(), &AdminListAll_Input{})
+	testSameErrcodes(t, "", errcode.ErrRestrictedArea, err)
+
+	all, err := svc.AdminListAll(ctx, &AdminListAll_Input{})
+	require.NoError(t, err)
+	require.True(t, len(all.Challenges) > 2)
+	assert.Equal(t, int64(len(all.Challenges)), all.Totals["challenge"])
+	assert.Equal(t, int64(len(all.ChallengeFlavors)), all.Totals["challenge_flavor"])
+	assert.Equal(t, int64(len(all.Seasons)), all.Totals["season"])
+	assert.Len(t, all.Totals, 21)
+	assert.Equal(t, int64(0), all.NextOffset)
+
+	// pages
+	first, err := svc.AdminListAll(ctx, &AdminListAll_Input{ListOptions: ListOptions{Limit: 1}})
+	require.NoError(t, err)
+	assert.Len(t, first.Challenges, 1)
+	assert.Equal(t, all.Totals, first.Totals)
+	assert.Equal(t, int64(1), first.NextOffset)
+	var largest int64
+	for _, total := range all.Totals {
+		if total > largest {
+			largest = total
+		}
+	}
+	last, err := svc.AdminListAll(ctx, &AdminListAll_Input{ListOptions: ListOptions{Offset: largest - 1}})
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), last.NextOffset)
+	almostLast, err := svc.AdminListAll(ctx, &AdminListAll_Input{ListOptions: ListOptions{Offset: largest - 2, Limit: 1}})
+	require.NoError(t, err)
+	assert.Equal(t, largest-1, almostLast.NextOffset)
+
+	_, err = svc.AdminListAll(ctx, &AdminListAll_Input{ListOptions: ListOptions{Limit: -1}})
+	testSameErrcodes(t, "", errcode.ErrInvalidListOptions, err)
+}
@@ -15,8 +15,19 @@ func (svc *service) AdminListChallengeSubscriptions(ctx context.Context, in *Adm
 		return nil, errcode.ErrMissingInput
 	}
 
+	query, total, err := listQuery(svc.db, in.ListOptions, listFilter{seasonID: in.SeasonID, status: in.Status}, listConfig{
+		table:        "challenge_subscription",
+		orderFields:  map[string]string{"closed_at": "challenge_subscription.closed_at"},
+		seasonFilter: "challenge_subscription.season_challenge_id IN (SELECT season_challenge.id FROM season_challenge WHERE season_challenge.season_id = ?)",
+		statusColumn: "status",
+		statusValues: pwdb.ChallengeSubscription_Status_value,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	var challengeSubscriptions []*pwdb.ChallengeSubscription
-	err := svc.db.
+	err = query.
 		Preload("Team").
 		Preload("Team.Organization").
 		Preload("Closer").
@@ -31,6 +42,10 @@ func (svc *service) AdminListChallengeSubscriptions(ctx context.Context, in *Adm
 		return nil, errcode.ErrListChallengeSubscriptions.Wrap(err)
 	}
 
-	out := AdminListChallengeSubscriptions_Output{Subscriptions: challengeSubscriptions}
+	out := AdminListChallengeSubscriptions_Output{
+		Subscriptions: challengeSubscriptions,
+		Total:         total,
+		NextOffset:    listNextOffset(in.ListOptions, len(challengeSubscriptions), total),
+	}
 	return &out, nil
 }
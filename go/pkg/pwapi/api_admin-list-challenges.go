@@ -15,8 +15,17 @@ func (svc *service) AdminListChallenges(ctx context.Context, in *AdminListChalle
 		return nil, errcode.ErrMissingInput
 	}
 
+	query, total, err := listQuery(svc.db, in.ListOptions, listFilter{seasonID: in.SeasonID}, listConfig{
+		table:        "challenge",
+		orderFields:  map[string]string{"name": "challenge.name"},
+		seasonFilter: "challenge.id IN (SELECT challenge_flavor.challenge_id FROM challenge_flavor JOIN season_challenge ON season_challenge.flavor_id = challenge_flavor.id WHERE season_challenge.season_id = ?)",
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	var challenges []*pwdb.Challenge
-	err := svc.db.
+	err = query.
 		Preload("Flavors").
 		Preload("Flavors.Instances").
 		Preload("Flavors.Instances.Agent").
@@ -27,6 +36,10 @@ func (svc *service) AdminListChallenges(ctx context.Context, in *AdminListChalle
 		return nil, errcode.ErrListChallengeInstances.Wrap(err)
 	}
 
-	out := AdminListChallenges_Output{Challenges: challenges}
+	out := AdminListChallenges_Output{
+		Challenges: challenges,
+		Total:      total,
+		NextOffset: listNextOffset(in.ListOptions, len(challenges), total),
+	}
 	return &out, nil
 }
@@ -0,0 +1,80 @@
+package pwapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pathwar.land/pathwar/v2/go/internal/testutil"
+	"pathwar.land/pathwar/v2/go/pkg/errcode"
+)
+
+func TestService_AdminListChallenges(t *testing.T) {
+	svc, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t)})
+	defer cleanup()
+	ctx := testingSetContextToken(context.Background(), t)
+
+	all, err := svc.AdminListChallenges(ctx, &AdminListChallenges_Input{})
+	require.NoError(t, err)
+	require.True(t, len(all.Challenges) > 2)
+	assert.Equal(t, int64(len(all.Challenges)), all.Total)
+	assert.Equal(t, int64(0), all.NextOffset)
+	for i := 1; i < len(all.Challenges); i++ {
+		assert.True(t, all.Challenges[i-1].ID < all.Challenges[i].ID)
+	}
+
+	// pages
+	first, err := svc.AdminListChallenges(ctx, &AdminListChallenges_Input{ListOptions: ListOptions{Limit: 2}})
+	require.NoError(t, err)
+	require.Len(t, first.Challenges, 2)
+	assert.Equal(t, all.Total, first.Total)
+	assert.Equal(t, int64(2), first.NextOffset)
+	assert.Equal(t, all.Challenges[0].ID, first.Challenges[0].ID)
+	second, err := svc.AdminListChallenges(ctx, &AdminListChallenges_Input{ListOptions: ListOptions{Limit: 2, Offset: first.NextOffset}})
+	require.NoError(t, err)
+	require.NotEmpty(t, second.Challenges)
+	assert.Equal(t, all.Challenges[2].ID, second.Challenges[0].ID)
+
+	// sort order
+	desc, err := svc.AdminListChallenges(ctx, &AdminListChallenges_Input{ListOptions: ListOptions{OrderBy: "-id", Limit: 1}})
+	require.NoError(t, err)
+	require.Len(t, desc.Challenges, 1)
+	assert.Equal(t, all.Challenges[len(all.Challenges)-1].ID, desc.Challenges[0].ID)
+
+	// filters
+	season := testingGlobalSeason(t, svc)
+	bySeason, err := svc.AdminListChallenges(ctx, &AdminListChallenges_Input{SeasonID: season.ID})
+	require.NoError(t, err)
+	require.NotEmpty(t, bySeason.Challenges)
+	for _, challenge := range bySeason.Challenges {
+		inSeason := false
+		for _, flavor := range challenge.Flavors {
+			for _, seasonChallenge := range flavor.SeasonChallenges {
+				inSeason = inSeason || seasonChallenge.SeasonID == season.ID
+			}
+		}
+		assert.Truef(t, inSeason, "%s is not in the season", challenge.Slug)
+	}
+	future := time.Now().Add(time.Hour)
+	none, err := svc.AdminListChallenges(ctx, &AdminListChallenges_Input{ListOptions: ListOptions{CreatedAfter: &future}})
+	require.NoError(t, err)
+	assert.Empty(t, none.Challenges)
+	assert.Equal(t, int64(0), none.Total)
+
+	// invalid options
+	var tests = []struct {
+		name  string
+		input *AdminListChallenges_Input
+	}{
+		{"negative-limit", &AdminListChallenges_Input{ListOptions: ListOptions{Limit: -1}}},
+		{"negative-offset", &AdminListChallenges_Input{ListOptions: ListOptions{Offset: -1}}},
+		{"unknown-order", &AdminListChallenges_Input{ListOptions: ListOptions{OrderBy: "id; DROP TABLE challenge"}}},
+		{"empty-range", &AdminListChallenges_Input{ListOptions: ListOptions{CreatedAfter: &future, CreatedBefore: &future}}},
+	}
+	for _, test := range tests {
+		_, err := svc.AdminListChallenges(ctx, test.input)
+		testSameErrcodes(t, test.name, errcode.ErrInvalidListOptions, err)
+	}
+}
@@ -15,8 +15,17 @@ func (svc *service) AdminListCoupons(ctx context.Context, in *AdminListCoupons_I
 		return nil, errcode.ErrMissingInput
 	}
 
+	query, total, err := listQuery(svc.db, in.ListOptions, listFilter{seasonID: in.SeasonID}, listConfig{
+		table:        "coupon",
+		orderFields:  map[string]string{"value": "coupon.value"},
+		seasonFilter: "coupon.season_id = ?",
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	var coupons []*pwdb.Coupon
-	err := svc.db.
+	err = query.
 		Preload("Season").
 		Preload("Validations").
 		Preload("Validations.Author").
@@ -27,6 +36,10 @@ func (svc *service) AdminListCoupons(ctx context.Context, in *AdminListCoupons_I
 		return nil, errcode.ErrListCoupons.Wrap(err)
 	}
 
-	out := AdminListCoupons_Output{Coupons: coupons}
+	out := AdminListCoupons_Output{
+		Coupons:    coupons,
+		Total:      total,
+		NextOffset: listNextOffset(in.ListOptions, len(coupons), total),
+	}
 	return &out, nil
 }
@@ -15,8 +15,19 @@ func (svc *service) AdminListOrganizations(ctx context.Context, in *AdminListOrg
 		return nil, errcode.ErrMissingInput
 	}
 
+	query, total, err := listQuery(svc.db, in.ListOptions, listFilter{seasonID: in.SeasonID, status: in.Status}, listConfig{
+		table:        "organization",
+		orderFields:  map[string]string{"name": "organization.name"},
+		seasonFilter: "organization.id IN (SELECT team.organization_id FROM team WHERE team.season_id = ?)",
+		statusColumn: "deletion_status",
+		statusValues: pwdb.DeletionStatus_value,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	var organizations []*pwdb.Organization
-	err := svc.db.
+	err = query.
 		Preload("Teams").
 		Preload("Teams.Season").
 		Preload("Members").
@@ -27,6 +38,10 @@ func (svc *service) AdminListOrganizations(ctx context.Context, in *AdminListOrg
 		return nil, errcode.ErrListOrganizations.Wrap(err)
 	}
 
-	out := AdminListOrganizations_Output{Organizations: organizations}
+	out := AdminListOrganizations_Output{
+		Organizations: organizations,
+		Total:         total,
+		NextOffset:    listNextOffset(in.ListOptions, len(organizations), total),
+	}
 	return &out, nil
 }
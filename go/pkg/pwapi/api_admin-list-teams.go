@@ -15,8 +15,19 @@ func (svc *service) AdminListTeams(ctx context.Context, in *AdminListTeams_Input
 		return nil, errcode.ErrMissingInput
 	}
 
+	query, total, err := listQuery(svc.db, in.ListOptions, listFilter{seasonID: in.SeasonID, status: in.Status}, listConfig{
+		table:        "team",
+		orderFields:  map[string]string{"score": "team.score", "cash": "team.cash", "last_scored_at": "team.last_scored_at"},
+		seasonFilter: "team.season_id = ?",
+		statusColumn: "deletion_status",
+		statusValues: pwdb.DeletionStatus_value,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	var teams []*pwdb.Team
-	err := svc.db.
+	err = query.
 		Preload("Season").
 		Preload("Organization").
 		Preload("Achievements").
@@ -31,6 +42,10 @@ func (svc *service) AdminListTeams(ctx context.Context, in *AdminListTeams_Input
 		return nil, errcode.ErrListTeams.Wrap(err)
 	}
 
-	out := AdminListTeams_Output{Teams: teams}
+	out := AdminListTeams_Output{
+		Teams:      teams,
+		Total:      total,
+		NextOffset: listNextOffset(in.ListOptions, len(teams), total),
+	}
 	return &out, nil
 }
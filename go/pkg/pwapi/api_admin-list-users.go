@@ -15,8 +15,19 @@ func (svc *service) AdminListUsers(ctx context.Context, in *AdminListUsers_Input
 		return nil, errcode.ErrMissingInput
 	}
 
+	query, total, err := listQuery(svc.db, in.ListOptions, listFilter{seasonID: in.SeasonID, status: in.Status}, listConfig{
+		table:        "user",
+		orderFields:  map[string]string{"username": "user.username"},
+		seasonFilter: "user.id IN (SELECT team_member.user_id FROM team_member JOIN team ON team.id = team_member.team_id WHERE team.season_id = ?)",
+		statusColumn: "deletion_status",
+		statusValues: pwdb.DeletionStatus_value,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	var users []*pwdb.User
-	err := svc.db.
+	err = query.
 		Preload("TeamMemberships").
 		Preload("TeamMemberships.Team").
 		Preload("OrganizationMemberships").
@@ -26,6 +37,10 @@ func (svc *service) AdminListUsers(ctx context.Context, in *AdminListUsers_Input
 		return nil, errcode.ErrListUsers.Wrap(err)
 	}
 
-	out := AdminListUsers_Output{Users: users}
+	out := AdminListUsers_Output{
+		Users:      users,
+		Total:      total,
+		NextOffset: listNextOffset(in.ListOptions, len(users), total),
+	}
 	return &out, nil
 }
@@ -0,0 +1,31 @@
+package pwapi
+
+import (
+	"context"
+
+	"pathwar.land/pathwar/v2/go/pkg/errcode"
+)
+
+func (svc *service) AdminRecomputeMedals(ctx context.Context, in *AdminRecomputeMedals_Input) (*AdminRecomputeMedals_Output, error) {
+	if !isAdminContext(ctx) {
+		return nil, errcode.ErrRestrictedArea
+	}
+	if in == nil {
+		return nil, errcode.ErrMissingInput
+	}
+
+	if in.SeasonID != 0 {
+		exists, err := seasonIDExists(svc.db, in.SeasonID)
+		if err != nil || !exists {
+			return nil, errcode.ErrInvalidSeasonID.Wrap(err)
+		}
+	}
+
+	teams, err := recomputeMedals(svc.db, in.SeasonID)
+	if err != nil {
+		return nil, errcode.ErrUpdateTeamMedals.Wrap(err)
+	}
+
+	out := AdminRecomputeMedals_Output{Teams: teams}
+	return &out, nil
+}
@@ -0,0 +1,66 @@
+package pwapi
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pathwar.land/pathwar/v2/go/internal/testutil"
+	"pathwar.land/pathwar/v2/go/pkg/errcode"
+	"pathwar.land/pathwar/v2/go/pkg/pwdb"
+)
+
+func TestService_AdminRecomputeMedals(t *testing.T) {
+	svc, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t)})
+	defer cleanup()
+	ctx := testingSetContextToken(context.Background(), t)
+	db := testingSvcDB(t, svc)
+	gs := testingGlobalSeason(t, svc)
+
+	// first blood
+	solve := testingSolveChallenge(ctx, t, svc)
+	activeTeam, seasonChallenge := solve.team, solve.seasonChallenge
+	assert.Equal(t, pwdb.ChallengeValidation_GoldMedal, solve.validation.Medal)
+	team, err := svc.TeamGet(ctx, &TeamGet_Input{TeamID: activeTeam.ID})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), team.Item.GoldMedals)
+
+	// second solve, by another team
+	otherTeam := testingAcceptedValidation(t, svc, seasonChallenge, solve.session.User.ID, "medals")
+
+	_, err = svc.AdminRecomputeMedals(ctx, nil)
+	testSameErrcodes(t, "", errcode.ErrMissingInput, err)
+	_, err = svc.AdminRecomputeMedals(ctx, &AdminRecomputeMedals_Input{SeasonID: -42})
+	testSameErrcodes(t, "", errcode.ErrInvalidSeasonID, err)
+	ret, err := svc.AdminRecomputeMedals(ctx, &AdminRecomputeMedals_Input{SeasonID: gs.ID})
+	require.NoError(t, err)
+	require.Len(t, ret.Teams, 1)
+	assert.Equal(t, otherTeam.ID, ret.Teams[0].ID)
+	assert.Equal(t, int64(1), ret.Teams[0].SilverMedals)
+
+	challenge, err := svc.SeasonChallengeGet(ctx, &SeasonChallengeGet_Input{SeasonChallengeID: seasonChallenge.ID})
+	require.NoError(t, err)
+	require.Len(t, challenge.Item.FirstBloods, 2)
+	assert.Equal(t, activeTeam.ID, challenge.Item.FirstBloods[0].Team.ID)
+	assert.Equal(t, pwdb.ChallengeValidation_GoldMedal, challenge.Item.FirstBloods[0].Medal)
+	assert.Equal(t, otherTeam.ID, challenge.Item.FirstBloods[1].Team.ID)
+	assert.Equal(t, pwdb.ChallengeValidation_SilverMedal, challenge.Item.FirstBloods[1].Medal)
+
+	// the first validation is refused, the other team gets the gold medal
+	require.NoError(t, db.Model(&pwdb.ChallengeValidation{ID: solve.validation.ID}).UpdateColumn("status", pwdb.ChallengeValidation_Refused).Error)
+	ret, err = svc.AdminRecomputeMedals(ctx, &AdminRecomputeMedals_Input{})
+	require.NoError(t, err)
+	require.Len(t, ret.Teams, 2)
+	team, err = svc.TeamGet(ctx, &TeamGet_Input{TeamID: activeTeam.ID})
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), team.Item.GoldMedals)
+	require.NoError(t, db.First(otherTeam, otherTeam.ID).Error)
+	assert.Equal(t, int64(1), otherTeam.GoldMedals)
+	assert.Equal(t, int64(0), otherTeam.SilverMedals)
+
+	// nothing left to repair
+	ret, err = svc.AdminRecomputeMedals(ctx, &AdminRecomputeMedals_Input{})
+	require.NoError(t, err)
+	assert.Empty(t, ret.Teams)
+}
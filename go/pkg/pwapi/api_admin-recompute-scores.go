@@ -0,0 +1,34 @@
+package pwapi
+
+import (
+	"context"
+
+	"pathwar.land/pathwar/v2/go/pkg/errcode"
+)
+
+func (svc *service) AdminRecomputeScores(ctx context.Context, in *AdminRecomputeScores_Input) (*AdminRecomputeScores_Output, error) {
+	if !isAdminContext(ctx) {
+		return nil, errcode.ErrRestrictedArea
+	}
+	if in == nil {
+		return nil, errcode.ErrMissingInput
+	}
+
+	userID, _ := userIDFromContext(ctx, svc.db)
+	// userID is only used for activity logging, we don't care that it returns an error
+
+	if in.SeasonID != 0 {
+		exists, err := seasonIDExists(svc.db, in.SeasonID)
+		if err != nil || !exists {
+			return nil, errcode.ErrInvalidSeasonID.Wrap(err)
+		}
+	}
+
+	teams, err := recomputeScores(svc.db, in.SeasonID, userID)
+	if err != nil {
+		return nil, errcode.ErrUpdateTeamScore.Wrap(err)
+	}
+
+	out := AdminRecomputeScores_Output{Teams: teams}
+	return &out, nil
+}
@@ -0,0 +1,46 @@
+package pwapi
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pathwar.land/pathwar/v2/go/internal/testutil"
+	"pathwar.land/pathwar/v2/go/pkg/errcode"
+	"pathwar.land/pathwar/v2/go/pkg/pwdb"
+)
+
+func TestService_AdminRecomputeScores(t *testing.T) {
+	svc, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t)})
+	defer cleanup()
+	ctx := testingSetContextToken(context.Background(), t)
+	db := testingSvcDB(t, svc)
+	gs := testingGlobalSeason(t, svc)
+
+	// validate a flavor worth 42 points
+	solve := testingBuyChallenge(ctx, t, svc)
+	activeTeam := solve.team
+	require.NoError(t, db.Model(&pwdb.ChallengeFlavor{ID: solve.seasonChallenge.FlavorID}).UpdateColumn("points", 42).Error)
+	testingValidateChallenge(ctx, t, svc, solve.subscription.ID)
+
+	// nothing to repair
+	_, err := svc.AdminRecomputeScores(ctx, nil)
+	testSameErrcodes(t, "", errcode.ErrMissingInput, err)
+	_, err = svc.AdminRecomputeScores(ctx, &AdminRecomputeScores_Input{SeasonID: -42})
+	testSameErrcodes(t, "", errcode.ErrInvalidSeasonID, err)
+	ret, err := svc.AdminRecomputeScores(ctx, &AdminRecomputeScores_Input{})
+	require.NoError(t, err)
+	assert.Empty(t, ret.Teams)
+
+	// repair a corrupted score
+	require.NoError(t, db.Model(&pwdb.Team{ID: activeTeam.ID}).UpdateColumn("score", 1337).Error)
+	ret, err = svc.AdminRecomputeScores(ctx, &AdminRecomputeScores_Input{SeasonID: gs.ID})
+	require.NoError(t, err)
+	require.Len(t, ret.Teams, 1)
+	assert.Equal(t, activeTeam.ID, ret.Teams[0].ID)
+	assert.Equal(t, int64(42), ret.Teams[0].Score)
+	team, err := svc.TeamGet(ctx, &TeamGet_Input{TeamID: activeTeam.ID})
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), team.Item.Score)
+}
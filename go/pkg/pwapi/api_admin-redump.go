@@ -14,6 +14,12 @@ func (svc *service) AdminRedump(ctx context.Context, in *AdminRedump_Input) (*Ad
 		return nil, errcode.ErrRestrictedArea
 	}
 
+	// a soft redump keeps the containers and their passphrases, the agent falls back on a redump if the hook fails
+	status := pwdb.ChallengeInstance_NeedRedump
+	if in.Soft {
+		status = pwdb.ChallengeInstance_NeedReset
+	}
+
 	var errs error
 	for _, identifier := range in.Identifiers {
 		instances := []int64{}
@@ -38,7 +44,11 @@ func (svc *service) AdminRedump(ctx context.Context, in *AdminRedump_Input) (*Ad
 		err := svc.db.
 			Model(pwdb.ChallengeInstance{}).
 			Where("id IN (?)", instances).
-			Updates(&pwdb.ChallengeInstance{Status: pwdb.ChallengeInstance_NeedRedump}).
+			Updates(map[string]interface{}{
+				"status":           status,
+				"startup_error":    "",
+				"startup_attempts": 0,
+			}).
 			Error
 		if err != nil {
 			errs = multierr.Append(errs, err)
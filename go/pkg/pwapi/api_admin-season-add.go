@@ -16,6 +16,9 @@ func (svc *service) AdminSeasonAdd(ctx context.Context, in *AdminSeasonAdd_Input
 	if in == nil || in.Season == nil {
 		return nil, errcode.ErrMissingInput
 	}
+	if err := validateScoringCurve(in.Season); err != nil {
+		return nil, err
+	}
 
 	// check that the name is not taken
 	var seasonCheck pwdb.Season
@@ -27,9 +30,36 @@ func (svc *service) AdminSeasonAdd(ctx context.Context, in *AdminSeasonAdd_Input
 		return nil, pwdb.GormToErrcode(err)
 	}
 
-	err = svc.db.Create(&in.Season).Error
+	err = svc.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Create(&in.Season).Error
+		if err != nil {
+			return errcode.ErrSeasonAdd.Wrap(err)
+		}
+		if in.Season.Visibility != pwdb.Season_Public {
+			return nil
+		}
+
+		// announce public seasons to every user
+		var userIDs []int64
+		err = tx.
+			Model(&pwdb.User{}).
+			Where(pwdb.User{DeletionStatus: pwdb.DeletionStatus_Active}).
+			Pluck("id", &userIDs).
+			Error
+		if err != nil {
+			return errcode.ErrCreateNotification.Wrap(err)
+		}
+		args := map[string]interface{}{
+			"season_id": in.Season.ID,
+			"name":      in.Season.Name,
+		}
+		if err := notifyUsers(tx, userIDs, seasonAddedMsg, challengesURL, args); err != nil {
+			return errcode.ErrCreateNotification.Wrap(err)
+		}
+		return nil
+	})
 	if err != nil {
-		return nil, errcode.ErrSeasonChallengeAdd.Wrap(err)
+		return nil, err
 	}
 
 	out := AdminSeasonAdd_Output{
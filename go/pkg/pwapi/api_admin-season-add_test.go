@@ -0,0 +1,70 @@
+package pwapi
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pathwar.land/pathwar/v2/go/internal/testutil"
+	"pathwar.land/pathwar/v2/go/pkg/errcode"
+	"pathwar.land/pathwar/v2/go/pkg/pwdb"
+)
+
+func TestService_AdminSeasonAdd(t *testing.T) {
+	svc, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t)})
+	defer cleanup()
+	ctx := testingSetContextToken(context.Background(), t)
+	db := testingSvcDB(t, svc)
+
+	// more users than a batch of notifications
+	for i := 0; i < notificationsBatchSize+5; i++ {
+		user := pwdb.User{Username: fmt.Sprintf("player-%d", i), OAuthSubject: fmt.Sprintf("player-%d", i), DeletionStatus: pwdb.DeletionStatus_Active}
+		require.NoError(t, db.Create(&user).Error)
+	}
+	var users int
+	require.NoError(t, db.Model(&pwdb.User{}).Where(pwdb.User{DeletionStatus: pwdb.DeletionStatus_Active}).Count(&users).Error)
+	seasonNotifications := func() []*pwdb.Notification {
+		var notifications []*pwdb.Notification
+		require.NoError(t, db.Where(pwdb.Notification{Msg: seasonAddedMsg}).Find(&notifications).Error)
+		return notifications
+	}
+
+	var tests = []struct {
+		name        string
+		input       *AdminSeasonAdd_Input
+		expectedErr error
+	}{
+		{"nil", nil, errcode.ErrMissingInput},
+		{"empty", &AdminSeasonAdd_Input{}, errcode.ErrMissingInput},
+		{"name-taken", &AdminSeasonAdd_Input{Season: &pwdb.Season{Name: "Global"}}, errcode.ErrSeasonNameAlreadyExist},
+	}
+	for _, test := range tests {
+		_, err := svc.AdminSeasonAdd(ctx, test.input)
+		testSameErrcodes(t, test.name, test.expectedErr, err)
+	}
+
+	// private seasons are not announced
+	_, err := svc.AdminSeasonAdd(ctx, &AdminSeasonAdd_Input{Season: &pwdb.Season{Name: "Private", Visibility: pwdb.Season_Private}})
+	require.NoError(t, err)
+	assert.Empty(t, seasonNotifications())
+
+	// public seasons are announced to every user
+	ret, err := svc.AdminSeasonAdd(ctx, &AdminSeasonAdd_Input{Season: &pwdb.Season{Name: "Public", Visibility: pwdb.Season_Public}})
+	require.NoError(t, err)
+	notifications := seasonNotifications()
+	require.Len(t, notifications, users)
+	ids := map[int64]bool{}
+	recipients := map[int64]bool{}
+	for _, notification := range notifications {
+		ids[notification.ID] = true
+		recipients[notification.UserID] = true
+		assert.Equal(t, challengesURL, notification.ClickURL)
+		assert.Equal(t, fmt.Sprintf(`{"name":"Public","season_id":%d}`, ret.Season.ID), notification.Args)
+		assert.NotNil(t, notification.CreatedAt)
+		assert.Nil(t, notification.ReadAt)
+	}
+	assert.Len(t, ids, users)
+	assert.Len(t, recipients, users)
+}
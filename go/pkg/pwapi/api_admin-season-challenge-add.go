@@ -37,6 +37,7 @@ func (svc *service) AdminSeasonChallengeAdd(ctx context.Context, in *AdminSeason
 	}
 
 	var seasonChallenge pwdb.SeasonChallenge
+	isNew := false
 	err := svc.db.
 		Where(&pwdb.SeasonChallenge{
 			SeasonID: in.SeasonChallenge.SeasonID,
@@ -46,13 +47,37 @@ func (svc *service) AdminSeasonChallengeAdd(ctx context.Context, in *AdminSeason
 		Error
 	if err == nil {
 		in.SeasonChallenge.ID = seasonChallenge.ID
-	} else if err != gorm.ErrRecordNotFound {
+	} else if err == gorm.ErrRecordNotFound {
+		isNew = true
+	} else {
 		return nil, pwdb.GormToErrcode(err)
 	}
 
-	err = svc.db.Save(in.SeasonChallenge).Error
+	err = svc.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Save(in.SeasonChallenge).Error
+		if err != nil {
+			return errcode.ErrSeasonChallengeAdd.Wrap(err)
+		}
+		if !isNew {
+			return nil
+		}
+
+		// notify the players of the season
+		userIDs, err := seasonMemberIDs(tx, in.SeasonChallenge.SeasonID)
+		if err != nil {
+			return errcode.ErrCreateNotification.Wrap(err)
+		}
+		args := map[string]interface{}{
+			"season_challenge_id": in.SeasonChallenge.ID,
+			"season_id":           in.SeasonChallenge.SeasonID,
+		}
+		if err := notifyUsers(tx, userIDs, seasonChallengeAddedMsg, seasonChallengeURL(in.SeasonChallenge.ID), args); err != nil {
+			return errcode.ErrCreateNotification.Wrap(err)
+		}
+		return nil
+	})
 	if err != nil {
-		return nil, errcode.ErrSeasonChallengeAdd.Wrap(err)
+		return nil, err
 	}
 
 	out := AdminSeasonChallengeAdd_Output{
@@ -0,0 +1,71 @@
+package pwapi
+
+import (
+	"context"
+
+	"github.com/jinzhu/gorm"
+	"pathwar.land/pathwar/v2/go/pkg/errcode"
+	"pathwar.land/pathwar/v2/go/pkg/pwdb"
+)
+
+func (svc *service) AdminSeasonSetScoring(ctx context.Context, in *AdminSeasonSetScoring_Input) (*AdminSeasonSetScoring_Output, error) {
+	if !isAdminContext(ctx) {
+		return nil, errcode.ErrRestrictedArea
+	}
+	if in == nil || in.SeasonID == 0 {
+		return nil, errcode.ErrMissingInput
+	}
+
+	userID, _ := userIDFromContext(ctx, svc.db)
+	// userID is only used for activity logging, we don't care that it returns an error
+
+	var season pwdb.Season
+	err := svc.db.First(&season, in.SeasonID).Error
+	if err != nil {
+		return nil, errcode.ErrInvalidSeasonID.Wrap(err)
+	}
+
+	// the curve is kept when disabling dynamic scoring, to enable it again later
+	season.DynamicScoring = in.DynamicScoring
+	if in.DynamicScoring {
+		season.ScoringInitial = in.ScoringInitial
+		season.ScoringMinimum = in.ScoringMinimum
+		season.ScoringDecay = in.ScoringDecay
+		if err := validateScoringCurve(&season); err != nil {
+			return nil, err
+		}
+	}
+
+	var teams []*pwdb.Team
+	err = svc.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.
+			Model(&pwdb.Season{}).
+			Where("id = ?", season.ID).
+			UpdateColumns(map[string]interface{}{
+				"dynamic_scoring": season.DynamicScoring,
+				"scoring_initial": season.ScoringInitial,
+				"scoring_minimum": season.ScoringMinimum,
+				"scoring_decay":   season.ScoringDecay,
+			}).
+			Error
+		if err != nil {
+			return errcode.ErrSeasonSetScoring.Wrap(err)
+		}
+
+		// every validated challenge of the season is now worth a different amount of points
+		teams, err = recomputeTeamScores(tx, season.ID, userID)
+		if err != nil {
+			return errcode.ErrUpdateTeamScore.Wrap(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	out := AdminSeasonSetScoring_Output{
+		Season: &season,
+		Teams:  teams,
+	}
+	return &out, nil
+}
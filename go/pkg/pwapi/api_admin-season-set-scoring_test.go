@@ -0,0 +1,86 @@
+package pwapi
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pathwar.land/pathwar/v2/go/internal/testutil"
+	"pathwar.land/pathwar/v2/go/pkg/errcode"
+	"pathwar.land/pathwar/v2/go/pkg/pwdb"
+)
+
+func TestService_AdminSeasonSetScoring(t *testing.T) {
+	svc, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t)})
+	defer cleanup()
+	ctx := testingSetContextToken(context.Background(), t)
+	db := testingSvcDB(t, svc)
+	gs := testingGlobalSeason(t, svc)
+
+	var tests = []struct {
+		name        string
+		input       *AdminSeasonSetScoring_Input
+		expectedErr error
+	}{
+		{"nil", nil, errcode.ErrMissingInput},
+		{"empty", &AdminSeasonSetScoring_Input{}, errcode.ErrMissingInput},
+		{"unknown-season", &AdminSeasonSetScoring_Input{SeasonID: -42}, errcode.ErrInvalidSeasonID},
+		{"invalid-curve", &AdminSeasonSetScoring_Input{SeasonID: gs.ID, DynamicScoring: true, ScoringInitial: 100, ScoringMinimum: 500}, errcode.ErrInvalidScoringCurve},
+	}
+	for _, test := range tests {
+		_, err := svc.AdminSeasonSetScoring(ctx, test.input)
+		testSameErrcodes(t, test.name, test.expectedErr, err)
+	}
+
+	// two teams validated a flavor worth 500 points
+	solve := testingBuyChallenge(ctx, t, svc)
+	activeTeam, seasonChallenge := solve.team, solve.seasonChallenge
+	require.NoError(t, db.Model(&pwdb.ChallengeFlavor{ID: seasonChallenge.FlavorID}).UpdateColumn("points", 500).Error)
+	testingValidateChallenge(ctx, t, svc, solve.subscription.ID)
+	otherTeam := testingAcceptedValidation(t, svc, seasonChallenge, solve.session.User.ID, "set-scoring")
+	teamScores := func() map[int64]int64 {
+		scores := map[int64]int64{}
+		for _, id := range []int64{activeTeam.ID, otherTeam.ID} {
+			var team pwdb.Team
+			require.NoError(t, db.First(&team, id).Error)
+			scores[id] = team.Score
+		}
+		return scores
+	}
+	changedTeams := func(teams []*pwdb.Team) map[int64]int64 {
+		scores := map[int64]int64{}
+		for _, team := range teams {
+			scores[team.ID] = team.Score
+		}
+		return scores
+	}
+
+	// both solvers get the decayed points of the second solve
+	ret, err := svc.AdminSeasonSetScoring(ctx, &AdminSeasonSetScoring_Input{SeasonID: gs.ID, DynamicScoring: true, ScoringInitial: 500, ScoringMinimum: 100, ScoringDecay: 2})
+	require.NoError(t, err)
+	assert.True(t, ret.Season.DynamicScoring)
+	assert.Equal(t, map[int64]int64{activeTeam.ID: 400, otherTeam.ID: 400}, changedTeams(ret.Teams))
+	assert.Equal(t, map[int64]int64{activeTeam.ID: 400, otherTeam.ID: 400}, teamScores())
+	challenge, err := svc.SeasonChallengeGet(ctx, &SeasonChallengeGet_Input{SeasonChallengeID: seasonChallenge.ID})
+	require.NoError(t, err)
+	assert.Equal(t, int64(400), challenge.Item.Points)
+
+	// back to the points of the flavor, the curve is kept
+	ret, err = svc.AdminSeasonSetScoring(ctx, &AdminSeasonSetScoring_Input{SeasonID: gs.ID})
+	require.NoError(t, err)
+	assert.False(t, ret.Season.DynamicScoring)
+	assert.Equal(t, map[int64]int64{activeTeam.ID: 500, otherTeam.ID: 500}, changedTeams(ret.Teams))
+	assert.Equal(t, map[int64]int64{activeTeam.ID: 500, otherTeam.ID: 500}, teamScores())
+	var season pwdb.Season
+	require.NoError(t, db.First(&season, gs.ID).Error)
+	assert.False(t, season.DynamicScoring)
+	assert.Equal(t, int64(500), season.ScoringInitial)
+	assert.Equal(t, int64(100), season.ScoringMinimum)
+	assert.Equal(t, int64(2), season.ScoringDecay)
+
+	// nothing changes
+	ret, err = svc.AdminSeasonSetScoring(ctx, &AdminSeasonSetScoring_Input{SeasonID: gs.ID})
+	require.NoError(t, err)
+	assert.Empty(t, ret.Teams)
+}
@@ -0,0 +1,202 @@
+package pwapi
+
+import (
+	"context"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"go.uber.org/zap"
+	"pathwar.land/pathwar/v2/go/pkg/errcode"
+	"pathwar.land/pathwar/v2/go/pkg/pwdb"
+)
+
+func (svc *service) AgentDrain(ctx context.Context, in *AgentDrain_Input) (*AgentDrain_Output, error) {
+	if !isAgentContext(ctx) {
+		return nil, errcode.ErrRestrictedArea
+	}
+	if in == nil || in.AgentName == "" {
+		return nil, errcode.ErrMissingInput
+	}
+
+	userID, _ := userIDFromContext(ctx, svc.db)
+	// userID is only used for activity logging, we don't care that it returns an error
+
+	var agent pwdb.Agent
+	err := svc.db.
+		Where(pwdb.Agent{Name: in.AgentName}).
+		First(&agent).
+		Error
+	if err != nil {
+		return nil, errcode.ErrGetAgent.Wrap(err)
+	}
+
+	if err := svc.drainAgent(&agent, in.Deadline, userID); err != nil {
+		return nil, err
+	}
+
+	out := AgentDrain_Output{Agent: &agent}
+	return &out, nil
+}
+
+// drainAgent stops the placement of new instances on the agent, the current instances
+// are kept running until the deadline, then moved by expireAgentDrain, which also runs from drainsLoop
+// so the drain of an agent that stopped calling the API expires too.
+func (svc *service) drainAgent(agent *pwdb.Agent, deadline *time.Time, authorID int64) error {
+	if deadline == nil {
+		now := time.Now()
+		deadline = &now
+	}
+	// an agent already draining keeps its earliest deadline
+	if agent.Status == pwdb.Agent_Draining && agent.DrainDeadline != nil && agent.DrainDeadline.Before(*deadline) {
+		deadline = agent.DrainDeadline
+	}
+
+	err := svc.db.
+		Model(agent).
+		Updates(map[string]interface{}{
+			"status":         pwdb.Agent_Draining,
+			"drain_deadline": deadline,
+		}).
+		Error
+	if err != nil {
+		return errcode.ErrDrainAgent.Wrap(err)
+	}
+	agent.Status = pwdb.Agent_Draining
+	agent.DrainDeadline = deadline
+
+	activity := pwdb.Activity{
+		Kind:     pwdb.Activity_AgentDrain,
+		AuthorID: authorID,
+		AgentID:  agent.ID,
+	}
+	if err := svc.db.Create(&activity).Error; err != nil {
+		return errcode.ErrDrainAgent.Wrap(err)
+	}
+
+	return svc.expireAgentDrain(agent, authorID)
+}
+
+// expireAgentDrain disables the instances of a draining agent once its deadline is reached,
+// and creates a replacement instance on another active agent for each flavor that would
+// not be available anymore.
+func (svc *service) expireAgentDrain(agent *pwdb.Agent, authorID int64) error {
+	if agent.Status != pwdb.Agent_Draining || agent.DrainDeadline == nil || time.Now().Before(*agent.DrainDeadline) {
+		return nil
+	}
+
+	err := svc.db.Transaction(func(tx *gorm.DB) error {
+		var instances []*pwdb.ChallengeInstance
+		err := tx.
+			Where(pwdb.ChallengeInstance{AgentID: agent.ID}).
+			Where("status <> ?", pwdb.ChallengeInstance_Disabled).
+			Find(&instances).
+			Error
+		if err != nil {
+			return err
+		}
+
+		for _, instance := range instances {
+			err = tx.
+				Model(instance).
+				Update(pwdb.ChallengeInstance{Status: pwdb.ChallengeInstance_Disabled}).
+				Error
+			if err != nil {
+				return err
+			}
+
+			var remaining int
+			err = tx.
+				Model(pwdb.ChallengeInstance{}).
+				Joins("JOIN agent ON agent.id = challenge_instance.agent_id").
+				Where("challenge_instance.flavor_id = ?", instance.FlavorID).
+				Where("challenge_instance.status <> ?", pwdb.ChallengeInstance_Disabled).
+				Where("agent.status = ?", pwdb.Agent_Active).
+				Count(&remaining).
+				Error
+			if err != nil {
+				return err
+			}
+			if remaining > 0 {
+				continue
+			}
+
+			var target pwdb.Agent
+			err = tx.
+				Where("status = ? AND id <> ?", pwdb.Agent_Active, agent.ID).
+				Order("default_agent DESC, id").
+				First(&target).
+				Error
+			if pwdb.IsRecordNotFoundError(err) {
+				continue // no agent available, the flavor stays unavailable
+			}
+			if err != nil {
+				return err
+			}
+
+			replacement := pwdb.ChallengeInstance{
+				Status:   pwdb.ChallengeInstance_IsNew,
+				AgentID:  target.ID,
+				FlavorID: instance.FlavorID,
+			}
+			if err = tx.Create(&replacement).Error; err != nil {
+				return err
+			}
+			activity := pwdb.Activity{
+				Kind:                pwdb.Activity_AgentChallengeInstanceCreate,
+				AuthorID:            authorID,
+				AgentID:             target.ID,
+				ChallengeInstanceID: replacement.ID,
+				ChallengeFlavorID:   instance.FlavorID,
+			}
+			if err = tx.Create(&activity).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return errcode.ErrDrainAgent.Wrap(err)
+	}
+	return nil
+}
+
+// expireAgentDrains expires the drains of every agent whose deadline is reached, and returns the number of agents expired
+func (svc *service) expireAgentDrains(now time.Time) (int, error) {
+	var agents []*pwdb.Agent
+	err := svc.db.
+		Where(pwdb.Agent{Status: pwdb.Agent_Draining}).
+		Where("drain_deadline <= ?", now).
+		Find(&agents).
+		Error
+	if err != nil {
+		return 0, errcode.ErrDrainAgent.Wrap(err)
+	}
+	for _, agent := range agents {
+		if err := svc.expireAgentDrain(agent, 0); err != nil {
+			return 0, err
+		}
+	}
+	return len(agents), nil
+}
+
+// drainsLoop expires the drains of the agents periodically, until the service is closed
+func (svc *service) drainsLoop(interval time.Duration) {
+	defer close(svc.drainsStopped)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-svc.drainsDone:
+			return
+		case now := <-ticker.C:
+			expired, err := svc.expireAgentDrains(now)
+			if err != nil {
+				svc.logger.Warn("expire agent drains", zap.Error(err))
+				continue
+			}
+			if expired > 0 {
+				svc.logger.Debug("agent drains expired", zap.Int("count", expired))
+			}
+		}
+	}
+}
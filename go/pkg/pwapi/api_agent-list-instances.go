@@ -2,7 +2,9 @@ package pwapi
 
 import (
 	"context"
+	"time"
 
+	"github.com/jinzhu/gorm"
 	"pathwar.land/pathwar/v2/go/pkg/errcode"
 	"pathwar.land/pathwar/v2/go/pkg/pwdb"
 )
@@ -24,10 +26,15 @@ func (svc *service) AgentListInstances(ctx context.Context, in *AgentListInstanc
 		return nil, errcode.ErrGetAgent.Wrap(err)
 	}
 
-	if agent.Status != pwdb.Agent_Active {
+	if agent.Status != pwdb.Agent_Active && agent.Status != pwdb.Agent_Draining {
 		return nil, errcode.ErrInactiveAgent
 	}
 
+	userID, _ := userIDFromContext(ctx, svc.db)
+	if err := svc.expireAgentDrain(&agent, userID); err != nil {
+		return nil, err
+	}
+
 	// FIXME: update lastSeen and timesSeen
 
 	var instances []*pwdb.ChallengeInstance
@@ -45,6 +52,56 @@ func (svc *service) AgentListInstances(ctx context.Context, in *AgentListInstanc
 		return nil, errcode.ErrListChallengeInstances.Wrap(err)
 	}
 
-	out := AgentListInstances_Output{Instances: instances}
+	upcomingFlavors, err := upcomingFlavors(svc.db, instances)
+	if err != nil {
+		return nil, errcode.ErrListChallenges.Wrap(err)
+	}
+
+	out := AgentListInstances_Output{Instances: instances, Agent: &agent, UpcomingFlavors: upcomingFlavors}
 	return &out, nil
 }
+
+// upcomingFlavors returns the flavors of the challenges of instances that are newer than all of their instantiated flavors
+func upcomingFlavors(db *gorm.DB, instances []*pwdb.ChallengeInstance) ([]*pwdb.ChallengeFlavor, error) {
+	var (
+		instantiated = map[int64]bool{}
+		newest       = map[int64]time.Time{}
+		challengeIDs = []int64{}
+	)
+	for _, instance := range instances {
+		if instance.Status == pwdb.ChallengeInstance_Disabled || instance.Flavor == nil {
+			continue
+		}
+		instantiated[instance.FlavorID] = true
+		challengeID := instance.Flavor.ChallengeID
+		if _, found := newest[challengeID]; !found {
+			challengeIDs = append(challengeIDs, challengeID)
+		}
+		if instance.Flavor.CreatedAt != nil && instance.Flavor.CreatedAt.After(newest[challengeID]) {
+			newest[challengeID] = *instance.Flavor.CreatedAt
+		}
+	}
+	if len(challengeIDs) == 0 {
+		return nil, nil
+	}
+
+	var flavors []*pwdb.ChallengeFlavor
+	err := db.
+		Preload("Challenge").
+		Where("challenge_id IN (?)", challengeIDs).
+		Where("compose_bundle <> ''").
+		Order("id asc").
+		Find(&flavors).
+		Error
+	if err != nil {
+		return nil, err
+	}
+	upcoming := []*pwdb.ChallengeFlavor{}
+	for _, flavor := range flavors {
+		if instantiated[flavor.ID] || flavor.CreatedAt == nil || !flavor.CreatedAt.After(newest[flavor.ChallengeID]) {
+			continue
+		}
+		upcoming = append(upcoming, flavor)
+	}
+	return upcoming, nil
+}
@@ -5,8 +5,10 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"pathwar.land/pathwar/v2/go/internal/testutil"
 	"pathwar.land/pathwar/v2/go/pkg/errcode"
+	"pathwar.land/pathwar/v2/go/pkg/pwdb"
 )
 
 func TestService_AgentListInstances(t *testing.T) {
@@ -50,3 +52,31 @@ func TestService_AgentListInstances(t *testing.T) {
 		})
 	}
 }
+
+func TestService_AgentListInstances_UpcomingFlavors(t *testing.T) {
+	svc, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t)})
+	defer cleanup()
+	ctx := testingSetContextToken(context.Background(), t)
+
+	before, err := svc.AgentListInstances(ctx, &AgentListInstances_Input{AgentName: "dummy-agent-2"})
+	require.NoError(t, err)
+	require.NotEmpty(t, before.Instances)
+	assert.Empty(t, before.UpcomingFlavors)
+	challengeID := before.Instances[0].Flavor.ChallengeID
+
+	// only the new flavors with a bundle are upcoming
+	upcoming, err := svc.AdminChallengeFlavorAdd(ctx, &AdminChallengeFlavorAdd_Input{
+		ChallengeFlavor: &pwdb.ChallengeFlavor{ChallengeID: challengeID, Version: "upcoming", ComposeBundle: "services: {}"},
+	})
+	require.NoError(t, err)
+	_, err = svc.AdminChallengeFlavorAdd(ctx, &AdminChallengeFlavorAdd_Input{
+		ChallengeFlavor: &pwdb.ChallengeFlavor{ChallengeID: challengeID, Version: "no-bundle"},
+	})
+	require.NoError(t, err)
+
+	after, err := svc.AgentListInstances(ctx, &AgentListInstances_Input{AgentName: "dummy-agent-2"})
+	require.NoError(t, err)
+	require.Len(t, after.UpcomingFlavors, 1)
+	assert.Equal(t, upcoming.ChallengeFlavor.ID, after.UpcomingFlavors[0].ID)
+	assert.NotNil(t, after.UpcomingFlavors[0].Challenge)
+}
@@ -41,7 +41,12 @@ func (svc *service) AgentRegister(ctx context.Context, in *AgentRegister_Input)
 	agent.Metadata = in.Metadata
 	agent.DomainSuffix = in.DomainSuffix
 	agent.AuthSalt = in.AuthSalt
-	agent.Status = pwdb.Agent_Active
+	// a drained agent registering again after its deadline is back in service,
+	// a pending drain is kept until the deadline is reached
+	if agent.Status != pwdb.Agent_Draining || agent.DrainDeadline == nil || time.Now().After(*agent.DrainDeadline) {
+		agent.Status = pwdb.Agent_Active
+		agent.DrainDeadline = nil
+	}
 	now := time.Now()
 	agent.LastRegistrationAt = &now
 	agent.LastSeenAt = &now
@@ -4,6 +4,7 @@ import (
 	"context"
 	"reflect"
 
+	"go.uber.org/zap"
 	"pathwar.land/pathwar/v2/go/pkg/errcode"
 	"pathwar.land/pathwar/v2/go/pkg/pwdb"
 )
@@ -21,8 +22,24 @@ func (svc *service) AgentUpdateState(ctx context.Context, in *AgentUpdateState_I
 		return nil, errcode.ErrGetUserIDFromContext.Wrap(err)
 	}
 
+	// an agent can only report the state of its own instances, the agents older than the agent name can report any
+	// instance until they are upgraded
+	query := svc.db
+	if in.AgentName != "" {
+		var agent pwdb.Agent
+		err = svc.db.
+			Where(&pwdb.Agent{Name: in.AgentName}).
+			First(&agent).
+			Error
+		if err != nil {
+			return nil, errcode.ErrGetAgent.Wrap(err)
+		}
+		query = query.Where(pwdb.ChallengeInstance{AgentID: agent.ID})
+	} else {
+		svc.logger.Warn("deprecated agent update state without agent name, upgrade the agent to only update its instances", zap.Int64("user", userID))
+	}
 	var dbInstances []*pwdb.ChallengeInstance
-	err = svc.db.Find(&dbInstances).Error
+	err = query.Find(&dbInstances).Error
 	if err != nil {
 		return nil, errcode.ErrAgentUpdateState.Wrap(err)
 	}
@@ -33,10 +50,17 @@ func (svc *service) AgentUpdateState(ctx context.Context, in *AgentUpdateState_I
 				dbInstance = instance
 			}
 		}
+		if dbInstance == nil {
+			continue
+		}
 		updated := false
 		if !reflect.DeepEqual(dbInstance, challengeInstance) {
 			updated = true
 		}
+		// disabled instances are only re-enabled by a redump, not by a late agent report
+		if dbInstance.Status == pwdb.ChallengeInstance_Disabled {
+			challengeInstance.Status = pwdb.ChallengeInstance_Disabled
+		}
 		cpy := challengeInstance
 		err := svc.db.Model(&cpy).
 			Update(pwdb.ChallengeInstance{
@@ -47,6 +71,17 @@ func (svc *service) AgentUpdateState(ctx context.Context, in *AgentUpdateState_I
 		if err != nil {
 			return nil, errcode.ErrAgentUpdateState.Wrap(err)
 		}
+		// startup fields are updated with a map, so they can be reset to zero values
+		err = svc.db.Model(&cpy).
+			Updates(map[string]interface{}{
+				"startup_error":           challengeInstance.StartupError,
+				"startup_attempts":        challengeInstance.StartupAttempts,
+				"last_startup_attempt_at": challengeInstance.LastStartupAttemptAt,
+			}).
+			Error
+		if err != nil {
+			return nil, errcode.ErrAgentUpdateState.Wrap(err)
+		}
 		if updated {
 			activity := pwdb.Activity{
 				Kind:                pwdb.Activity_AgentChallengeInstanceUpdate,
@@ -61,6 +96,30 @@ func (svc *service) AgentUpdateState(ctx context.Context, in *AgentUpdateState_I
 		}
 	}
 
+	for _, report := range in.ThrottlingReports {
+		var dbInstance *pwdb.ChallengeInstance
+		for _, instance := range dbInstances {
+			if instance.ID == report.ChallengeInstanceID {
+				dbInstance = instance
+			}
+		}
+		if dbInstance == nil || report.Count < 1 {
+			continue
+		}
+		activity := pwdb.Activity{
+			Kind:                pwdb.Activity_AgentChallengeInstanceThrottle,
+			AuthorID:            userID,
+			UserID:              report.UserID,
+			AgentID:             dbInstance.AgentID,
+			ChallengeInstanceID: dbInstance.ID,
+			ChallengeFlavorID:   dbInstance.FlavorID,
+			Count:               report.Count,
+		}
+		if err := svc.db.Create(&activity).Error; err != nil {
+			return nil, errcode.ErrAgentUpdateState.Wrap(err)
+		}
+	}
+
 	if err != nil {
 		return nil, errcode.ErrCommitUserTransaction.Wrap(err)
 	}
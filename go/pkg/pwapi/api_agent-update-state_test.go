@@ -0,0 +1,107 @@
+package pwapi
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pathwar.land/pathwar/v2/go/internal/testutil"
+	"pathwar.land/pathwar/v2/go/pkg/errcode"
+	"pathwar.land/pathwar/v2/go/pkg/pwdb"
+)
+
+func TestService_AgentUpdateState(t *testing.T) {
+	svc, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t)})
+	defer cleanup()
+	ctx := testingSetContextToken(context.Background(), t)
+	db := testingSvcDB(t, svc)
+
+	// fetch user session
+	_, err := svc.UserGetSession(ctx, nil)
+	require.NoError(t, err)
+
+	// invalid input
+	_, err = svc.AgentUpdateState(ctx, nil)
+	testSameErrcodes(t, "", errcode.ErrMissingInput, err)
+	_, err = svc.AgentUpdateState(ctx, &AgentUpdateState_Input{AgentName: "unknown"})
+	testSameErrcodes(t, "", errcode.ErrGetAgent, err)
+
+	// report a failed startup
+	instances, err := svc.AgentListInstances(ctx, &AgentListInstances_Input{AgentName: "dummy-agent-1"})
+	require.NoError(t, err)
+	require.NotEmpty(t, instances.Instances)
+	instance := instances.Instances[0]
+	now := time.Now()
+	instance.Status = pwdb.ChallengeInstance_StartupFailed
+	instance.StartupError = "docker-compose up: exit status 1"
+	instance.StartupAttempts = 5
+	instance.LastStartupAttemptAt = &now
+	instance.Flavor = nil
+	instance.Agent = nil
+	_, err = svc.AgentUpdateState(ctx, &AgentUpdateState_Input{AgentName: "dummy-agent-1", Instances: []*pwdb.ChallengeInstance{instance}})
+	require.NoError(t, err)
+
+	var dbInstance pwdb.ChallengeInstance
+	require.NoError(t, db.First(&dbInstance, instance.ID).Error)
+	assert.Equal(t, pwdb.ChallengeInstance_StartupFailed, dbInstance.Status)
+	assert.Equal(t, "docker-compose up: exit status 1", dbInstance.StartupError)
+	assert.Equal(t, int64(5), dbInstance.StartupAttempts)
+	assert.NotNil(t, dbInstance.LastStartupAttemptAt)
+
+	// another agent cannot report the state of the instance
+	other := *instance
+	other.Status = pwdb.ChallengeInstance_Available
+	_, err = svc.AgentUpdateState(ctx, &AgentUpdateState_Input{
+		AgentName:         "dummy-agent-2",
+		Instances:         []*pwdb.ChallengeInstance{&other},
+		ThrottlingReports: []*AgentUpdateState_ThrottlingReport{{ChallengeInstanceID: instance.ID, UserID: 42, Count: 1}},
+	})
+	require.NoError(t, err)
+	dbInstance = pwdb.ChallengeInstance{}
+	require.NoError(t, db.First(&dbInstance, instance.ID).Error)
+	assert.Equal(t, pwdb.ChallengeInstance_StartupFailed, dbInstance.Status)
+
+	// report throttled requests
+	_, err = svc.AgentUpdateState(ctx, &AgentUpdateState_Input{
+		AgentName: "dummy-agent-1",
+		ThrottlingReports: []*AgentUpdateState_ThrottlingReport{
+			{ChallengeInstanceID: instance.ID, UserID: 42, Count: 12},
+			{ChallengeInstanceID: 4242, UserID: 42, Count: 12}, // unknown instance
+		},
+	})
+	require.NoError(t, err)
+	var activities []*pwdb.Activity
+	require.NoError(t, db.Where(pwdb.Activity{Kind: pwdb.Activity_AgentChallengeInstanceThrottle}).Find(&activities).Error)
+	require.Len(t, activities, 1)
+	assert.Equal(t, instance.ID, activities[0].ChallengeInstanceID)
+	assert.Equal(t, int64(42), activities[0].UserID)
+	assert.Equal(t, int64(12), activities[0].Count)
+
+	// a redump clears the startup error
+	_, err = svc.AdminRedump(ctx, &AdminRedump_Input{Identifiers: []string{fmt.Sprintf("%d", instance.ID)}})
+	require.NoError(t, err)
+	dbInstance = pwdb.ChallengeInstance{}
+	require.NoError(t, db.First(&dbInstance, instance.ID).Error)
+	assert.Equal(t, pwdb.ChallengeInstance_NeedRedump, dbInstance.Status)
+	assert.Empty(t, dbInstance.StartupError)
+	assert.Zero(t, dbInstance.StartupAttempts)
+
+	// a soft redump asks for the on-reset hook
+	_, err = svc.AdminRedump(ctx, &AdminRedump_Input{Identifiers: []string{fmt.Sprintf("%d", instance.ID)}, Soft: true})
+	require.NoError(t, err)
+	dbInstance = pwdb.ChallengeInstance{}
+	require.NoError(t, db.First(&dbInstance, instance.ID).Error)
+	assert.Equal(t, pwdb.ChallengeInstance_NeedReset, dbInstance.Status)
+
+	// the agents older than the agent name still report the state of their instances
+	legacy := *instance
+	legacy.Status = pwdb.ChallengeInstance_Available
+	_, err = svc.AgentUpdateState(ctx, &AgentUpdateState_Input{Instances: []*pwdb.ChallengeInstance{&legacy}})
+	require.NoError(t, err)
+	dbInstance = pwdb.ChallengeInstance{}
+	require.NoError(t, db.First(&dbInstance, instance.ID).Error)
+	assert.Equal(t, pwdb.ChallengeInstance_Available, dbInstance.Status)
+}
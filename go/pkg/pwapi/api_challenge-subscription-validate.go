@@ -10,6 +10,7 @@ import (
 	"github.com/jinzhu/gorm"
 	"pathwar.land/pathwar/v2/go/pkg/errcode"
 	"pathwar.land/pathwar/v2/go/pkg/pwdb"
+	"pathwar.land/pathwar/v2/go/pkg/pwinit"
 )
 
 func (svc *service) ChallengeSubscriptionValidate(ctx context.Context, in *ChallengeSubscriptionValidate_Input) (*ChallengeSubscriptionValidate_Output, error) {
@@ -28,9 +29,11 @@ func (svc *service) ChallengeSubscriptionValidate(ctx context.Context, in *Chall
 	var subscription pwdb.ChallengeSubscription
 	err = svc.db.
 		Preload("Team", "team.deletion_status = ?", pwdb.DeletionStatus_Active).
+		Preload("Team.Members").
 		Preload("SeasonChallenge").
 		Preload("SeasonChallenge.Flavor").
 		Preload("SeasonChallenge.Flavor.Instances").
+		Preload("SeasonChallenge.Flavor.Instances.Agent").
 		Preload("SeasonChallenge.Season").
 		Joins("JOIN team ON team.id = challenge_subscription.team_id").
 		Joins("JOIN team_member ON team_member.team_id = team.id AND team_member.user_id = ?", userID).
@@ -68,6 +71,9 @@ func (svc *service) ChallengeSubscriptionValidate(ctx context.Context, in *Chall
 			return nil, err
 		}
 		amountExpected = len(configData.Passphrases)
+		if configData.IsPerUser() {
+			amountExpected = int(subscription.SeasonChallenge.Flavor.Passphrases)
+		}
 		if amountExpected == 0 {
 			return nil, errcode.ErrChallengeInactiveValidation.Wrap(errors.New("challenge config is invalid"))
 		}
@@ -88,11 +94,17 @@ func (svc *service) ChallengeSubscriptionValidate(ctx context.Context, in *Chall
 		if err != nil {
 			return nil, err
 		}
-		for index, passphrase := range configData.Passphrases {
-			for _, userPassphrase := range in.Passphrases {
-				if passphrase == userPassphrase {
-					validPassphrases[index] = true
-					usedInstances[instance.ID] = true
+		candidates, err := expectedPassphrases(instance, configData, subscription.Team.GetMembers(), amountExpected)
+		if err != nil {
+			return nil, err
+		}
+		for _, passphrases := range candidates {
+			for index, passphrase := range passphrases {
+				for _, userPassphrase := range in.Passphrases {
+					if passphrase == userPassphrase {
+						validPassphrases[index] = true
+						usedInstances[instance.ID] = true
+					}
 				}
 			}
 		}
@@ -127,6 +139,7 @@ func (svc *service) ChallengeSubscriptionValidate(ctx context.Context, in *Chall
 		ChallengeSubscriptionID: in.ChallengeSubscriptionID,
 		Passphrases:             string(passphrases),
 		AuthorID:                userID,
+		TeamID:                  subscription.TeamID,
 		AuthorComment:           in.Comment,
 		Status:                  pwdb.ChallengeValidation_NeedReview,
 	}
@@ -137,6 +150,11 @@ func (svc *service) ChallengeSubscriptionValidate(ctx context.Context, in *Chall
 
 	// update DB
 	err = svc.db.Transaction(func(tx *gorm.DB) error {
+		err = lockSeasonChallenge(tx, subscription.SeasonChallengeID)
+		if err != nil {
+			return errcode.ErrUpdateChallengeSubscription.Wrap(err)
+		}
+
 		err = tx.Create(&validation).Error
 		if err != nil {
 			return errcode.ErrCreateChallengeValidation.Wrap(err)
@@ -155,7 +173,7 @@ func (svc *service) ChallengeSubscriptionValidate(ctx context.Context, in *Chall
 			return errcode.ErrUpdateChallengeSubscription.Wrap(err)
 		}
 
-		// mark used instances as needing a redump
+		// mark used instances as validated, the agent runs their on-validate hook and redumps them
 		usedInstanceIDs := make([]int64, len(usedInstances))
 		i := 0
 		for id := range usedInstances {
@@ -165,7 +183,7 @@ func (svc *service) ChallengeSubscriptionValidate(ctx context.Context, in *Chall
 		err = tx.
 			Model(&instances[0]).
 			Where("id IN (?)", usedInstanceIDs).
-			Update(pwdb.ChallengeInstance{Status: pwdb.ChallengeInstance_NeedRedump, InstanceConfig: []byte{}}).
+			Update(pwdb.ChallengeInstance{Status: pwdb.ChallengeInstance_Validated, InstanceConfig: []byte{}}).
 			Error
 		if err != nil {
 			return errcode.ErrAgentUpdateState.Wrap(err)
@@ -189,12 +207,44 @@ func (svc *service) ChallengeSubscriptionValidate(ctx context.Context, in *Chall
 			ChallengeFlavorID:       subscription.SeasonChallenge.FlavorID,
 			SeasonID:                subscription.SeasonChallenge.SeasonID,
 		}
-		return tx.Create(&activity).Error
+		err = tx.Create(&activity).Error
+		if err != nil {
+			return err
+		}
+
+		// update team score
+		if isScoringValidation(validation.Status) {
+			if err := updateSeasonChallengeScores(tx, subscription.SeasonChallenge, subscription.TeamID, userID); err != nil {
+				return errcode.ErrUpdateTeamScore.Wrap(err)
+			}
+			if _, err := awardMedals(tx, subscription.SeasonChallengeID); err != nil {
+				return errcode.ErrUpdateTeamMedals.Wrap(err)
+			}
+		}
+
+		// notify the team when the validation is accepted automatically, the other ones are notified once reviewed by an admin
+		if validation.Status == pwdb.ChallengeValidation_AutoAccepted {
+			userIDs, err := teamMemberIDs(tx, subscription.TeamID)
+			if err != nil {
+				return errcode.ErrCreateNotification.Wrap(err)
+			}
+			args := map[string]interface{}{
+				"challenge_validation_id": validation.ID,
+				"season_challenge_id":     subscription.SeasonChallengeID,
+				"status":                  validation.Status.String(),
+			}
+			if err := notifyUsers(tx, userIDs, challengeValidationAutoAcceptedMsg, seasonChallengeURL(subscription.SeasonChallengeID), args); err != nil {
+				return errcode.ErrCreateNotification.Wrap(err)
+			}
+		}
+		return nil
 	})
 	if err != nil {
 		return nil, err
 	}
 
+	svc.checkTeamAchievements(subscription.TeamID, userID)
+
 	// load updated challenge subscription with validations
 	err = svc.db.
 		Preload("Validations").
@@ -221,3 +271,23 @@ func (svc *service) ChallengeSubscriptionValidate(ctx context.Context, in *Chall
 	}
 	return &ret, nil
 }
+
+// expectedPassphrases returns the passphrases an instance accepts, the ones of each team member if they are per user
+func expectedPassphrases(instance *pwdb.ChallengeInstance, config *pwinit.InitConfig, members []*pwdb.TeamMember, amount int) ([][]string, error) {
+	if !config.IsPerUser() {
+		return [][]string{config.Passphrases}, nil
+	}
+	candidates := make([][]string, 0, len(members))
+	for _, member := range members {
+		prefixHash, err := pwdb.ChallengeInstancePrefixHash(fmt.Sprintf("%d", instance.ID), member.UserID, instance.GetAgent().GetAuthSalt())
+		if err != nil {
+			return nil, errcode.ErrGeneratePrefixHash.Wrap(err)
+		}
+		passphrases := make([]string, amount)
+		for index := range passphrases {
+			passphrases[index] = pwinit.DerivePassphrase(config.PassphraseSecret, index, prefixHash)
+		}
+		candidates = append(candidates, passphrases)
+	}
+	return candidates, nil
+}
@@ -2,6 +2,7 @@ package pwapi
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -9,6 +10,7 @@ import (
 	"pathwar.land/pathwar/v2/go/internal/testutil"
 	"pathwar.land/pathwar/v2/go/pkg/errcode"
 	"pathwar.land/pathwar/v2/go/pkg/pwdb"
+	"pathwar.land/pathwar/v2/go/pkg/pwinit"
 )
 
 func TestService_ChallengeSubscriptionValidate(t *testing.T) {
@@ -100,4 +102,62 @@ func TestService_ChallengeSubscriptionValidate(t *testing.T) {
 			// FIXME: hide previous passphrases
 		}
 	}
+
+	// the validated flavor gives its points to the team, its validation reward by default
+	team, err := svc.TeamGet(ctx, &TeamGet_Input{TeamID: activeTeam.ID})
+	require.NoError(t, err)
+	assert.Equal(t, challenges.Items[5].Flavor.ValidationReward, team.Item.Score)
+	assert.NotNil(t, team.Item.LastScoredAt)
+
+	// the used instances run their on-validate hook before being redumped
+	var validated int
+	db := testingSvcDB(t, svc)
+	require.NoError(t, db.Model(pwdb.ChallengeInstance{}).Where(pwdb.ChallengeInstance{FlavorID: challenges.Items[5].FlavorID, Status: pwdb.ChallengeInstance_Validated}).Count(&validated).Error)
+	assert.NotZero(t, validated)
+}
+
+func TestService_ChallengeSubscriptionValidate_PerUser(t *testing.T) {
+	svc, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t)})
+	defer cleanup()
+	ctx := testingSetContextToken(context.Background(), t)
+
+	solve := testingBuyChallenge(ctx, t, svc)
+	session := solve.session
+
+	// switch the flavor to per-user passphrases
+	db := testingSvcDB(t, svc)
+	flavorID := solve.seasonChallenge.FlavorID
+	err := db.Model(&pwdb.ChallengeFlavor{}).Where("id = ?", flavorID).UpdateColumns(map[string]interface{}{"per_user_passphrases": true, "passphrases": 2}).Error
+	require.NoError(t, err)
+	err = db.Model(&pwdb.ChallengeInstance{}).Where(pwdb.ChallengeInstance{FlavorID: flavorID}).UpdateColumn("instance_config", []byte(`{"passphrase_secret": "s3cr3t"}`)).Error
+	require.NoError(t, err)
+	var instance pwdb.ChallengeInstance
+	err = db.Preload("Agent").Where(pwdb.ChallengeInstance{FlavorID: flavorID, Status: pwdb.ChallengeInstance_Available}).First(&instance).Error
+	require.NoError(t, err)
+	prefixHash, err := pwdb.ChallengeInstancePrefixHash(fmt.Sprintf("%d", instance.ID), session.User.ID, instance.Agent.AuthSalt)
+	require.NoError(t, err)
+	otherPrefixHash, err := pwdb.ChallengeInstancePrefixHash(fmt.Sprintf("%d", instance.ID), session.User.ID+1000, instance.Agent.AuthSalt)
+	require.NoError(t, err)
+
+	var tests = []struct {
+		name        string
+		passphrases []string
+		expectedErr error
+	}{
+		{"shared passphrases", []string{"a", "b"}, errcode.ErrChallengeIncompleteValidation},
+		{"another player", []string{pwinit.DerivePassphrase("s3cr3t", 0, otherPrefixHash), pwinit.DerivePassphrase("s3cr3t", 1, otherPrefixHash)}, errcode.ErrChallengeIncompleteValidation},
+		{"incomplete", []string{pwinit.DerivePassphrase("s3cr3t", 0, prefixHash)}, errcode.ErrChallengeIncompleteValidation},
+		{"valid", []string{pwinit.DerivePassphrase("s3cr3t", 0, prefixHash), pwinit.DerivePassphrase("s3cr3t", 1, prefixHash)}, nil},
+	}
+	for _, test := range tests {
+		ret, err := svc.ChallengeSubscriptionValidate(ctx, &ChallengeSubscriptionValidate_Input{
+			ChallengeSubscriptionID: solve.subscription.ID,
+			Passphrases:             test.passphrases,
+		})
+		testSameErrcodes(t, test.name, test.expectedErr, err)
+		if err != nil {
+			continue
+		}
+		assert.Equalf(t, "[0,1]", ret.ChallengeValidation.Passphrases, test.name)
+	}
 }
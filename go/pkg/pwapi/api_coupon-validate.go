@@ -106,6 +106,8 @@ func (svc *service) CouponValidate(ctx context.Context, in *CouponValidate_Input
 		return nil, pwdb.GormToErrcode(err)
 	}
 
+	svc.checkTeamAchievements(team.ID, userID)
+
 	// load it again with preload
 	err = svc.db.
 		Preload("Team").
@@ -0,0 +1,46 @@
+package pwapi
+
+import (
+	"context"
+
+	"pathwar.land/pathwar/v2/go/pkg/errcode"
+	"pathwar.land/pathwar/v2/go/pkg/pwdb"
+)
+
+func (svc *service) NotificationList(ctx context.Context, in *NotificationList_Input) (*NotificationList_Output, error) {
+	if in == nil {
+		return nil, errcode.ErrMissingInput
+	}
+
+	userID, err := userIDFromContext(ctx, svc.db)
+	if err != nil {
+		return nil, errcode.ErrUnauthenticated.Wrap(err)
+	}
+
+	// query
+	query := svc.db.Where(pwdb.Notification{UserID: userID})
+	if in.UnreadOnly {
+		query = query.Where("notification.read_at IS NULL")
+	}
+	query, total, err := listQuery(query, in.ListOptions, listFilter{}, listConfig{
+		table:        "notification",
+		defaultOrder: "notification.created_at desc",
+		orderFields:  map[string]string{"read_at": "notification.read_at"},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var ret NotificationList_Output
+	err = query.Find(&ret.Items).Error
+	if err != nil {
+		return nil, errcode.ErrGetNotifications.Wrap(err)
+	}
+	ret.Total = total
+	ret.NextOffset = listNextOffset(in.ListOptions, len(ret.Items), total)
+	ret.Unread, err = unreadNotifications(svc.db, userID)
+	if err != nil {
+		return nil, errcode.ErrGetNotifications.Wrap(err)
+	}
+
+	return &ret, nil
+}
@@ -0,0 +1,84 @@
+package pwapi
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pathwar.land/pathwar/v2/go/internal/testutil"
+	"pathwar.land/pathwar/v2/go/pkg/errcode"
+	"pathwar.land/pathwar/v2/go/pkg/pwdb"
+)
+
+func TestService_NotificationList(t *testing.T) {
+	svc, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t)})
+	defer cleanup()
+	db := testingSvcDB(t, svc)
+	ctx := testingSetContextToken(context.Background(), t)
+	session, err := svc.UserGetSession(ctx, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int32(0), session.Notifications)
+	ctx2 := testingSetContextToken2(context.Background(), t)
+	session2, err := svc.UserGetSession(ctx2, nil)
+	require.NoError(t, err)
+
+	// an invite notifies the invited user
+	season := pwdb.Season{Name: "Notifications", Status: pwdb.Season_Started, Visibility: pwdb.Season_Public}
+	require.NoError(t, db.Create(&season).Error)
+	team, err := svc.TeamCreate(ctx, &TeamCreate_Input{SeasonID: fmt.Sprint(season.ID), Name: "NotificationsTeam"})
+	require.NoError(t, err)
+	_, err = svc.TeamSendInvite(ctx, &TeamSendInvite_Input{TeamID: fmt.Sprint(team.Team.ID), UserID: fmt.Sprint(session2.User.ID)})
+	require.NoError(t, err)
+	ret, err := svc.NotificationList(ctx2, &NotificationList_Input{})
+	require.NoError(t, err)
+	require.Len(t, ret.Items, 1)
+	assert.Equal(t, teamInviteReceivedMsg, ret.Items[0].Msg)
+	assert.Contains(t, ret.Items[0].Args, fmt.Sprintf(`"team_id":%d`, team.Team.ID))
+	assert.Equal(t, int64(1), ret.Unread)
+
+	// a coupon and a validation notify the achievements and the review
+	testingSolveChallenge(ctx, t, svc)
+
+	session, err = svc.UserGetSession(ctx, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int32(4), session.Notifications)
+	ret, err = svc.NotificationList(ctx, &NotificationList_Input{ListOptions: ListOptions{OrderBy: "created_at"}})
+	require.NoError(t, err)
+	msgs := []string{}
+	for _, notification := range ret.Items {
+		msgs = append(msgs, notification.Msg)
+	}
+	assert.Equal(t, []string{achievementUnlockedMsg, achievementUnlockedMsg, challengeValidationAutoAcceptedMsg, achievementUnlockedMsg}, msgs)
+
+	// paging
+	ret, err = svc.NotificationList(ctx, &NotificationList_Input{ListOptions: ListOptions{Limit: 3}})
+	require.NoError(t, err)
+	assert.Len(t, ret.Items, 3)
+	assert.Equal(t, int64(4), ret.Total)
+	assert.Equal(t, int64(3), ret.NextOffset)
+	_, err = svc.NotificationList(ctx, &NotificationList_Input{ListOptions: ListOptions{OrderBy: "msg"}})
+	testSameErrcodes(t, "", errcode.ErrInvalidListOptions, err)
+
+	// mark read
+	_, err = svc.NotificationMarkRead(ctx, &NotificationMarkRead_Input{})
+	testSameErrcodes(t, "", errcode.ErrMissingInput, err)
+	marked, err := svc.NotificationMarkRead(ctx, &NotificationMarkRead_Input{NotificationIDs: []int64{ret.Items[0].ID}})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), marked.Marked)
+	assert.Equal(t, int64(3), marked.Unread)
+	ret, err = svc.NotificationList(ctx, &NotificationList_Input{UnreadOnly: true})
+	require.NoError(t, err)
+	assert.Len(t, ret.Items, 3)
+	assert.Equal(t, int64(3), ret.Total)
+
+	// other users' notifications are left untouched
+	marked, err = svc.NotificationMarkRead(ctx, &NotificationMarkRead_Input{All: true})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), marked.Marked)
+	assert.Equal(t, int64(0), marked.Unread)
+	session2, err = svc.UserGetSession(ctx2, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), session2.Notifications)
+}
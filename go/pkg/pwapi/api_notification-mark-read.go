@@ -0,0 +1,40 @@
+package pwapi
+
+import (
+	"context"
+	"time"
+
+	"pathwar.land/pathwar/v2/go/pkg/errcode"
+	"pathwar.land/pathwar/v2/go/pkg/pwdb"
+)
+
+func (svc *service) NotificationMarkRead(ctx context.Context, in *NotificationMarkRead_Input) (*NotificationMarkRead_Output, error) {
+	if in == nil || (len(in.NotificationIDs) == 0 && !in.All) {
+		return nil, errcode.ErrMissingInput
+	}
+
+	userID, err := userIDFromContext(ctx, svc.db)
+	if err != nil {
+		return nil, errcode.ErrUnauthenticated.Wrap(err)
+	}
+
+	// only the unread notifications of the user are updated, the others are silently ignored
+	query := svc.db.
+		Model(&pwdb.Notification{}).
+		Where(pwdb.Notification{UserID: userID}).
+		Where("read_at IS NULL")
+	if !in.All {
+		query = query.Where("id IN (?)", in.NotificationIDs)
+	}
+	query = query.UpdateColumn("read_at", time.Now())
+	if err := query.Error; err != nil {
+		return nil, errcode.ErrMarkNotificationsRead.Wrap(err)
+	}
+
+	ret := NotificationMarkRead_Output{Marked: query.RowsAffected}
+	ret.Unread, err = unreadNotifications(svc.db, userID)
+	if err != nil {
+		return nil, errcode.ErrGetNotifications.Wrap(err)
+	}
+	return &ret, nil
+}
@@ -7,19 +7,35 @@ import (
 	"pathwar.land/pathwar/v2/go/pkg/pwdb"
 )
 
-func (svc *service) OrganizationList(context.Context, *OrganizationList_Input) (*OrganizationList_Output, error) {
+func (svc *service) OrganizationList(ctx context.Context, in *OrganizationList_Input) (*OrganizationList_Output, error) {
+	if in == nil {
+		return nil, errcode.ErrMissingInput
+	}
+
+	query := svc.db.
+		Where(pwdb.Organization{
+			DeletionStatus: pwdb.DeletionStatus_Active,
+		})
+	query, total, err := listQuery(query, in.ListOptions, listFilter{seasonID: in.SeasonID}, listConfig{
+		table:        "organization",
+		orderFields:  map[string]string{"name": "organization.name"},
+		seasonFilter: "organization.id IN (SELECT team.organization_id FROM team WHERE team.season_id = ?)",
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	var organizations OrganizationList_Output
-	err := svc.db.
+	err = query.
 		Preload("Teams").
 		// Preload("OrganizationMembers").
-		Where(pwdb.Organization{
-			DeletionStatus: pwdb.DeletionStatus_Active,
-		}).
 		Find(&organizations.Items).
 		Error
 	if err != nil {
 		return nil, errcode.ErrFindOrganizations.Wrap(err)
 	}
+	organizations.Total = total
+	organizations.NextOffset = listNextOffset(in.ListOptions, len(organizations.Items), total)
 
 	return &organizations, nil
 }
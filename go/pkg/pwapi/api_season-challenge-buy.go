@@ -120,6 +120,8 @@ func (svc *service) SeasonChallengeBuy(ctx context.Context, in *SeasonChallengeB
 		return nil, errcode.ErrCreateChallengeSubscription.Wrap(err)
 	}
 
+	svc.checkTeamAchievements(team.ID, userID)
+
 	// load and return the freshly inserted entry
 	err = svc.db.
 		Preload("Team", "team.deletion_status = ?", pwdb.DeletionStatus_Active).
@@ -43,6 +43,14 @@ func (svc *service) SeasonChallengeGet(ctx context.Context, in *SeasonChallengeG
 	if err != nil {
 		return nil, errcode.ErrGetSeasonChallenge.Wrap(err)
 	}
+	err = setSeasonChallengePoints(svc.db, season, []*pwdb.SeasonChallenge{&item})
+	if err != nil {
+		return nil, errcode.ErrGetSeasonChallenge.Wrap(err)
+	}
+	err = setSeasonChallengeFirstBloods(svc.db, &item)
+	if err != nil {
+		return nil, errcode.ErrGetSeasonChallenge.Wrap(err)
+	}
 	for _, instance := range item.Flavor.Instances {
 		// FIXME: hide instances without nginx-url?
 		instance.InstanceConfig = nil
@@ -30,25 +30,49 @@ func (svc *service) SeasonChallengeList(ctx context.Context, in *SeasonChallenge
 		return nil, errcode.ErrUserHasNoTeamForSeason.Wrap(err)
 	}
 
+	query := svc.db.
+		Joins("LEFT JOIN challenge_flavor ON season_challenge.flavor_id = challenge_flavor.id").
+		Where(pwdb.SeasonChallenge{SeasonID: in.SeasonID})
+	query, total, err := listQuery(query, in.ListOptions, listFilter{seasonID: in.SeasonID}, listConfig{
+		table:        "season_challenge",
+		defaultOrder: "challenge_flavor.purchase_price asc, challenge_flavor.validation_reward asc",
+		orderFields: map[string]string{
+			"purchase_price":    "challenge_flavor.purchase_price",
+			"validation_reward": "challenge_flavor.validation_reward",
+		},
+		seasonFilter: "season_challenge.season_id = ?",
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	var seasonChallenges []*pwdb.SeasonChallenge
-	err = svc.db.
+	err = query.
 		// Preload("Season").
 		Preload("Flavor").
-		Joins("LEFT JOIN challenge_flavor ON season_challenge.flavor_id = challenge_flavor.id").
 		Preload("Flavor.Challenge").
 		Preload("Flavor.Instances").
 		Preload("Flavor.Instances.Agent"). // FIXME: where status==active
 		Preload("Subscriptions", "team_id = ?", team.ID).
 		Preload("Subscriptions.Team").
 		Preload("Subscriptions.Team.Season").
-		Where(pwdb.SeasonChallenge{SeasonID: in.SeasonID}).
-		Order("challenge_flavor.purchase_price asc, challenge_flavor.validation_reward asc").
 		Find(&seasonChallenges).
 		Error
 	if err != nil {
 		return nil, errcode.ErrGetSeasonChallenges.Wrap(err)
 	}
 
+	// current points
+	var season pwdb.Season
+	err = svc.db.First(&season, in.SeasonID).Error
+	if err != nil {
+		return nil, errcode.ErrGetSeason.Wrap(err)
+	}
+	err = setSeasonChallengePoints(svc.db, &season, seasonChallenges)
+	if err != nil {
+		return nil, errcode.ErrGetSeasonChallenges.Wrap(err)
+	}
+
 	// prepare & cleanup
 	for _, sc := range seasonChallenges {
 		// FIXME: hide challenges without flavors?
@@ -82,7 +106,9 @@ func (svc *service) SeasonChallengeList(ctx context.Context, in *SeasonChallenge
 	}
 
 	ret := SeasonChallengeList_Output{
-		Items: seasonChallenges,
+		Items:      seasonChallenges,
+		Total:      total,
+		NextOffset: listNextOffset(in.ListOptions, len(seasonChallenges), total),
 	}
 	return &ret, nil
 }
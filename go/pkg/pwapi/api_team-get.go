@@ -2,7 +2,6 @@ package pwapi
 
 import (
 	"context"
-	"math/rand"
 
 	"pathwar.land/pathwar/v2/go/pkg/errcode"
 	"pathwar.land/pathwar/v2/go/pkg/pwdb"
@@ -31,14 +30,5 @@ func (svc *service) TeamGet(ctx context.Context, in *TeamGet_Input) (*TeamGet_Ou
 	}
 
 	ret := TeamGet_Output{Item: &item}
-
-	// tmp: fake data
-	// FIXME: use real data
-	item.GoldMedals = int64(rand.Intn(3))
-	item.SilverMedals = int64(rand.Intn(3))
-	item.BronzeMedals = int64(rand.Intn(4))
-	item.Score = int64(rand.Intn(100))
-	item.NbAchievements = int64(rand.Intn(10))
-
 	return &ret, nil
 }
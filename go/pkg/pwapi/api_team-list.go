@@ -2,7 +2,6 @@ package pwapi
 
 import (
 	"context"
-	"math/rand"
 
 	"pathwar.land/pathwar/v2/go/pkg/errcode"
 	"pathwar.land/pathwar/v2/go/pkg/pwdb"
@@ -19,32 +18,31 @@ func (svc *service) TeamList(ctx context.Context, in *TeamList_Input) (*TeamList
 	}
 
 	// query
+	query := svc.db.
+		Where(pwdb.Team{DeletionStatus: pwdb.DeletionStatus_Active})
+	query, total, err := listQuery(query, in.ListOptions, listFilter{seasonID: in.SeasonID}, listConfig{
+		table:        "team",
+		defaultOrder: "team.score desc, team.last_scored_at asc",
+		orderFields:  map[string]string{"score": "team.score", "cash": "team.cash", "last_scored_at": "team.last_scored_at"},
+		seasonFilter: "team.season_id = ?",
+	})
+	if err != nil {
+		return nil, err
+	}
 	var ret TeamList_Output
-	err = svc.db.
+	err = query.
 		Preload("Organization").
 		// Preload("Season").
 		// Preload("Members").
 		// Preload("ChallengeSubscription").
 		// Preload("Achievements").
-		Where(pwdb.Team{
-			SeasonID:       in.SeasonID,
-			DeletionStatus: pwdb.DeletionStatus_Active,
-		}).
 		Find(&ret.Items).
 		Error
 	if err != nil {
 		return nil, errcode.ErrGetTeams.Wrap(err)
 	}
-
-	// add fake data
-	// FIXME: use real data instead
-	for _, team := range ret.Items {
-		team.GoldMedals = int64(rand.Intn(3))
-		team.SilverMedals = int64(rand.Intn(3))
-		team.BronzeMedals = int64(rand.Intn(4))
-		team.Score = int64(rand.Intn(100))
-		team.NbAchievements = int64(rand.Intn(10))
-	}
+	ret.Total = total
+	ret.NextOffset = listNextOffset(in.ListOptions, len(ret.Items), total)
 
 	return &ret, nil
 }
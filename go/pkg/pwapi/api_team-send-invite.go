@@ -104,14 +104,25 @@ func (svc *service) TeamSendInvite(ctx context.Context, in *TeamSendInvite_Input
 			OrganizationID: team.OrganizationID,
 			SeasonID:       team.SeasonID,
 		}
-		return tx.Create(&activity).Error
+		err = tx.Create(&activity).Error
+		if err != nil {
+			return err
+		}
+
+		args := map[string]interface{}{
+			"team_invite_id": teamInvite.ID,
+			"team_id":        team.ID,
+			"author_id":      userID,
+		}
+		if err := notifyUsers(tx, []int64{invitedUserID}, teamInviteReceivedMsg, teamURL(team.ID), args); err != nil {
+			return errcode.ErrCreateNotification.Wrap(err)
+		}
+		return nil
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	// FIXME: Notify invited user
-
 	ret := TeamSendInvite_Output{
 		TeamInvite: &teamInvite,
 	}
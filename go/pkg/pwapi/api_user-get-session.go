@@ -4,7 +4,6 @@ import (
 	"context"
 	"crypto/md5"
 	"fmt"
-	"math/rand"
 	"strings"
 
 	"github.com/gosimple/slug"
@@ -50,8 +49,11 @@ func (svc *service) UserGetSession(ctx context.Context, _ *UserGetSession_Input)
 		// FIXME: also update the solo organization
 	}
 
-	// FIXME: output.Notifications = COUNT
-	output.Notifications = int32(rand.Intn(10))
+	unread, err := unreadNotifications(svc.db, output.User.ID)
+	if err != nil {
+		return nil, errcode.ErrGetNotifications.Wrap(err)
+	}
+	output.Notifications = int32(unread)
 
 	output.Seasons, err = svc.loadUserSeasons(ctx)
 	if err != nil {
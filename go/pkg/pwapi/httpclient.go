@@ -46,6 +46,20 @@ func (c HTTPClient) AgentUpdateState(ctx context.Context, input *AgentUpdateStat
 	return result, err
 }
 
+func (c HTTPClient) AgentDrain(ctx context.Context, input *AgentDrain_Input) (AgentDrain_Output, error) {
+	var _ *AgentDrain_Input = input
+	var result AgentDrain_Output
+	err := c.doPost(ctx, "/agent/drain", input, &result)
+	return result, err
+}
+
+func (c HTTPClient) AdminAgentDrain(ctx context.Context, input *AdminAgentDrain_Input) (AdminAgentDrain_Output, error) {
+	var _ *AdminAgentDrain_Input = input
+	var result AdminAgentDrain_Output
+	err := c.doPost(ctx, "/admin/agent-drain", input, &result)
+	return result, err
+}
+
 func (c HTTPClient) AdminRedump(ctx context.Context, input *AdminRedump_Input) (AdminRedump_Output, error) {
 	var _ *AdminRedump_Input = input
 	var result AdminRedump_Output
@@ -144,6 +158,13 @@ func (c HTTPClient) AdminRedumpChallenge(ctx context.Context, input *AdminChalle
 	return result, err
 }
 
+func (c HTTPClient) AdminChallengeRegister(ctx context.Context, input *AdminChallengeRegister_Input) (AdminChallengeRegister_Output, error) {
+	var _ *AdminChallengeRegister_Input = input
+	var result AdminChallengeRegister_Output
+	err := c.doPost(ctx, "/admin/challenge-register", input, &result)
+	return result, err
+}
+
 func (c HTTPClient) AdminAddChallengeFlavor(ctx context.Context, input *AdminChallengeFlavorAdd_Input) (AdminChallengeFlavorAdd_Output, error) {
 	var _ *AdminChallengeFlavorAdd_Input = input
 	var result AdminChallengeFlavorAdd_Output
@@ -165,6 +186,76 @@ func (c HTTPClient) AdminAddSeason(ctx context.Context, input *AdminSeasonAdd_In
 	return result, err
 }
 
+func (c HTTPClient) NotificationList(ctx context.Context, input *NotificationList_Input) (NotificationList_Output, error) {
+	var _ *NotificationList_Input = input
+	var result NotificationList_Output
+	err := c.doGet(ctx, "/notifications", input, &result)
+	return result, err
+}
+
+func (c HTTPClient) NotificationMarkRead(ctx context.Context, input *NotificationMarkRead_Input) (NotificationMarkRead_Output, error) {
+	var _ *NotificationMarkRead_Input = input
+	var result NotificationMarkRead_Output
+	err := c.doPost(ctx, "/notifications/mark-read", input, &result)
+	return result, err
+}
+
+func (c HTTPClient) TeamList(ctx context.Context, input *TeamList_Input) (TeamList_Output, error) {
+	var _ *TeamList_Input = input
+	var result TeamList_Output
+	err := c.doGet(ctx, "/teams", input, &result)
+	return result, err
+}
+
+func (c HTTPClient) SeasonChallengeList(ctx context.Context, input *SeasonChallengeList_Input) (SeasonChallengeList_Output, error) {
+	var _ *SeasonChallengeList_Input = input
+	var result SeasonChallengeList_Output
+	err := c.doGet(ctx, "/season-challenges", input, &result)
+	return result, err
+}
+
+func (c HTTPClient) OrganizationList(ctx context.Context, input *OrganizationList_Input) (OrganizationList_Output, error) {
+	var _ *OrganizationList_Input = input
+	var result OrganizationList_Output
+	err := c.doGet(ctx, "/organizations", input, &result)
+	return result, err
+}
+
+func (c HTTPClient) AdminSeasonSetScoring(ctx context.Context, input *AdminSeasonSetScoring_Input) (AdminSeasonSetScoring_Output, error) {
+	var _ *AdminSeasonSetScoring_Input = input
+	var result AdminSeasonSetScoring_Output
+	err := c.doPost(ctx, "/admin/season-set-scoring", input, &result)
+	return result, err
+}
+
+func (c HTTPClient) AdminRecomputeScores(ctx context.Context, input *AdminRecomputeScores_Input) (AdminRecomputeScores_Output, error) {
+	var _ *AdminRecomputeScores_Input = input
+	var result AdminRecomputeScores_Output
+	err := c.doPost(ctx, "/admin/recompute-scores", input, &result)
+	return result, err
+}
+
+func (c HTTPClient) AdminRecomputeMedals(ctx context.Context, input *AdminRecomputeMedals_Input) (AdminRecomputeMedals_Output, error) {
+	var _ *AdminRecomputeMedals_Input = input
+	var result AdminRecomputeMedals_Output
+	err := c.doPost(ctx, "/admin/recompute-medals", input, &result)
+	return result, err
+}
+
+func (c HTTPClient) AdminBackfillAchievements(ctx context.Context, input *AdminBackfillAchievements_Input) (AdminBackfillAchievements_Output, error) {
+	var _ *AdminBackfillAchievements_Input = input
+	var result AdminBackfillAchievements_Output
+	err := c.doPost(ctx, "/admin/backfill-achievements", input, &result)
+	return result, err
+}
+
+func (c HTTPClient) AdminChallengeValidationReview(ctx context.Context, input *AdminChallengeValidationReview_Input) (AdminChallengeValidationReview_Output, error) {
+	var _ *AdminChallengeValidationReview_Input = input
+	var result AdminChallengeValidationReview_Output
+	err := c.doPost(ctx, "/admin/challenge-validation-review", input, &result)
+	return result, err
+}
+
 func (c HTTPClient) GetStatus(ctx context.Context, input *GetStatus_Input) (GetStatus_Output, error) {
 	var _ *GetStatus_Input = input
 	var result GetStatus_Output
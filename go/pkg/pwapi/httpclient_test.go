@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -38,3 +39,29 @@ func TestHTTPClient_GetStatus(t *testing.T) {
 	assert.Equal(t, err.Error(), `TODO(#666): TODO(#666): invalid status code (500): "{\n  \"code\": 2,\n  \"message\": \"ErrUnauthenticated(#103)\"\n}"`)
 	assert.Equal(t, UserSetPreferences_Output{}, ret)
 }
+
+func TestHTTPClient_AdminListChallenges(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	logger := testutil.Logger(t)
+
+	server, cleanup := TestingServer(t, ctx, ServerOpts{Logger: logger})
+	defer cleanup()
+	hc := &http.Client{
+		Transport: pwsso.TestingTransport(t),
+	}
+	client := NewHTTPClient(hc, fmt.Sprintf("http://%s", server.ListenerAddr()))
+
+	all, err := client.AdminListChallenges(ctx, &AdminListChallenges_Input{})
+	require.NoError(t, err)
+	require.True(t, len(all.Challenges) > 1)
+
+	// the list options are passed as query parameters
+	before := time.Now().Add(time.Hour)
+	page, err := client.AdminListChallenges(ctx, &AdminListChallenges_Input{ListOptions: ListOptions{Limit: 1, OrderBy: "-id", CreatedBefore: &before}})
+	require.NoError(t, err)
+	require.Len(t, page.Challenges, 1)
+	assert.Equal(t, all.Total, page.Total)
+	assert.Equal(t, int64(1), page.NextOffset)
+	assert.Equal(t, all.Challenges[len(all.Challenges)-1].ID, page.Challenges[0].ID)
+}
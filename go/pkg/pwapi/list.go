@@ -0,0 +1,138 @@
+package pwapi
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/google/go-querystring/query"
+	"github.com/jinzhu/gorm"
+	"pathwar.land/pathwar/v2/go/pkg/errcode"
+	"pathwar.land/pathwar/v2/go/pkg/pwdb"
+)
+
+const (
+	defaultListLimit = 100
+	maxListLimit     = 1000
+)
+
+// listFilter are the filters supported by some list endpoints, the zero values are ignored
+type listFilter struct {
+	seasonID int64
+	status   string
+}
+
+// listConfig describes how a list endpoint filters and sorts its table
+type listConfig struct {
+	table        string
+	defaultOrder string            // defaults to the table id
+	orderFields  map[string]string // sortable columns in addition to id, created_at and updated_at
+	seasonFilter string            // condition with a season_id placeholder, empty if the items are not bound to a season
+	statusColumn string            // empty if the items have no status
+	statusValues map[string]int32
+}
+
+// listQuery filters the query with the options and the filter, counts the matching items, then sorts and pages the query
+func listQuery(db *gorm.DB, opts ListOptions, filter listFilter, config listConfig) (*gorm.DB, int64, error) {
+	limit, offset := opts.Limit, opts.Offset
+	if limit < 0 || offset < 0 {
+		return nil, 0, errcode.ErrInvalidListOptions.Wrap(fmt.Errorf("limit and offset should be positive"))
+	}
+	if limit == 0 {
+		limit = defaultListLimit
+	}
+	if limit > maxListLimit {
+		limit = maxListLimit
+	}
+
+	// filters
+	if seasonID := filter.seasonID; seasonID != 0 {
+		if config.seasonFilter == "" {
+			return nil, 0, errcode.ErrInvalidListOptions.Wrap(fmt.Errorf("%s cannot be filtered by season", config.table))
+		}
+		db = db.Where(config.seasonFilter, seasonID)
+	}
+	if status := filter.status; status != "" {
+		if config.statusColumn == "" {
+			return nil, 0, errcode.ErrInvalidListOptions.Wrap(fmt.Errorf("%s cannot be filtered by status", config.table))
+		}
+		value, found := int32(0), false
+		for name, candidate := range config.statusValues {
+			if strings.EqualFold(name, status) {
+				value, found = candidate, true
+				break
+			}
+		}
+		if !found {
+			return nil, 0, errcode.ErrInvalidListOptions.Wrap(fmt.Errorf("unknown %s status: %q", config.table, status))
+		}
+		db = db.Where(config.table+"."+config.statusColumn+" = ?", value)
+	}
+	after, before := opts.CreatedAfter, opts.CreatedBefore
+	if after != nil && before != nil && !after.Before(*before) {
+		return nil, 0, errcode.ErrInvalidListOptions.Wrap(fmt.Errorf("created_after should be before created_before"))
+	}
+	if after != nil {
+		db = db.Where(config.table+".created_at > ?", *after)
+	}
+	if before != nil {
+		db = db.Where(config.table+".created_at < ?", *before)
+	}
+
+	var total int64
+	err := db.Table(config.table).Count(&total).Error
+	if err != nil {
+		return nil, 0, pwdb.GormToErrcode(err)
+	}
+
+	// sort order, always ending with the id to have stable pages
+	id := config.table + ".id"
+	order := config.defaultOrder
+	if order == "" {
+		order = id + " asc"
+	}
+	if orderBy := opts.OrderBy; orderBy != "" {
+		direction := "asc"
+		if strings.HasPrefix(orderBy, "-") {
+			orderBy = orderBy[1:]
+			direction = "desc"
+		}
+		var column string
+		switch orderBy {
+		case "id", "created_at", "updated_at":
+			column = config.table + "." + orderBy
+		default:
+			column = config.orderFields[orderBy]
+		}
+		if column == "" {
+			return nil, 0, errcode.ErrInvalidListOptions.Wrap(fmt.Errorf("%s cannot be ordered by %q", config.table, orderBy))
+		}
+		order = column + " " + direction
+	}
+	if !strings.HasPrefix(order, id+" ") {
+		order += ", " + id + " asc"
+	}
+
+	return db.Order(order).Limit(limit).Offset(offset), total, nil
+}
+
+// listNextOffset returns the offset of the page following the one returned, or zero on the last page
+func listNextOffset(opts ListOptions, count int, total int64) int64 {
+	next := opts.Offset + int64(count)
+	if next >= total {
+		return 0
+	}
+	return next
+}
+
+// EncodeValues encodes the options in a query string with the names expected by the gateway, i.e., options.limit
+func (opts ListOptions) EncodeValues(key string, values *url.Values) error {
+	encoded, err := query.Values(opts)
+	if err != nil {
+		return err
+	}
+	for name, value := range encoded {
+		(*values)[key+"."+name] = value
+	}
+	return nil
+}
@@ -0,0 +1,221 @@
+package pwapi
+
+import (
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"pathwar.land/pathwar/v2/go/pkg/pwdb"
+)
+
+// medalRanking lists the medals awarded to the first teams validating a season challenge
+var medalRanking = []pwdb.ChallengeValidation_Medal{
+	pwdb.ChallengeValidation_GoldMedal,
+	pwdb.ChallengeValidation_SilverMedal,
+	pwdb.ChallengeValidation_BronzeMedal,
+}
+
+// lockSeasonChallenge updates the season challenge row first in a transaction,
+// so the concurrent validations of a season challenge are serialized and each one sees the previous ones
+func lockSeasonChallenge(tx *gorm.DB, seasonChallengeID int64) error {
+	return tx.
+		Model(&pwdb.SeasonChallenge{}).
+		Where("id = ?", seasonChallengeID).
+		UpdateColumn("updated_at", time.Now()).
+		Error
+}
+
+// awardMedals gives the medals of a season challenge to the first accepted validations of the first three active teams,
+// takes them back from the others, and returns the teams whose medals changed
+func awardMedals(tx *gorm.DB, seasonChallengeID int64) ([]int64, error) {
+	var validations []struct {
+		ID     int64
+		TeamID int64
+		Medal  pwdb.ChallengeValidation_Medal
+	}
+	err := tx.
+		Table("challenge_validation").
+		Select("challenge_validation.id, challenge_subscription.team_id, challenge_validation.medal").
+		Joins("JOIN challenge_subscription ON challenge_subscription.id = challenge_validation.challenge_subscription_id").
+		Joins("JOIN team ON team.id = challenge_subscription.team_id").
+		Where("challenge_subscription.season_challenge_id = ?", seasonChallengeID).
+		Where("challenge_validation.status IN (?)", scoringValidationStatuses).
+		Where("team.deletion_status = ?", pwdb.DeletionStatus_Active).
+		Order("challenge_validation.created_at asc, challenge_validation.id asc").
+		Scan(&validations).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	expected := map[int64]pwdb.ChallengeValidation_Medal{}
+	ranked := map[int64]bool{}
+	for _, validation := range validations {
+		if len(ranked) == len(medalRanking) {
+			break
+		}
+		if ranked[validation.TeamID] {
+			continue
+		}
+		expected[validation.ID] = medalRanking[len(ranked)]
+		ranked[validation.TeamID] = true
+	}
+
+	// the current medals include the ones of refused validations and deleted teams
+	var current []struct {
+		ID     int64
+		TeamID int64
+		Medal  pwdb.ChallengeValidation_Medal
+	}
+	err = tx.
+		Table("challenge_validation").
+		Select("challenge_validation.id, challenge_subscription.team_id, challenge_validation.medal").
+		Joins("JOIN challenge_subscription ON challenge_subscription.id = challenge_validation.challenge_subscription_id").
+		Where("challenge_subscription.season_challenge_id = ?", seasonChallengeID).
+		Where("challenge_validation.medal <> ?", pwdb.ChallengeValidation_NoMedal).
+		Scan(&current).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	changed := map[int64]bool{}
+	for _, validation := range current {
+		if expected[validation.ID] == validation.Medal {
+			continue
+		}
+		err = tx.
+			Model(&pwdb.ChallengeValidation{}).
+			Where("id = ?", validation.ID).
+			UpdateColumn("medal", pwdb.ChallengeValidation_NoMedal).
+			Error
+		if err != nil {
+			return nil, err
+		}
+		changed[validation.TeamID] = true
+	}
+	for _, validation := range validations {
+		medal, found := expected[validation.ID]
+		if !found || medal == validation.Medal {
+			continue
+		}
+		err = tx.
+			Model(&pwdb.ChallengeValidation{}).
+			Where("id = ?", validation.ID).
+			UpdateColumn("medal", medal).
+			Error
+		if err != nil {
+			return nil, err
+		}
+		changed[validation.TeamID] = true
+	}
+
+	teamIDs := make([]int64, 0, len(changed))
+	for teamID := range changed {
+		if err := updateTeamMedals(tx, teamID); err != nil {
+			return nil, err
+		}
+		teamIDs = append(teamIDs, teamID)
+	}
+	return teamIDs, nil
+}
+
+// updateTeamMedals counts the medals of a team's validations into its counters
+func updateTeamMedals(tx *gorm.DB, teamID int64) error {
+	var counts []struct {
+		Medal pwdb.ChallengeValidation_Medal
+		Count int64
+	}
+	err := tx.
+		Table("challenge_validation").
+		Select("challenge_validation.medal, COUNT(*) AS count").
+		Joins("JOIN challenge_subscription ON challenge_subscription.id = challenge_validation.challenge_subscription_id").
+		Where("challenge_subscription.team_id = ?", teamID).
+		Where("challenge_validation.medal <> ?", pwdb.ChallengeValidation_NoMedal).
+		Group("challenge_validation.medal").
+		Scan(&counts).
+		Error
+	if err != nil {
+		return err
+	}
+
+	columns := map[string]interface{}{
+		"gold_medals":   0,
+		"silver_medals": 0,
+		"bronze_medals": 0,
+	}
+	for _, count := range counts {
+		switch count.Medal {
+		case pwdb.ChallengeValidation_GoldMedal:
+			columns["gold_medals"] = count.Count
+		case pwdb.ChallengeValidation_SilverMedal:
+			columns["silver_medals"] = count.Count
+		case pwdb.ChallengeValidation_BronzeMedal:
+			columns["bronze_medals"] = count.Count
+		}
+	}
+	return tx.Model(&pwdb.Team{}).Where("id = ?", teamID).UpdateColumns(columns).Error
+}
+
+// setSeasonChallengeFirstBloods fills the validations awarded a medal of a season challenge, with their team
+func setSeasonChallengeFirstBloods(db *gorm.DB, seasonChallenge *pwdb.SeasonChallenge) error {
+	var firstBloods []*pwdb.ChallengeValidation
+	err := db.
+		Preload("Team").
+		Joins("JOIN challenge_subscription ON challenge_subscription.id = challenge_validation.challenge_subscription_id").
+		Where("challenge_subscription.season_challenge_id = ?", seasonChallenge.ID).
+		Where("challenge_validation.medal <> ?", pwdb.ChallengeValidation_NoMedal).
+		Order("challenge_validation.medal asc").
+		Find(&firstBloods).
+		Error
+	if err != nil {
+		return err
+	}
+	for _, validation := range firstBloods {
+		validation.Passphrases = ""
+	}
+	seasonChallenge.FirstBloods = firstBloods
+	return nil
+}
+
+// recomputeMedals awards the medals of every season challenge of a season, or of every season, and returns the teams whose medals changed
+func recomputeMedals(db *gorm.DB, seasonID int64) ([]*pwdb.Team, error) {
+	changed := []*pwdb.Team{}
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var seasonChallengeIDs []int64
+		query := tx.Model(&pwdb.SeasonChallenge{})
+		if seasonID != 0 {
+			query = query.Where(pwdb.SeasonChallenge{SeasonID: seasonID})
+		}
+		err := query.Pluck("id", &seasonChallengeIDs).Error
+		if err != nil {
+			return err
+		}
+
+		changedIDs := map[int64]bool{}
+		for _, seasonChallengeID := range seasonChallengeIDs {
+			if err := lockSeasonChallenge(tx, seasonChallengeID); err != nil {
+				return err
+			}
+			teamIDs, err := awardMedals(tx, seasonChallengeID)
+			if err != nil {
+				return err
+			}
+			for _, teamID := range teamIDs {
+				changedIDs[teamID] = true
+			}
+		}
+		if len(changedIDs) == 0 {
+			return nil
+		}
+
+		teamIDs := make([]int64, 0, len(changedIDs))
+		for teamID := range changedIDs {
+			teamIDs = append(teamIDs, teamID)
+		}
+		return tx.Where("id IN (?)", teamIDs).Order("id asc").Find(&changed).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return changed, nil
+}
@@ -0,0 +1,100 @@
+package pwapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"pathwar.land/pathwar/v2/go/pkg/pwdb"
+)
+
+// notification messages, the clients translate them and format their args
+const (
+	achievementUnlockedMsg             = "achievement_unlocked"
+	challengeValidationAutoAcceptedMsg = "challenge_validation_auto_accepted"
+	challengeValidationReviewedMsg     = "challenge_validation_reviewed"
+	seasonAddedMsg                     = "season_added"
+	seasonChallengeAddedMsg            = "season_challenge_added"
+	teamInviteReceivedMsg              = "team_invite_received"
+)
+
+// notificationsBatchSize keeps the batched inserts under the placeholders limit of sqlite
+const notificationsBatchSize = 100
+
+// web pages opened when clicking the notifications
+const challengesURL = "/app/challenges"
+
+func seasonChallengeURL(seasonChallengeID int64) string {
+	return fmt.Sprintf("/app/challenges/%d", seasonChallengeID)
+}
+
+func teamURL(teamID int64) string {
+	return fmt.Sprintf("/app/team/%d", teamID)
+}
+
+// notifyUsers creates the same notification for several users, by batches, the args are marshaled as JSON
+func notifyUsers(tx *gorm.DB, userIDs []int64, msg string, clickURL string, args interface{}) error {
+	marshaled, err := json.Marshal(args)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	for start := 0; start < len(userIDs); start += notificationsBatchSize {
+		end := start + notificationsBatchSize
+		if end > len(userIDs) {
+			end = len(userIDs)
+		}
+		rows := make([]string, 0, end-start)
+		values := make([]interface{}, 0, 7*(end-start))
+		for _, userID := range userIDs[start:end] {
+			id, err := pwdb.NewID(tx)
+			if err != nil {
+				return err
+			}
+			rows = append(rows, "(?, ?, ?, ?, ?, ?, ?)")
+			values = append(values, id, now, now, userID, msg, string(marshaled), clickURL)
+		}
+		query := "INSERT INTO notification (id, created_at, updated_at, user_id, msg, args, click_url) VALUES " + strings.Join(rows, ", ")
+		if err := tx.Exec(query, values...).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// teamMemberIDs returns the ids of the users of a team
+func teamMemberIDs(db *gorm.DB, teamID int64) ([]int64, error) {
+	var userIDs []int64
+	err := db.
+		Model(&pwdb.TeamMember{}).
+		Where(pwdb.TeamMember{TeamID: teamID}).
+		Pluck("user_id", &userIDs).
+		Error
+	return userIDs, err
+}
+
+// unreadNotifications counts the notifications of a user not marked as read
+func unreadNotifications(db *gorm.DB, userID int64) (int64, error) {
+	var count int64
+	err := db.
+		Model(&pwdb.Notification{}).
+		Where(pwdb.Notification{UserID: userID}).
+		Where("read_at IS NULL").
+		Count(&count).
+		Error
+	return count, err
+}
+
+// seasonMemberIDs returns the ids of the users having an active team in a season
+func seasonMemberIDs(db *gorm.DB, seasonID int64) ([]int64, error) {
+	var userIDs []int64
+	err := db.
+		Table("team_member").
+		Joins("JOIN team ON team.id = team_member.team_id").
+		Where("team.season_id = ? AND team.deletion_status = ?", seasonID, pwdb.DeletionStatus_Active).
+		Pluck("DISTINCT team_member.user_id", &userIDs).
+		Error
+	return userIDs, err
+}
@@ -0,0 +1,332 @@
+package pwapi
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"pathwar.land/pathwar/v2/go/pkg/errcode"
+	"pathwar.land/pathwar/v2/go/pkg/pwdb"
+)
+
+// default dynamic scoring curve
+const (
+	defaultScoringInitial = 500
+	defaultScoringMinimum = 100
+	defaultScoringDecay   = 20
+)
+
+// scoringValidationStatuses are the statuses of the validations giving points to their team
+var scoringValidationStatuses = []pwdb.ChallengeValidation_Status{
+	pwdb.ChallengeValidation_Accepted,
+	pwdb.ChallengeValidation_AutoAccepted,
+}
+
+func isScoringValidation(status pwdb.ChallengeValidation_Status) bool {
+	for _, candidate := range scoringValidationStatuses {
+		if status == candidate {
+			return true
+		}
+	}
+	return false
+}
+
+// flavorPoints returns the points of a flavor, falling back on its validation reward
+func flavorPoints(points, validationReward int64) int64 {
+	if points != 0 {
+		return points
+	}
+	return validationReward
+}
+
+// dynamicPoints returns the points of a season challenge solved by a number of teams.
+// Like CTFd, the value follows a parabola from initial for the first solve to minimum after decay solves.
+func dynamicPoints(initial, minimum, decay, solves int64) int64 {
+	if decay <= 0 {
+		return initial
+	}
+	if solves > 0 {
+		solves--
+	}
+	value := int64(math.Ceil(float64(minimum-initial)/float64(decay*decay)*float64(solves*solves) + float64(initial)))
+	if value < minimum {
+		return minimum
+	}
+	return value
+}
+
+// seasonChallengePoints returns the points of a season challenge solved by a number of teams.
+// With dynamic scoring, a flavor without explicit points follows the curve of the season; the curve of a flavor with
+// points is scaled by them, i.e., a flavor worth twice the initial points starts and ends twice higher, and it keeps
+// at least one point.
+func seasonChallengePoints(season pwdb.Season, points, validationReward, solves int64) int64 {
+	if !season.DynamicScoring || season.ScoringInitial <= 0 {
+		return flavorPoints(points, validationReward)
+	}
+	if points == 0 {
+		return dynamicPoints(season.ScoringInitial, season.ScoringMinimum, season.ScoringDecay, solves)
+	}
+	minimum := int64(math.Ceil(float64(season.ScoringMinimum) * float64(points) / float64(season.ScoringInitial)))
+	if minimum < 1 && points > 0 {
+		minimum = 1
+	}
+	return dynamicPoints(points, minimum, season.ScoringDecay, solves)
+}
+
+// validateScoringCurve applies the default dynamic scoring curve and checks it
+func validateScoringCurve(season *pwdb.Season) error {
+	if !season.DynamicScoring {
+		return nil
+	}
+	if season.ScoringInitial == 0 {
+		season.ScoringInitial = defaultScoringInitial
+	}
+	if season.ScoringMinimum == 0 {
+		season.ScoringMinimum = defaultScoringMinimum
+	}
+	if season.ScoringDecay == 0 {
+		season.ScoringDecay = defaultScoringDecay
+	}
+	if season.ScoringMinimum < 0 || season.ScoringMinimum > season.ScoringInitial || season.ScoringDecay < 0 {
+		return errcode.ErrInvalidScoringCurve.Wrap(fmt.Errorf("expected 0 <= minimum (%d) <= initial (%d) and decay (%d) > 0", season.ScoringMinimum, season.ScoringInitial, season.ScoringDecay))
+	}
+	return nil
+}
+
+// seasonChallengeSolves counts the teams having validated each season challenge
+func seasonChallengeSolves(db *gorm.DB, seasonChallengeIDs []int64) (map[int64]int64, error) {
+	var rows []struct {
+		SeasonChallengeID int64
+		Solves            int64
+	}
+	err := db.
+		Table("challenge_validation").
+		Select("challenge_subscription.season_challenge_id, COUNT(DISTINCT challenge_subscription.team_id) AS solves").
+		Joins("JOIN challenge_subscription ON challenge_subscription.id = challenge_validation.challenge_subscription_id").
+		Where("challenge_subscription.season_challenge_id IN (?)", seasonChallengeIDs).
+		Where("challenge_validation.status IN (?)", scoringValidationStatuses).
+		Group("challenge_subscription.season_challenge_id").
+		Scan(&rows).
+		Error
+	if err != nil {
+		return nil, err
+	}
+	solves := make(map[int64]int64, len(rows))
+	for _, row := range rows {
+		solves[row.SeasonChallengeID] = row.Solves
+	}
+	return solves, nil
+}
+
+// setSeasonChallengePoints fills the current points and solves of season challenges of a season, with their flavors preloaded
+func setSeasonChallengePoints(db *gorm.DB, season *pwdb.Season, seasonChallenges []*pwdb.SeasonChallenge) error {
+	if len(seasonChallenges) == 0 {
+		return nil
+	}
+	ids := make([]int64, len(seasonChallenges))
+	for i, seasonChallenge := range seasonChallenges {
+		ids[i] = seasonChallenge.ID
+	}
+	solves, err := seasonChallengeSolves(db, ids)
+	if err != nil {
+		return err
+	}
+	for _, seasonChallenge := range seasonChallenges {
+		seasonChallenge.Solves = solves[seasonChallenge.ID]
+		if seasonChallenge.Flavor != nil {
+			seasonChallenge.Points = seasonChallengePoints(*season, seasonChallenge.Flavor.Points, seasonChallenge.Flavor.ValidationReward, seasonChallenge.Solves)
+		}
+	}
+	return nil
+}
+
+type teamScore struct {
+	score        int64
+	lastScoredAt *time.Time
+}
+
+func (s teamScore) equals(team *pwdb.Team) bool {
+	if s.score != team.Score {
+		return false
+	}
+	if s.lastScoredAt == nil || team.LastScoredAt == nil {
+		return s.lastScoredAt == nil && team.LastScoredAt == nil
+	}
+	return s.lastScoredAt.Equal(*team.LastScoredAt)
+}
+
+// computeTeamScore sums the points of the season challenges validated by a team, each one counted at its first accepted validation
+func computeTeamScore(db *gorm.DB, teamID int64) (teamScore, error) {
+	var validations []struct {
+		SeasonChallengeID int64
+		Points            int64
+		ValidationReward  int64
+		DynamicScoring    bool
+		ScoringInitial    int64
+		ScoringMinimum    int64
+		ScoringDecay      int64
+		ValidatedAt       time.Time
+	}
+	err := db.
+		Table("challenge_validation").
+		Select("challenge_subscription.season_challenge_id, challenge_flavor.points, challenge_flavor.validation_reward, season.dynamic_scoring, season.scoring_initial, season.scoring_minimum, season.scoring_decay, challenge_validation.created_at AS validated_at").
+		Joins("JOIN challenge_subscription ON challenge_subscription.id = challenge_validation.challenge_subscription_id").
+		Joins("JOIN season_challenge ON season_challenge.id = challenge_subscription.season_challenge_id").
+		Joins("JOIN challenge_flavor ON challenge_flavor.id = season_challenge.flavor_id").
+		Joins("JOIN season ON season.id = season_challenge.season_id").
+		Where("challenge_subscription.team_id = ?", teamID).
+		Where("challenge_validation.status IN (?)", scoringValidationStatuses).
+		Order("challenge_validation.created_at asc, challenge_validation.id asc").
+		Scan(&validations).
+		Error
+	if err != nil {
+		return teamScore{}, err
+	}
+
+	var dynamic []int64
+	for _, validation := range validations {
+		if validation.DynamicScoring {
+			dynamic = append(dynamic, validation.SeasonChallengeID)
+		}
+	}
+	var solves map[int64]int64
+	if len(dynamic) > 0 {
+		solves, err = seasonChallengeSolves(db, dynamic)
+		if err != nil {
+			return teamScore{}, err
+		}
+	}
+
+	var score teamScore
+	validated := map[int64]bool{}
+	for _, validation := range validations {
+		if validated[validation.SeasonChallengeID] {
+			continue
+		}
+		validated[validation.SeasonChallengeID] = true
+		validatedAt := validation.ValidatedAt
+		season := pwdb.Season{
+			DynamicScoring: validation.DynamicScoring,
+			ScoringInitial: validation.ScoringInitial,
+			ScoringMinimum: validation.ScoringMinimum,
+			ScoringDecay:   validation.ScoringDecay,
+		}
+		score.score += seasonChallengePoints(season, validation.Points, validation.ValidationReward, solves[validation.SeasonChallengeID])
+		score.lastScoredAt = &validatedAt
+	}
+	return score, nil
+}
+
+// changeTeamScore stores the score of a team and records its change as an activity completed with the team's fields
+func changeTeamScore(tx *gorm.DB, team *pwdb.Team, score teamScore, activity pwdb.Activity) error {
+	err := tx.
+		Model(&pwdb.Team{}).
+		Where("id = ?", team.ID).
+		UpdateColumns(map[string]interface{}{
+			"score":          score.score,
+			"last_scored_at": score.lastScoredAt,
+		}).
+		Error
+	if err != nil {
+		return err
+	}
+
+	delta := score.score - team.Score
+	team.Score = score.score
+	team.LastScoredAt = score.lastScoredAt
+	if delta == 0 {
+		return nil
+	}
+	activity.Kind = pwdb.Activity_TeamScoreChange
+	activity.TeamID = team.ID
+	activity.SeasonID = team.SeasonID
+	activity.OrganizationID = team.OrganizationID
+	activity.ScoreDelta = delta
+	return tx.Create(&activity).Error
+}
+
+// updateSeasonChallengeScores updates the score of a team validating a season challenge.
+// With dynamic scoring, the scores of the previous solvers are updated too since the challenge is now worth less.
+func updateSeasonChallengeScores(tx *gorm.DB, seasonChallenge *pwdb.SeasonChallenge, teamID int64, authorID int64) error {
+	teamIDs := []int64{teamID}
+	if seasonChallenge.Season.DynamicScoring {
+		err := tx.
+			Table("challenge_validation").
+			Joins("JOIN challenge_subscription ON challenge_subscription.id = challenge_validation.challenge_subscription_id").
+			Where("challenge_subscription.season_challenge_id = ?", seasonChallenge.ID).
+			Where("challenge_validation.status IN (?)", scoringValidationStatuses).
+			Pluck("DISTINCT challenge_subscription.team_id", &teamIDs).
+			Error
+		if err != nil {
+			return err
+		}
+	}
+
+	var teams []*pwdb.Team
+	err := tx.Where("id IN (?)", teamIDs).Find(&teams).Error
+	if err != nil {
+		return err
+	}
+	for _, team := range teams {
+		score, err := computeTeamScore(tx, team.ID)
+		if err != nil {
+			return err
+		}
+		if score.equals(team) {
+			continue
+		}
+		activity := pwdb.Activity{
+			AuthorID:          authorID,
+			SeasonChallengeID: seasonChallenge.ID,
+			ChallengeFlavorID: seasonChallenge.FlavorID,
+		}
+		if err := changeTeamScore(tx, team, score, activity); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// recomputeScores updates the score of every team of a season, or of every season, and returns the teams whose score changed
+func recomputeScores(db *gorm.DB, seasonID int64, authorID int64) ([]*pwdb.Team, error) {
+	var changed []*pwdb.Team
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		changed, err = recomputeTeamScores(tx, seasonID, authorID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return changed, nil
+}
+
+// recomputeTeamScores is recomputeScores within a transaction
+func recomputeTeamScores(tx *gorm.DB, seasonID int64, authorID int64) ([]*pwdb.Team, error) {
+	var teams []*pwdb.Team
+	query := tx
+	if seasonID != 0 {
+		query = query.Where(pwdb.Team{SeasonID: seasonID})
+	}
+	err := query.Find(&teams).Error
+	if err != nil {
+		return nil, err
+	}
+
+	changed := []*pwdb.Team{}
+	for _, team := range teams {
+		score, err := computeTeamScore(tx, team.ID)
+		if err != nil {
+			return nil, err
+		}
+		if score.equals(team) {
+			continue
+		}
+		if err := changeTeamScore(tx, team, score, pwdb.Activity{AuthorID: authorID}); err != nil {
+			return nil, err
+		}
+		changed = append(changed, team)
+	}
+	return changed, nil
+}
@@ -0,0 +1,120 @@
+package pwapi
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pathwar.land/pathwar/v2/go/internal/testutil"
+	"pathwar.land/pathwar/v2/go/pkg/pwdb"
+)
+
+func TestDynamicPoints(t *testing.T) {
+	tests := []struct {
+		initial, minimum, decay, solves int64
+		expected                        int64
+	}{
+		{500, 100, 20, 0, 500},
+		{500, 100, 20, 1, 500},
+		{500, 100, 20, 2, 499},
+		{500, 100, 20, 11, 400},
+		{500, 100, 20, 21, 100},
+		{500, 100, 20, 42, 100},
+		{500, 100, 0, 42, 500},
+	}
+	for _, test := range tests {
+		assert.Equalf(t, test.expected, dynamicPoints(test.initial, test.minimum, test.decay, test.solves), "%+v", test)
+	}
+}
+
+func TestSeasonChallengePoints(t *testing.T) {
+	curve := pwdb.Season{DynamicScoring: true, ScoringInitial: 500, ScoringMinimum: 100, ScoringDecay: 20}
+	tests := []struct {
+		name             string
+		season           pwdb.Season
+		points           int64
+		validationReward int64
+		solves           int64
+		expected         int64
+	}{
+		{"static", pwdb.Season{}, 42, 1, 10, 42},
+		{"static-reward", pwdb.Season{}, 0, 5, 10, 5},
+		{"curve", curve, 500, 0, 11, 400},
+		{"curve-minimum", curve, 500, 0, 42, 100},
+		{"twice", curve, 1000, 0, 0, 1000},
+		{"twice-decayed", curve, 1000, 0, 11, 800},
+		{"twice-minimum", curve, 1000, 0, 42, 200},
+		{"tenth-minimum", curve, 50, 0, 42, 10},
+		{"one-point", curve, 1, 0, 0, 1},
+		{"one-point-minimum", curve, 1, 0, 42, 1},
+		{"five-points-minimum", curve, 5, 0, 42, 1},
+		{"seven-points-minimum", curve, 7, 0, 42, 2},
+		{"one-point-reward", curve, 0, 1, 0, 500},
+		{"one-point-reward-minimum", curve, 0, 1, 42, 100},
+		{"five-points-reward", curve, 0, 5, 11, 400},
+		{"five-points-reward-minimum", curve, 0, 5, 42, 100},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.expected, seasonChallengePoints(test.season, test.points, test.validationReward, test.solves), test.name)
+	}
+}
+
+func TestService_DynamicScoring(t *testing.T) {
+	svc, cleanup := TestingService(t, ServiceOpts{Logger: testutil.Logger(t)})
+	defer cleanup()
+	ctx := testingSetContextToken(context.Background(), t)
+	db := testingSvcDB(t, svc)
+	gs := testingGlobalSeason(t, svc)
+
+	// a challenge worth the initial points loses 100 points at its second solve
+	require.NoError(t, db.Model(&pwdb.Season{ID: gs.ID}).UpdateColumns(map[string]interface{}{
+		"dynamic_scoring": true,
+		"scoring_initial": 500,
+		"scoring_minimum": 100,
+		"scoring_decay":   2,
+	}).Error)
+
+	// first solve
+	solve := testingBuyChallenge(ctx, t, svc)
+	session, activeTeam, seasonChallenge := solve.session, solve.team, solve.seasonChallenge
+	// without points, the flavor follows the curve of the season
+	assert.Equal(t, int64(0), seasonChallenge.Flavor.Points)
+	assert.Equal(t, int64(500), seasonChallenge.Points)
+
+	// the curve is scaled by the points of the flavor, this one is worth twice the initial points
+	require.NoError(t, db.Model(&pwdb.ChallengeFlavor{ID: seasonChallenge.FlavorID}).UpdateColumn("points", 1000).Error)
+	challenge, err := svc.SeasonChallengeGet(ctx, &SeasonChallengeGet_Input{SeasonChallengeID: seasonChallenge.ID})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1000), challenge.Item.Points)
+	assert.Equal(t, int64(0), challenge.Item.Solves)
+	testingValidateChallenge(ctx, t, svc, solve.subscription.ID)
+	team, err := svc.TeamGet(ctx, &TeamGet_Input{TeamID: activeTeam.ID})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1000), team.Item.Score)
+
+	// second solve, by another team
+	otherTeam := testingAcceptedValidation(t, svc, seasonChallenge, session.User.ID, "dynamic-scoring")
+	var loaded pwdb.SeasonChallenge
+	require.NoError(t, db.Preload("Season").First(&loaded, seasonChallenge.ID).Error)
+	require.NoError(t, updateSeasonChallengeScores(db, &loaded, otherTeam.ID, session.User.ID))
+
+	// both solvers get the decayed points
+	challenge, err = svc.SeasonChallengeGet(ctx, &SeasonChallengeGet_Input{SeasonChallengeID: seasonChallenge.ID})
+	require.NoError(t, err)
+	assert.Equal(t, int64(800), challenge.Item.Points)
+	assert.Equal(t, int64(2), challenge.Item.Solves)
+	team, err = svc.TeamGet(ctx, &TeamGet_Input{TeamID: activeTeam.ID})
+	require.NoError(t, err)
+	assert.Equal(t, int64(800), team.Item.Score)
+	require.NoError(t, db.First(otherTeam, otherTeam.ID).Error)
+	assert.Equal(t, int64(800), otherTeam.Score)
+
+	// score changes are recorded as activities
+	var changes []*pwdb.Activity
+	require.NoError(t, db.Where(pwdb.Activity{Kind: pwdb.Activity_TeamScoreChange, TeamID: activeTeam.ID}).Order("id asc").Find(&changes).Error)
+	require.Len(t, changes, 2)
+	assert.Equal(t, int64(1000), changes[0].ScoreDelta)
+	assert.Equal(t, int64(-200), changes[1].ScoreDelta)
+	assert.Equal(t, seasonChallenge.ID, changes[1].SeasonChallengeID)
+}
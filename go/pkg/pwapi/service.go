@@ -38,6 +38,18 @@ func NewService(db *gorm.DB, sso pwsso.Client, opts ServiceOpts) (Service, error
 		}
 	}
 
+	if opts.AchievementsInterval > 0 {
+		svc.achievementsDone = make(chan struct{})
+		svc.achievementsStopped = make(chan struct{})
+		go svc.achievementsLoop(opts.AchievementsInterval)
+	}
+
+	if opts.DrainsInterval > 0 {
+		svc.drainsDone = make(chan struct{})
+		svc.drainsStopped = make(chan struct{})
+		go svc.drainsLoop(opts.DrainsInterval)
+	}
+
 	return svc, nil
 }
 
@@ -48,16 +60,34 @@ type service struct {
 	snowflake *snowflake.Node
 	startedAt time.Time
 	logger    *zap.Logger
+
+	achievementsDone    chan struct{}
+	achievementsStopped chan struct{}
+	drainsDone          chan struct{}
+	drainsStopped       chan struct{}
 }
 
 type ServiceOpts struct {
 	Logger    *zap.Logger
 	Snowflake *snowflake.Node
+
+	// AchievementsInterval is the delay between two evaluations of the time-based achievements, disabled if 0
+	AchievementsInterval time.Duration
+	// DrainsInterval is the delay between two checks of the drain deadlines of the agents, disabled if 0
+	DrainsInterval time.Duration
 }
 
 func (svc *service) Close() error {
 	// Note: everything passed in the New() should be closed by the parent of service.
 	// Here you need to close everything started by the service itself.
+	if svc.achievementsDone != nil {
+		close(svc.achievementsDone)
+		<-svc.achievementsStopped
+	}
+	if svc.drainsDone != nil {
+		close(svc.drainsDone)
+		<-svc.drainsStopped
+	}
 	svc.opts.Logger.Debug("closed service")
 	return nil
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/jinzhu/gorm"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"pathwar.land/pathwar/v2/go/pkg/errcode"
 	"pathwar.land/pathwar/v2/go/pkg/pwdb"
 	"pathwar.land/pathwar/v2/go/pkg/pwsso"
@@ -121,3 +122,79 @@ func testSameErrcodes(t *testing.T, name string, expected, got error) {
 		"%s%v", prefix, got,
 	)
 }
+
+// testingChallengeSolve is the state left by testingBuyChallenge and testingSolveChallenge
+type testingChallengeSolve struct {
+	session         *UserGetSession_Output
+	team            *pwdb.Team
+	seasonChallenge *pwdb.SeasonChallenge
+	subscription    *pwdb.ChallengeSubscription
+	validation      *pwdb.ChallengeValidation
+}
+
+// testingBuyChallenge gives some cash to the team of the user and buys a challenge of the global season with an
+// available instance
+func testingBuyChallenge(ctx context.Context, t *testing.T, svc Service) *testingChallengeSolve {
+	t.Helper()
+
+	gs := testingGlobalSeason(t, svc)
+	session, err := svc.UserGetSession(ctx, nil)
+	require.NoError(t, err)
+	team := session.User.ActiveTeamMember.Team
+	_, err = svc.CouponValidate(ctx, &CouponValidate_Input{Hash: "test-coupon-1", TeamID: team.ID})
+	require.NoError(t, err)
+	challenges, err := svc.SeasonChallengeList(ctx, &SeasonChallengeList_Input{SeasonID: gs.ID})
+	require.NoError(t, err)
+	seasonChallenge := challenges.Items[5]
+	bought, err := svc.SeasonChallengeBuy(ctx, &SeasonChallengeBuy_Input{FlavorID: seasonChallenge.Flavor.Slug, SeasonID: team.Season.Slug})
+	require.NoError(t, err)
+
+	return &testingChallengeSolve{
+		session:         session,
+		team:            team,
+		seasonChallenge: seasonChallenge,
+		subscription:    bought.ChallengeSubscription,
+	}
+}
+
+// testingSolveChallenge buys a challenge like testingBuyChallenge, then validates it with the passphrases of its
+// instances
+func testingSolveChallenge(ctx context.Context, t *testing.T, svc Service) *testingChallengeSolve {
+	t.Helper()
+
+	solve := testingBuyChallenge(ctx, t, svc)
+	solve.validation = testingValidateChallenge(ctx, t, svc, solve.subscription.ID)
+	return solve
+}
+
+func testingValidateChallenge(ctx context.Context, t *testing.T, svc Service, subscriptionID int64) *pwdb.ChallengeValidation {
+	t.Helper()
+
+	ret, err := svc.ChallengeSubscriptionValidate(ctx, &ChallengeSubscriptionValidate_Input{
+		ChallengeSubscriptionID: subscriptionID,
+		Passphrases:             []string{"a", "b", "c", "d"},
+	})
+	require.NoError(t, err)
+	return ret.ChallengeValidation
+}
+
+// testingAcceptedValidation creates another team in the season of the challenge with an accepted validation, without
+// updating the scores nor the medals
+func testingAcceptedValidation(t *testing.T, svc Service, seasonChallenge *pwdb.SeasonChallenge, authorID int64, name string) *pwdb.Team {
+	t.Helper()
+
+	db := testingSvcDB(t, svc)
+	organization := pwdb.Organization{Name: name}
+	require.NoError(t, db.Create(&organization).Error)
+	team := pwdb.Team{SeasonID: seasonChallenge.SeasonID, OrganizationID: organization.ID, DeletionStatus: pwdb.DeletionStatus_Active}
+	require.NoError(t, db.Create(&team).Error)
+	subscription := pwdb.ChallengeSubscription{TeamID: team.ID, SeasonChallengeID: seasonChallenge.ID, BuyerID: authorID}
+	require.NoError(t, db.Create(&subscription).Error)
+	require.NoError(t, db.Create(&pwdb.ChallengeValidation{
+		ChallengeSubscriptionID: subscription.ID,
+		TeamID:                  team.ID,
+		AuthorID:                authorID,
+		Status:                  pwdb.ChallengeValidation_Accepted,
+	}).Error)
+	return &team
+}
@@ -0,0 +1,316 @@
+package pwcompose
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sort"
+	"strings"
+	"time"
+
+	"pathwar.land/pathwar/v2/go/pkg/errcode"
+)
+
+const (
+	// BundleFormat identifies a bundle envelope, a compose bundle without it is a plain prepared compose file
+	BundleFormat = "pathwar-bundle"
+	// BundleVersion is the version of the bundle format generated by Prepare
+	BundleVersion = 1
+
+	bundleManifestFile   = "manifest.json"
+	bundleComposeFile    = "docker-compose.yml"
+	bundleOnInitDir      = "on-init/"
+	bundleAttachmentsDir = "attachments/"
+)
+
+// Bundle is the content of a challenge bundle archive.
+type Bundle struct {
+	Manifest BundleManifest
+	// Compose is the prepared docker-compose.yml file
+	Compose string
+	// OnInit contains the on-init hooks, by service name
+	OnInit map[string][]byte
+	// Attachments contains the files of the attachments/ directory of the challenge, by relative path
+	Attachments map[string][]byte
+}
+
+// BundleManifest describes a bundle archive.
+type BundleManifest struct {
+	Version   int             `json:"version"`
+	Challenge string          `json:"challenge"`
+	Pathwar   PathwarMetadata `json:"x-pathwar"`
+	// Images are the images of the services, by service name, pinned to a digest when they were pushed
+	Images map[string]string `json:"images,omitempty"`
+}
+
+// SignedBundle is the envelope stored in ChallengeFlavor.ComposeBundle.
+//
+// The hash is computed on the archive and is what gets signed, so a bundle is identified by its content.
+type SignedBundle struct {
+	Format    string `json:"format"`
+	Version   int    `json:"version"`
+	Hash      string `json:"hash"`
+	PublicKey []byte `json:"public_key,omitempty"`
+	Signature []byte `json:"signature,omitempty"`
+	Archive   []byte `json:"archive"`
+}
+
+// NewSignedBundle packs a bundle into a versioned archive, and signs it if a key is provided.
+func NewSignedBundle(bundle Bundle, key ed25519.PrivateKey) (*SignedBundle, error) {
+	bundle.Manifest.Version = BundleVersion
+	archive, err := bundle.archive()
+	if err != nil {
+		return nil, errcode.ErrComposeCreateBundle.Wrap(err)
+	}
+
+	signed := SignedBundle{
+		Format:  BundleFormat,
+		Version: BundleVersion,
+		Hash:    bundleHash(archive),
+		Archive: archive,
+	}
+	if key != nil {
+		signed.PublicKey = key.Public().(ed25519.PublicKey)
+		signed.Signature = ed25519.Sign(key, []byte(signed.Hash))
+	}
+	return &signed, nil
+}
+
+// Marshal returns the representation of the bundle stored in ChallengeFlavor.ComposeBundle.
+func (sb SignedBundle) Marshal() (string, error) {
+	out, err := json.Marshal(sb)
+	if err != nil {
+		return "", errcode.ErrComposeCreateBundle.Wrap(err)
+	}
+	return string(out), nil
+}
+
+// Verify checks the integrity of the archive and, if trusted keys are provided, that it was signed by one of them.
+func (sb SignedBundle) Verify(trustedKeys []ed25519.PublicKey) error {
+	if hash := bundleHash(sb.Archive); hash != sb.Hash {
+		return errcode.ErrComposeBundleHashMismatch.Wrap(fmt.Errorf("expected %s, got %s", sb.Hash, hash))
+	}
+	if len(trustedKeys) == 0 {
+		return nil
+	}
+	if len(sb.Signature) == 0 {
+		return errcode.ErrComposeUnsignedBundle
+	}
+	for _, key := range trustedKeys {
+		if ed25519.Verify(key, []byte(sb.Hash), sb.Signature) {
+			return nil
+		}
+	}
+	return errcode.ErrComposeUntrustedBundle
+}
+
+// IsBundle returns true if the compose bundle is a bundle envelope rather than a plain prepared compose file.
+func IsBundle(composeBundle string) bool {
+	_, ok := parseSignedBundle(composeBundle)
+	return ok
+}
+
+// OpenBundle verifies a compose bundle against the trusted keys and unpacks it.
+//
+// Plain prepared compose files are still supported for compatibility, unless trusted keys are configured.
+func OpenBundle(composeBundle string, trustedKeys []ed25519.PublicKey) (*Bundle, error) {
+	signed, ok := parseSignedBundle(composeBundle)
+	if !ok {
+		if len(trustedKeys) > 0 {
+			return nil, errcode.ErrComposeUnsignedBundle
+		}
+		return &Bundle{Compose: composeBundle}, nil
+	}
+	if signed.Version != BundleVersion {
+		return nil, errcode.ErrComposeInvalidBundle.Wrap(fmt.Errorf("unsupported bundle version: %d", signed.Version))
+	}
+	if err := signed.Verify(trustedKeys); err != nil {
+		return nil, err
+	}
+
+	bundle, err := unpackBundle(signed.Archive)
+	if err != nil {
+		return nil, errcode.ErrComposeInvalidBundle.Wrap(err)
+	}
+	return bundle, nil
+}
+
+// ReadPrivateKey reads an ed25519 private key from a PEM file, i.e., generated with `openssl genpkey -algorithm ed25519`.
+func ReadPrivateKey(path string) (ed25519.PrivateKey, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errcode.ErrComposeReadKey.Wrap(err)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errcode.ErrComposeReadKey.Wrap(fmt.Errorf("%s: no PEM data found", path))
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, errcode.ErrComposeReadKey.Wrap(err)
+	}
+	privateKey, ok := key.(ed25519.PrivateKey)
+	if !ok {
+		return nil, errcode.ErrComposeReadKey.Wrap(fmt.Errorf("%s: not an ed25519 private key", path))
+	}
+	return privateKey, nil
+}
+
+// ReadPublicKeys reads the ed25519 public keys of a PEM file, i.e., generated with `openssl pkey -pubout`.
+// The file can contain several keys.
+func ReadPublicKeys(path string) ([]ed25519.PublicKey, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errcode.ErrComposeReadKey.Wrap(err)
+	}
+	var keys []ed25519.PublicKey
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		key, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, errcode.ErrComposeReadKey.Wrap(err)
+		}
+		publicKey, ok := key.(ed25519.PublicKey)
+		if !ok {
+			return nil, errcode.ErrComposeReadKey.Wrap(fmt.Errorf("%s: not an ed25519 public key", path))
+		}
+		keys = append(keys, publicKey)
+	}
+	if len(keys) == 0 {
+		return nil, errcode.ErrComposeReadKey.Wrap(fmt.Errorf("%s: no public key found", path))
+	}
+	return keys, nil
+}
+
+func parseSignedBundle(composeBundle string) (*SignedBundle, bool) {
+	trimmed := strings.TrimSpace(composeBundle)
+	if !strings.HasPrefix(trimmed, "{") {
+		return nil, false
+	}
+	var signed SignedBundle
+	if err := json.Unmarshal([]byte(trimmed), &signed); err != nil || signed.Format != BundleFormat {
+		return nil, false
+	}
+	return &signed, true
+}
+
+func bundleHash(archive []byte) string {
+	sum := sha256.Sum256(archive)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+// archive generates a reproducible tar.gz archive, the same bundle always has the same hash
+func (b Bundle) archive() ([]byte, error) {
+	manifest, err := json.MarshalIndent(b.Manifest, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	files := map[string][]byte{
+		bundleManifestFile: manifest,
+		bundleComposeFile:  []byte(b.Compose),
+	}
+	for name, content := range b.OnInit {
+		files[bundleOnInitDir+name] = content
+	}
+	for name, content := range b.Attachments {
+		files[bundleAttachmentsDir+name] = content
+	}
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var buf bytes.Buffer
+	gzipWriter := gzip.NewWriter(&buf)
+	tarWriter := tar.NewWriter(gzipWriter)
+	for _, name := range names {
+		mode := int64(0644)
+		if strings.HasPrefix(name, bundleOnInitDir) {
+			mode = 0755
+		}
+		header := tar.Header{
+			Name:    name,
+			Mode:    mode,
+			Size:    int64(len(files[name])),
+			ModTime: time.Unix(0, 0),
+		}
+		if err := tarWriter.WriteHeader(&header); err != nil {
+			return nil, err
+		}
+		if _, err := tarWriter.Write(files[name]); err != nil {
+			return nil, err
+		}
+	}
+	if err := tarWriter.Close(); err != nil {
+		return nil, err
+	}
+	if err := gzipWriter.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func unpackBundle(archive []byte) (*Bundle, error) {
+	gzipReader, err := gzip.NewReader(bytes.NewReader(archive))
+	if err != nil {
+		return nil, err
+	}
+	defer gzipReader.Close()
+
+	bundle := Bundle{
+		OnInit:      map[string][]byte{},
+		Attachments: map[string][]byte{},
+	}
+	var hasManifest, hasCompose bool
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		content, err := ioutil.ReadAll(tarReader)
+		if err != nil {
+			return nil, err
+		}
+		switch name := header.Name; {
+		case name == bundleManifestFile:
+			if err := json.Unmarshal(content, &bundle.Manifest); err != nil {
+				return nil, err
+			}
+			hasManifest = true
+		case name == bundleComposeFile:
+			bundle.Compose = string(content)
+			hasCompose = true
+		case strings.HasPrefix(name, bundleOnInitDir):
+			bundle.OnInit[strings.TrimPrefix(name, bundleOnInitDir)] = content
+		case strings.HasPrefix(name, bundleAttachmentsDir):
+			bundle.Attachments[strings.TrimPrefix(name, bundleAttachmentsDir)] = content
+		default:
+			return nil, fmt.Errorf("unexpected file in bundle: %q", name)
+		}
+	}
+	if !hasManifest || !hasCompose {
+		return nil, fmt.Errorf("bundle should contain %s and %s", bundleManifestFile, bundleComposeFile)
+	}
+	if bundle.Manifest.Version != BundleVersion {
+		return nil, fmt.Errorf("unsupported manifest version: %d", bundle.Manifest.Version)
+	}
+	return &bundle, nil
+}
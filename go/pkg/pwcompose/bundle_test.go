@@ -0,0 +1,175 @@
+package pwcompose
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pathwar.land/pathwar/v2/go/pkg/errcode"
+)
+
+func testingBundle() Bundle {
+	return Bundle{
+		Manifest: BundleManifest{
+			Challenge: "testing",
+			Images:    map[string]string{"front": "pathwar/testing@sha256:1234"},
+		},
+		Compose:     "services:\n  front:\n    image: pathwar/testing@sha256:1234\n",
+		OnInit:      map[string][]byte{"front": []byte("#!/bin/sh\necho init\n")},
+		Attachments: map[string][]byte{"docs/README.md": []byte("hello")},
+	}
+}
+
+func testingKey(t *testing.T) (ed25519.PublicKey, ed25519.PrivateKey) {
+	t.Helper()
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	return public, private
+}
+
+func testingMarshalBundle(t *testing.T, signed *SignedBundle) string {
+	t.Helper()
+	out, err := signed.Marshal()
+	require.NoError(t, err)
+	return out
+}
+
+func TestOpenBundle_Signed(t *testing.T) {
+	public, private := testingKey(t)
+	signed, err := NewSignedBundle(testingBundle(), private)
+	require.NoError(t, err)
+	composeBundle := testingMarshalBundle(t, signed)
+	assert.True(t, IsBundle(composeBundle))
+
+	bundle, err := OpenBundle(composeBundle, []ed25519.PublicKey{public})
+	require.NoError(t, err)
+	expected := testingBundle()
+	expected.Manifest.Version = BundleVersion
+	assert.Equal(t, expected, *bundle)
+
+	// the archive is reproducible, a bundle is identified by its content
+	again, err := NewSignedBundle(testingBundle(), private)
+	require.NoError(t, err)
+	assert.Equal(t, signed.Hash, again.Hash)
+	assert.Equal(t, bundleHash(signed.Archive), signed.Hash)
+}
+
+func TestOpenBundle_Errors(t *testing.T) {
+	public, private := testingKey(t)
+	otherPublic, otherPrivate := testingKey(t)
+	trusted := []ed25519.PublicKey{public}
+
+	signed, err := NewSignedBundle(testingBundle(), private)
+	require.NoError(t, err)
+	unsigned, err := NewSignedBundle(testingBundle(), nil)
+	require.NoError(t, err)
+	untrusted, err := NewSignedBundle(testingBundle(), otherPrivate)
+	require.NoError(t, err)
+
+	// the archive is modified after the signature
+	tampered := *signed
+	tampered.Archive = append([]byte{}, signed.Archive...)
+	tampered.Archive[len(tampered.Archive)-1] ^= 0xff
+	// the hash is updated too, but cannot be signed again without the key
+	rehashed := tampered
+	rehashed.Hash = bundleHash(rehashed.Archive)
+	// the archive is valid, but not a bundle
+	invalidArchive := *unsigned
+	invalidArchive.Archive = []byte("not a tar.gz")
+	invalidArchive.Hash = bundleHash(invalidArchive.Archive)
+	unsupportedVersion := *unsigned
+	unsupportedVersion.Version = BundleVersion + 1
+
+	tests := []struct {
+		name          string
+		composeBundle string
+		trustedKeys   []ed25519.PublicKey
+		expectedErr   error
+	}{
+		{"signed-trusted", testingMarshalBundle(t, signed), trusted, nil},
+		{"signed-several-keys", testingMarshalBundle(t, signed), []ed25519.PublicKey{otherPublic, public}, nil},
+		{"signed-no-trusted-keys", testingMarshalBundle(t, signed), nil, nil},
+		{"unsigned-no-trusted-keys", testingMarshalBundle(t, unsigned), nil, nil},
+		{"plain-compose", "services: {}", nil, nil},
+		{"tampered", testingMarshalBundle(t, &tampered), trusted, errcode.ErrComposeBundleHashMismatch},
+		{"tampered-no-trusted-keys", testingMarshalBundle(t, &tampered), nil, errcode.ErrComposeBundleHashMismatch},
+		{"tampered-rehashed", testingMarshalBundle(t, &rehashed), trusted, errcode.ErrComposeUntrustedBundle},
+		{"untrusted", testingMarshalBundle(t, untrusted), trusted, errcode.ErrComposeUntrustedBundle},
+		{"unsigned", testingMarshalBundle(t, unsigned), trusted, errcode.ErrComposeUnsignedBundle},
+		{"plain-compose-trusted-keys", "services: {}", trusted, errcode.ErrComposeUnsignedBundle},
+		{"malformed-json", `{"format": "pathwar-bundle", "archive": `, trusted, errcode.ErrComposeUnsignedBundle},
+		{"malformed-archive", testingMarshalBundle(t, &invalidArchive), nil, errcode.ErrComposeInvalidBundle},
+		{"unsupported-version", testingMarshalBundle(t, &unsupportedVersion), nil, errcode.ErrComposeInvalidBundle},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			bundle, err := OpenBundle(test.composeBundle, test.trustedKeys)
+			if test.expectedErr == nil {
+				require.NoError(t, err)
+				assert.NotEmpty(t, bundle.Compose)
+				return
+			}
+			assert.Equalf(t, errcode.Code(test.expectedErr), errcode.Code(err), "%v", err)
+			assert.Nil(t, bundle)
+		})
+	}
+}
+
+func TestUnpackBundle_Malformed(t *testing.T) {
+	_, err := unpackBundle(nil)
+	assert.Error(t, err)
+
+	bundle := testingBundle()
+	bundle.Manifest.Version = BundleVersion + 1
+	archive, err := bundle.archive()
+	require.NoError(t, err)
+	_, err = unpackBundle(archive)
+	assert.Error(t, err)
+}
+
+func TestReadPublicKeys(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pwcompose-keys")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	encode := func(t *testing.T, key interface{}) []byte {
+		t.Helper()
+		der, err := x509.MarshalPKIXPublicKey(key)
+		require.NoError(t, err)
+		return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+	}
+	first, _ := testingKey(t)
+	second, _ := testingKey(t)
+	write := func(t *testing.T, name string, content []byte) string {
+		t.Helper()
+		path := filepath.Join(dir, name)
+		require.NoError(t, ioutil.WriteFile(path, content, 0600))
+		return path
+	}
+
+	keys, err := ReadPublicKeys(write(t, "keys.pem", append(encode(t, first), encode(t, second)...)))
+	require.NoError(t, err)
+	assert.Equal(t, []ed25519.PublicKey{first, second}, keys)
+
+	tests := []struct {
+		name    string
+		content []byte
+	}{
+		{"empty", nil},
+		{"not-pem", []byte("ssh-ed25519 AAAA")},
+		{"invalid-der", pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: []byte("invalid")})},
+	}
+	for _, test := range tests {
+		_, err := ReadPublicKeys(write(t, test.name+".pem", test.content))
+		assert.Equalf(t, errcode.ErrComposeReadKey.Code(), errcode.Code(err), "%s: %v", test.name, err)
+	}
+	_, err = ReadPublicKeys(filepath.Join(dir, "missing.pem"))
+	assert.Equal(t, errcode.ErrComposeReadKey.Code(), errcode.Code(err))
+}
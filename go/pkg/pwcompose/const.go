@@ -1,5 +1,7 @@
 package pwcompose
 
+import "time"
+
 const (
 	labelPrefix           = "land.pathwar.compose."
 	serviceNameLabel      = labelPrefix + "service-name"
@@ -15,5 +17,18 @@ const (
 )
 
 const (
-	defaultDockerPrefix = "pathwar/"
+	defaultDockerPrefix  = "pathwar/"
+	defaultSolverImage   = "curlimages/curl:7.72.0"
+	defaultSolverTimeout = time.Minute
+	testVersion          = "test"
+	testInstanceKey      = "test"
+	testPrefixHash       = "testhash"
 )
+
+const (
+	pwinitOnInitPath     = "/pwinit/on-init"
+	pwinitAttachmentsDir = "/pwinit/attachments"
+)
+
+// pwinitHooks are the scripts run by pwinit when copied to /pwinit/ by the Dockerfile of a service
+var pwinitHooks = []string{"on-init", "on-start", "on-reset", "on-validate"}
@@ -0,0 +1,132 @@
+package pwcompose
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/client"
+	"github.com/docker/docker/pkg/stdcopy"
+	"go.uber.org/zap"
+	"pathwar.land/pathwar/v2/go/pkg/errcode"
+)
+
+type ExecOpts struct {
+	// ID is an instance key, a challenge name or ID, a container ID or a container name
+	ID      string
+	Service string
+	Command []string
+	// User runs the command as another user than the one of the container, i.e., "0:0"
+	User   string
+	TTY    bool
+	Stdin  io.Reader
+	Stdout io.Writer
+	Stderr io.Writer
+	Logger *zap.Logger
+}
+
+func NewExecOpts() ExecOpts {
+	return ExecOpts{}
+}
+
+func (opts *ExecOpts) applyDefaults() {
+	if opts.Logger == nil {
+		opts.Logger = zap.NewNop()
+	}
+	if opts.Stdin == nil {
+		opts.Stdin = os.Stdin
+	}
+	if opts.Stdout == nil {
+		opts.Stdout = os.Stdout
+	}
+	if opts.Stderr == nil {
+		opts.Stderr = os.Stderr
+	}
+}
+
+// Exec runs a command in the container of an instance, the instance key and service should match exactly one container
+func Exec(ctx context.Context, cli *client.Client, opts ExecOpts) error {
+	opts.applyDefaults()
+	opts.Logger.Debug("exec", zap.String("id", opts.ID), zap.String("service", opts.Service), zap.Strings("command", opts.Command), zap.Bool("tty", opts.TTY))
+
+	if len(opts.Command) == 0 {
+		return errcode.ErrMissingInput.Wrap(fmt.Errorf("missing command"))
+	}
+
+	containersInfo, err := GetContainersInfo(ctx, cli)
+	if err != nil {
+		return errcode.ErrComposeGetContainersInfo.Wrap(err)
+	}
+	containers := findContainers(containersInfo, opts.ID, opts.Service)
+	switch len(containers) {
+	case 0:
+		return errcode.ErrComposeNoSuchContainer.Wrap(fmt.Errorf("no container matching %q", opts.ID))
+	case 1:
+	default:
+		names := make([]string, len(containers))
+		for idx, match := range containers {
+			names[idx] = match.name()
+		}
+		return errcode.ErrComposeAmbiguousContainer.Wrap(fmt.Errorf("%d containers matching %q: %s", len(containers), opts.ID, strings.Join(names, ", ")))
+	}
+
+	execConfig := types.ExecConfig{
+		AttachStdin:  true,
+		AttachStdout: true,
+		AttachStderr: true,
+		Tty:          opts.TTY,
+		Cmd:          opts.Command,
+		User:         opts.User,
+	}
+	execRes, err := cli.ContainerExecCreate(ctx, containers[0].ID, execConfig)
+	if err != nil {
+		return errcode.ErrDockerAPIContainerExecCreate.Wrap(err)
+	}
+
+	res, err := cli.ContainerExecAttach(ctx, execRes.ID, execConfig)
+	if err != nil {
+		return errcode.ErrDockerAPIContainerExecAttach.Wrap(err)
+	}
+	defer res.Close()
+
+	go func() {
+		_, _ = io.Copy(res.Conn, opts.Stdin)
+		_ = res.CloseWrite()
+	}()
+
+	// buffered, so the copy does not block forever when the context is done first
+	outputDone := make(chan error, 1)
+	go func() {
+		var err error
+		// the output of an exec with a TTY is not multiplexed
+		if opts.TTY {
+			_, err = io.Copy(opts.Stdout, res.Reader)
+		} else {
+			_, err = stdcopy.StdCopy(opts.Stdout, opts.Stderr, res.Reader)
+		}
+		outputDone <- err
+	}()
+	select {
+	case err := <-outputDone:
+		if err != nil {
+			return errcode.ErrDockerAPIContainerExecAttach.Wrap(err)
+		}
+	case <-ctx.Done():
+		// closing the hijacked connection ends the copy
+		res.Close()
+		return errcode.ErrDockerAPIContainerExecAttach.Wrap(ctx.Err())
+	}
+
+	inspect, err := cli.ContainerExecInspect(ctx, execRes.ID)
+	if err != nil {
+		return errcode.ErrDockerAPIContainerExecInspect.Wrap(err)
+	}
+	opts.Logger.Debug("exec finished", zap.Int("exit-code", inspect.ExitCode))
+	if inspect.ExitCode != 0 {
+		return errcode.ErrDockerAPIExitCode.Wrap(fmt.Errorf("exit code %d", inspect.ExitCode))
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package pwcompose
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/docker/docker/client"
+	"github.com/docker/docker/pkg/stdcopy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pathwar.land/pathwar/v2/go/pkg/errcode"
+)
+
+// testingExecDaemon is a fake docker daemon running a command that never ends in the container of an instance
+type testingExecDaemon struct {
+	// closed is closed when the client closes the attached connection
+	closed chan struct{}
+}
+
+func (d *testingExecDaemon) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	path := r.URL.Path[strings.Index(r.URL.Path[1:], "/")+1:] // strip the API version
+	switch {
+	case r.Method == http.MethodGet && path == "/containers/json":
+		fmt.Fprintf(w, `[{"Id": "front", "Names": ["/testing_front"], "State": "running", "Labels": {%q: "testing", %q: "front"}}]`, challengeNameLabel, serviceNameLabel)
+	case r.Method == http.MethodGet && path == "/networks":
+		fmt.Fprint(w, `[]`)
+	case r.Method == http.MethodPost && path == "/containers/front/exec":
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"Id": "exec"}`)
+	case r.Method == http.MethodPost && path == "/exec/exec/start":
+		conn, _, err := w.(http.Hijacker).Hijack()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		defer conn.Close()
+		fmt.Fprint(conn, "HTTP/1.1 101 UPGRADED\r\nContent-Type: application/vnd.docker.raw-stream\r\nConnection: Upgrade\r\nUpgrade: tcp\r\n\r\n")
+		// the command prints forever, until the client goes away
+		stdout := stdcopy.NewStdWriter(conn, stdcopy.Stdout)
+		for {
+			if _, err := fmt.Fprint(stdout, "running\n"); err != nil {
+				close(d.closed)
+				return
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func TestExec_Cancel(t *testing.T) {
+	daemon := testingExecDaemon{closed: make(chan struct{})}
+	server := httptest.NewServer(&daemon)
+	defer server.Close()
+	cli, err := client.NewClient("tcp://"+server.Listener.Addr().String(), "1.25", nil, nil)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	opts := NewExecOpts()
+	opts.ID = "testing"
+	opts.Command = []string{"sleep", "infinity"}
+	opts.Stdin = bytes.NewReader(nil)
+	opts.Stdout = ioutil.Discard
+	opts.Stderr = ioutil.Discard
+	err = Exec(ctx, cli, opts)
+	assert.Equal(t, errcode.Code(errcode.ErrDockerAPIContainerExecAttach), errcode.Code(err))
+
+	// the attached connection is closed with the context
+	select {
+	case <-daemon.closed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the attached connection was not closed")
+	}
+}
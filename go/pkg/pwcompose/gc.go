@@ -0,0 +1,122 @@
+package pwcompose
+
+import (
+	"context"
+	"strings"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/client"
+	"go.uber.org/zap"
+	"pathwar.land/pathwar/v2/go/pkg/errcode"
+)
+
+type GCOpts struct {
+	// KeepImages are the images still referenced by a challenge, the other images labelled by pathwar
+	// are considered as old flavor versions and are removed if unused
+	KeepImages []string
+	Logger     *zap.Logger
+}
+
+func (opts *GCOpts) applyDefaults() {
+	if opts.Logger == nil {
+		opts.Logger = zap.NewNop()
+	}
+}
+
+// GC removes the unused images and volumes related to Pathwar challenges
+func GC(ctx context.Context, cli *client.Client, opts GCOpts) error {
+	opts.applyDefaults()
+	opts.Logger.Debug("gc", zap.Any("opts", opts))
+
+	// images and volumes used by a container (running or not) are never removed
+	containers, err := cli.ContainerList(ctx, types.ContainerListOptions{All: true})
+	if err != nil {
+		return errcode.ErrDockerAPIContainerList.Wrap(err)
+	}
+	usedImages := map[string]bool{}
+	usedVolumes := map[string]bool{}
+	for _, container := range containers {
+		usedImages[container.ImageID] = true
+		for _, mount := range container.Mounts {
+			if mount.Name != "" {
+				usedVolumes[mount.Name] = true
+			}
+		}
+	}
+
+	// resolve the images to keep
+	for _, image := range opts.KeepImages {
+		inspect, _, err := cli.ImageInspectWithRaw(ctx, image)
+		switch {
+		case client.IsErrImageNotFound(err):
+			continue
+		case err != nil:
+			return errcode.ErrDockerAPIImageInspect.Wrap(err)
+		}
+		usedImages[inspect.ID] = true
+	}
+
+	// images
+	images, err := cli.ImageList(ctx, types.ImageListOptions{})
+	if err != nil {
+		return errcode.ErrDockerAPIImageList.Wrap(err)
+	}
+	removedImages := 0
+	for _, image := range collectableImages(images, usedImages) {
+		_, err := cli.ImageRemove(ctx, image.ID, types.ImageRemoveOptions{PruneChildren: true})
+		if err != nil {
+			// may be used by another image, will be retried on the next run
+			opts.Logger.Warn("remove image", zap.String("id", image.ID), zap.Error(err))
+			continue
+		}
+		opts.Logger.Debug("image removed", zap.String("ID", image.ID), zap.Strings("tags", image.RepoTags))
+		removedImages++
+	}
+
+	// volumes
+	volumeFilters := filters.NewArgs()
+	volumeFilters.Add("label", challengeNameLabel)
+	volumeFilters.Add("dangling", "true")
+	volumes, err := cli.VolumeList(ctx, volumeFilters)
+	if err != nil {
+		return errcode.ErrDockerAPIVolumeList.Wrap(err)
+	}
+	removedVolumes := 0
+	for _, volume := range volumes.Volumes {
+		if usedVolumes[volume.Name] {
+			continue
+		}
+		if err := cli.VolumeRemove(ctx, volume.Name, false); err != nil {
+			return errcode.ErrDockerAPIVolumeRemove.Wrap(err)
+		}
+		opts.Logger.Debug("volume removed", zap.String("name", volume.Name))
+		removedVolumes++
+	}
+
+	opts.Logger.Info("garbage collected", zap.Int("images", removedImages), zap.Int("volumes", removedVolumes))
+	return nil
+}
+
+// collectableImages returns the images labelled by pathwar that are not used.
+// Only the labels are trusted, an unlabelled image may be shared with anything else running on the host.
+func collectableImages(images []types.ImageSummary, usedImages map[string]bool) []types.ImageSummary {
+	collectable := []types.ImageSummary{}
+	for _, image := range images {
+		if usedImages[image.ID] || !isPathwarImage(image) {
+			continue
+		}
+		collectable = append(collectable, image)
+	}
+	return collectable
+}
+
+// isPathwarImage returns true if the image is labelled by pathwar, i.e., built by pwcompose
+func isPathwarImage(image types.ImageSummary) bool {
+	for label := range image.Labels {
+		if strings.HasPrefix(label, labelPrefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,31 @@
+package pwcompose
+
+import (
+	"testing"
+
+	"github.com/docker/docker/api/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCollectableImages(t *testing.T) {
+	images := []types.ImageSummary{
+		{ID: "sha256:built-used", RepoTags: []string{"pathwar/testing:front"}, Labels: map[string]string{challengeNameLabel: "testing"}},
+		{ID: "sha256:built-old", RepoTags: []string{"pathwar/testing:front-old"}, Labels: map[string]string{challengeNameLabel: "testing"}},
+		{ID: "sha256:built-kept", RepoDigests: []string{"pathwar/testing@sha256:1234"}, Labels: map[string]string{serviceNameLabel: "db"}},
+		{ID: "sha256:alpine-challenge", RepoTags: []string{"alpine:3.12"}},
+		{ID: "sha256:alpine-host", RepoTags: []string{"alpine:latest"}},
+		{ID: "sha256:other-labels", RepoTags: []string{"example/app:latest"}, Labels: map[string]string{"com.example.pathwar": "true"}},
+		{ID: "sha256:dangling"},
+	}
+	used := map[string]bool{
+		"sha256:built-used":       true,
+		"sha256:built-kept":       true,
+		"sha256:alpine-challenge": true,
+	}
+
+	ids := []string{}
+	for _, image := range collectableImages(images, used) {
+		ids = append(ids, image.ID)
+	}
+	assert.Equal(t, []string{"sha256:built-old"}, ids)
+}
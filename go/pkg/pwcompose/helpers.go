@@ -2,6 +2,8 @@ package pwcompose
 
 import (
 	"context"
+	"sort"
+	"strings"
 
 	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/client"
@@ -64,6 +66,69 @@ func GetContainersInfo(ctx context.Context, cli *client.Client) (*ContainersInfo
 	return &containersInfo, nil
 }
 
+// InstanceIsUp returns true if an instance has containers and all of them are running
+func (ci ContainersInfo) InstanceIsUp(instanceKey string) bool {
+	found := false
+	for _, c := range ci.RunningContainers {
+		if c.Labels[InstanceKeyLabel] != instanceKey {
+			continue
+		}
+		if c.State != "running" {
+			return false
+		}
+		found = true
+	}
+	return found
+}
+
+// InstanceHasExited returns true if a container of an instance has stopped, i.e., a service without restart policy
+func (ci ContainersInfo) InstanceHasExited(instanceKey string) bool {
+	for _, c := range ci.RunningContainers {
+		if c.Labels[InstanceKeyLabel] == instanceKey && (c.State == "exited" || c.State == "dead") {
+			return true
+		}
+	}
+	return false
+}
+
 func composeCliCommonArgs(path string) []string {
 	return []string{"-f", path, "--no-ansi", "--log-level=ERROR"}
 }
+
+// findContainers returns the pathwar containers matching an instance key (the instance ID on the agents), a challenge
+// name or ID (name@version), a container ID or a container name, optionally filtered by service name
+func findContainers(containersInfo *ContainersInfo, id string, service string) []container {
+	matches := []container{}
+	for _, candidate := range containersInfo.RunningContainers {
+		if !candidate.matches(id) {
+			continue
+		}
+		if service != "" && candidate.Labels[serviceNameLabel] != service {
+			continue
+		}
+		matches = append(matches, candidate)
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		return matches[i].name() < matches[j].name()
+	})
+	return matches
+}
+
+func (c container) matches(id string) bool {
+	switch {
+	case id == "":
+		return false
+	case id == c.Labels[InstanceKeyLabel], id == c.Labels[challengeNameLabel], id == c.ChallengeID():
+		return true
+	case id == c.ID, len(id) >= 7 && strings.HasPrefix(c.ID, id):
+		return true
+	}
+	return id == c.name()
+}
+
+func (c container) name() string {
+	if len(c.Names) == 0 {
+		return c.ID
+	}
+	return strings.TrimPrefix(c.Names[0], "/")
+}
@@ -0,0 +1,96 @@
+package pwcompose
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindContainers(t *testing.T) {
+	newContainer := func(id string, name string, service string, instanceKey string) container {
+		return container{
+			ID:    id,
+			Names: []string{"/" + name},
+			Labels: map[string]string{
+				challengeNameLabel:    "testing",
+				challengeVersionLabel: "1.0.0",
+				serviceNameLabel:      service,
+				InstanceKeyLabel:      instanceKey,
+			},
+		}
+	}
+	containersInfo := &ContainersInfo{RunningContainers: map[string]container{}}
+	for _, c := range []container{
+		newContainer("0123456789abcdef", "front.42", "front", "42"),
+		newContainer("fedcba9876543210", "db.42", "db", "42"),
+		newContainer("aaaaaaaaaaaaaaaa", "front.43", "front", "43"),
+	} {
+		containersInfo.RunningContainers[c.ID] = c
+	}
+
+	names := func(containers []container) []string {
+		ret := []string{}
+		for _, c := range containers {
+			ret = append(ret, c.name())
+		}
+		return ret
+	}
+	tests := []struct {
+		id       string
+		service  string
+		expected []string
+	}{
+		{"42", "", []string{"db.42", "front.42"}},
+		{"42", "front", []string{"front.42"}},
+		{"testing", "front", []string{"front.42", "front.43"}},
+		{"testing@1.0.0", "", []string{"db.42", "front.42", "front.43"}},
+		{"0123456", "", []string{"front.42"}},
+		{"012345", "", []string{}},
+		{"front.43", "", []string{"front.43"}},
+		{"44", "", []string{}},
+		{"", "", []string{}},
+	}
+	for _, test := range tests {
+		assert.Equalf(t, test.expected, names(findContainers(containersInfo, test.id, test.service)), "%q %q", test.id, test.service)
+	}
+}
+
+func TestContainersInfo_InstanceIsUp(t *testing.T) {
+	newContainer := func(id string, instanceKey string, state string) container {
+		return container{
+			ID:     id,
+			State:  state,
+			Labels: map[string]string{challengeNameLabel: "testing", InstanceKeyLabel: instanceKey},
+		}
+	}
+	containersInfo := ContainersInfo{RunningContainers: map[string]container{}}
+	for _, c := range []container{
+		newContainer("a", "42", "running"),
+		newContainer("b", "42", "running"),
+		newContainer("c", "43", "running"),
+		newContainer("d", "43", "exited"),
+	} {
+		containersInfo.RunningContainers[c.ID] = c
+	}
+
+	assert.True(t, containersInfo.InstanceIsUp("42"))
+	assert.False(t, containersInfo.InstanceIsUp("43"))
+	assert.False(t, containersInfo.InstanceIsUp("44"))
+}
+
+func TestContainersInfo_InstanceHasExited(t *testing.T) {
+	containersInfo := ContainersInfo{RunningContainers: map[string]container{}}
+	for _, c := range []container{
+		{ID: "a", State: "running", Labels: map[string]string{InstanceKeyLabel: "42"}},
+		{ID: "b", State: "running", Labels: map[string]string{InstanceKeyLabel: "43"}},
+		{ID: "c", State: "exited", Labels: map[string]string{InstanceKeyLabel: "43"}},
+		{ID: "d", State: "dead", Labels: map[string]string{InstanceKeyLabel: "44"}},
+	} {
+		containersInfo.RunningContainers[c.ID] = c
+	}
+
+	assert.False(t, containersInfo.InstanceHasExited("42"))
+	assert.True(t, containersInfo.InstanceHasExited("43"))
+	assert.True(t, containersInfo.InstanceHasExited("44"))
+	assert.False(t, containersInfo.InstanceHasExited("45"))
+}
@@ -0,0 +1,105 @@
+package pwcompose
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/docker/docker/client"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+	"pathwar.land/pathwar/v2/go/pkg/errcode"
+)
+
+const (
+	// ResetHook runs the on-reset hook of pwinit, i.e., to restore the state of an instance without recreating it
+	ResetHook = "reset"
+	// ValidateHook runs the on-validate hook of pwinit, i.e., after a passphrase of the instance was validated
+	ValidateHook = "validate"
+)
+
+type HookOpts struct {
+	// ID is an instance key, a challenge name or ID, a container ID or a container name
+	ID      string
+	Service string
+	// Hook is the pwinit command to run, ResetHook or ValidateHook
+	Hook   string
+	Args   []string
+	Stdout io.Writer
+	Stderr io.Writer
+	Logger *zap.Logger
+}
+
+func NewHookOpts() HookOpts {
+	return HookOpts{
+		Hook: ResetHook,
+	}
+}
+
+func (opts *HookOpts) applyDefaults() {
+	if opts.Logger == nil {
+		opts.Logger = zap.NewNop()
+	}
+	if opts.Stdout == nil {
+		opts.Stdout = os.Stdout
+	}
+	if opts.Stderr == nil {
+		opts.Stderr = os.Stderr
+	}
+}
+
+// RunHook runs a pwinit hook as root in the running containers of an instance, the containers without the hook
+// script are skipped by pwinit
+func RunHook(ctx context.Context, cli *client.Client, opts HookOpts) error {
+	opts.applyDefaults()
+	opts.Logger.Debug("hook", zap.String("id", opts.ID), zap.String("service", opts.Service), zap.String("hook", opts.Hook))
+
+	command, err := hookCommand(opts.Hook, opts.Args)
+	if err != nil {
+		return err
+	}
+
+	containersInfo, err := GetContainersInfo(ctx, cli)
+	if err != nil {
+		return errcode.ErrComposeGetContainersInfo.Wrap(err)
+	}
+	containers := findContainers(containersInfo, opts.ID, opts.Service)
+	if len(containers) == 0 {
+		return errcode.ErrComposeNoSuchContainer.Wrap(fmt.Errorf("no container matching %q", opts.ID))
+	}
+
+	var errs error
+	for _, match := range containers {
+		if match.State != "running" {
+			opts.Logger.Warn("container not running, skipping hook", zap.String("container", match.name()), zap.String("state", match.State))
+			continue
+		}
+		execOpts := NewExecOpts()
+		execOpts.ID = match.ID
+		execOpts.Command = command
+		execOpts.User = "0:0"
+		execOpts.Stdin = bytes.NewReader(nil)
+		execOpts.Stdout = opts.Stdout
+		execOpts.Stderr = opts.Stderr
+		execOpts.Logger = opts.Logger
+		if err := Exec(ctx, cli, execOpts); err != nil {
+			errs = multierr.Append(errs, fmt.Errorf("%s: %w", match.name(), err))
+		}
+	}
+	if errs != nil {
+		return errcode.ErrComposeHook.Wrap(errs)
+	}
+	return nil
+}
+
+// hookCommand returns the pwinit command running a hook
+func hookCommand(hook string, args []string) ([]string, error) {
+	switch hook {
+	case ResetHook, ValidateHook:
+	default:
+		return nil, errcode.ErrInvalidInput.Wrap(fmt.Errorf("unsupported hook %q", hook))
+	}
+	return append([]string{"/bin/pwinit", hook}, args...), nil
+}
@@ -0,0 +1,27 @@
+package pwcompose
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pathwar.land/pathwar/v2/go/pkg/errcode"
+)
+
+func TestHookCommand(t *testing.T) {
+	tests := []struct {
+		hook        string
+		args        []string
+		expected    []string
+		expectedErr error
+	}{
+		{ResetHook, nil, []string{"/bin/pwinit", "reset"}, nil},
+		{ValidateHook, []string{"1", "2"}, []string{"/bin/pwinit", "validate", "1", "2"}, nil},
+		{"init", nil, nil, errcode.ErrInvalidInput},
+		{"", nil, nil, errcode.ErrInvalidInput},
+	}
+	for _, test := range tests {
+		command, err := hookCommand(test.hook, test.args)
+		assert.Equalf(t, errcode.Code(test.expectedErr), errcode.Code(err), "%s: %v", test.hook, err)
+		assert.Equal(t, test.expected, command, test.hook)
+	}
+}
@@ -0,0 +1,642 @@
+package pwcompose
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"gopkg.in/yaml.v3"
+	"pathwar.land/pathwar/v2/go/pkg/errcode"
+	"pathwar.land/pathwar/v2/go/pkg/pwdb"
+)
+
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+)
+
+// Diagnostic is a problem found by Lint, positions are 1-based
+type Diagnostic struct {
+	File     string   `json:"file"`
+	Line     int      `json:"line"`
+	Column   int      `json:"column"`
+	Severity Severity `json:"severity"`
+	Code     string   `json:"code"`
+	Message  string   `json:"message"`
+}
+
+func (d Diagnostic) String() string {
+	return fmt.Sprintf("%s:%d:%d: %s: %s [%s]", d.File, d.Line, d.Column, d.Severity, d.Message, d.Code)
+}
+
+type LintOpts struct {
+	ChallengeDir string
+	Logger       *zap.Logger
+}
+
+func NewLintOpts() LintOpts {
+	return LintOpts{
+		ChallengeDir: ".",
+	}
+}
+
+func (opts *LintOpts) applyDefaults() {
+	if opts.Logger == nil {
+		opts.Logger = zap.NewNop()
+	}
+	if opts.ChallengeDir == "" {
+		opts.ChallengeDir = "."
+	}
+}
+
+var (
+	// keys handled by pwcompose, the other ones are dropped by Prepare
+	supportedComposeKeys = map[string]bool{"version": true, "networks": true, "volumes": true, "services": true, "x-pathwar": true}
+	// service options giving too much power to a challenge over the agent
+	dangerousServiceKeys = map[string]string{
+		"privileged":   "gives full access to the host",
+		"network_mode": "can attach the service to the host network",
+		"pid":          "can share the host PID namespace",
+		"ipc":          "can share the host IPC namespace",
+		"userns_mode":  "can disable user namespaces",
+		"security_opt": "can disable seccomp or apparmor",
+		"devices":      "exposes host devices",
+	}
+	dangerousCapabilities = map[string]bool{"ALL": true, "SYS_ADMIN": true, "NET_ADMIN": true, "SYS_PTRACE": true, "SYS_MODULE": true, "DAC_READ_SEARCH": true}
+	challengeSlugRegex    = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*$`)
+	passphraseRefRegex    = regexp.MustCompile(`pwinit\s+passphrase\s+([0-9]+\b|\S+)`)
+	yamlErrorLineRegex    = regexp.MustCompile(`line (\d+): (.*)`)
+)
+
+// Lint checks a challenge directory and returns the diagnostics sorted by position,
+// an error is only returned if the challenge cannot be read
+func Lint(opts LintOpts) ([]Diagnostic, error) {
+	opts.applyDefaults()
+	opts.Logger.Debug("lint", zap.Any("opts", opts))
+
+	if _, err := os.Stat(opts.ChallengeDir); os.IsNotExist(err) {
+		return nil, errcode.ErrComposeDirectoryNotFound.Wrap(err)
+	}
+	l := linter{
+		dir:         opts.ChallengeDir,
+		composePath: filepath.Join(opts.ChallengeDir, "docker-compose.yml"),
+	}
+	composeData, err := ioutil.ReadFile(l.composePath)
+	if err != nil {
+		return nil, errcode.ErrComposeReadConfig.Wrap(err)
+	}
+
+	l.lintCompose(composeData)
+
+	sort.SliceStable(l.diagnostics, func(i, j int) bool {
+		a, b := l.diagnostics[i], l.diagnostics[j]
+		if a.File != b.File {
+			return a.File < b.File
+		}
+		if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+		return a.Column < b.Column
+	})
+	return l.diagnostics, nil
+}
+
+// HasErrors returns true if at least one diagnostic is an error
+func HasErrors(diagnostics []Diagnostic) bool {
+	for _, diagnostic := range diagnostics {
+		if diagnostic.Severity == SeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+type linter struct {
+	dir         string
+	composePath string
+	diagnostics []Diagnostic
+
+	passphrases     int
+	passphrasesNode *yaml.Node
+	passphraseRefs  map[int]bool
+	dynamicRefs     bool
+	onInitScripts   int
+	pulledServices  int
+	templatesNode   *yaml.Node
+}
+
+func (l *linter) report(file string, line, column int, severity Severity, code string, format string, args ...interface{}) {
+	l.diagnostics = append(l.diagnostics, Diagnostic{
+		File:     file,
+		Line:     line,
+		Column:   column,
+		Severity: severity,
+		Code:     code,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+func (l *linter) reportNode(node *yaml.Node, severity Severity, code string, format string, args ...interface{}) {
+	l.report(l.composePath, node.Line, node.Column, severity, code, format, args...)
+}
+
+// reportDecodeError reports the errors returned when decoding a yaml node into a go struct
+func (l *linter) reportDecodeError(node *yaml.Node, err error) {
+	typeErr, ok := err.(*yaml.TypeError)
+	if !ok {
+		l.reportNode(node, SeverityError, "invalid-value", "%v", err)
+		return
+	}
+	for _, msg := range typeErr.Errors {
+		match := yamlErrorLineRegex.FindStringSubmatch(msg)
+		if match == nil {
+			l.reportNode(node, SeverityError, "invalid-value", "%s", msg)
+			continue
+		}
+		line, _ := strconv.Atoi(match[1])
+		l.report(l.composePath, line, 1, SeverityError, "invalid-value", "%s", match[2])
+	}
+}
+
+func (l *linter) lintCompose(composeData []byte) {
+	l.passphrases = 1 // default value of the API
+	var root yaml.Node
+	if err := yaml.Unmarshal(composeData, &root); err != nil {
+		line := 1
+		if match := yamlErrorLineRegex.FindStringSubmatch(err.Error()); match != nil {
+			line, _ = strconv.Atoi(match[1])
+		}
+		l.report(l.composePath, line, 1, SeverityError, "invalid-yaml", "%v", err)
+		return
+	}
+	if len(root.Content) == 0 || root.Content[0].Kind != yaml.MappingNode {
+		l.report(l.composePath, 1, 1, SeverityError, "invalid-yaml", "docker-compose.yml should be a mapping")
+		return
+	}
+	doc := root.Content[0]
+
+	var pathwarNode, servicesNode *yaml.Node
+	for i := 0; i+1 < len(doc.Content); i += 2 {
+		key, value := doc.Content[i], doc.Content[i+1]
+		switch {
+		case key.Value == "x-pathwar":
+			pathwarNode = value
+		case key.Value == "services":
+			servicesNode = value
+		case key.Value == "networks":
+			l.lintMapValues(value, reflect.TypeOf(network{}))
+		case key.Value == "volumes":
+			l.lintMapValues(value, reflect.TypeOf(volume{}))
+		case supportedComposeKeys[key.Value], strings.HasPrefix(key.Value, "x-"):
+		default:
+			l.reportNode(key, SeverityError, "unsupported-key", "unsupported key %q", key.Value)
+		}
+	}
+
+	if pathwarNode == nil {
+		l.reportNode(doc, SeverityError, "missing-x-pathwar", "missing x-pathwar metadata, required to register the challenge")
+	} else {
+		l.lintPathwar(pathwarNode)
+	}
+
+	if servicesNode == nil || servicesNode.Kind != yaml.MappingNode || len(servicesNode.Content) == 0 {
+		l.reportNode(doc, SeverityError, "missing-services", "no services defined")
+	} else {
+		l.lintServices(servicesNode)
+		l.lintTemplates(servicesNode)
+	}
+
+	l.lintPassphrases()
+}
+
+// lintTemplates checks that the templates of x-pathwar.pwinit are declared for existing services with absolute paths
+func (l *linter) lintTemplates(servicesNode *yaml.Node) {
+	node := l.templatesNode
+	if node == nil {
+		return
+	}
+	if node.Kind != yaml.MappingNode {
+		l.reportNode(node, SeverityError, "invalid-value", "x-pathwar.pwinit.templates should be a mapping of service names to file paths")
+		return
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, value := node.Content[i], node.Content[i+1]
+		if lookupNode(servicesNode, key.Value) == nil {
+			l.reportNode(key, SeverityError, "unknown-service", "x-pathwar.pwinit.templates: no such service %q", key.Value)
+		}
+		if value.Kind != yaml.SequenceNode {
+			l.reportNode(value, SeverityError, "invalid-value", "x-pathwar.pwinit.templates.%s should be a list of file paths", key.Value)
+			continue
+		}
+		for _, pathNode := range value.Content {
+			if !path.IsAbs(pathNode.Value) {
+				l.reportNode(pathNode, SeverityError, "invalid-value", "template path %q should be absolute", pathNode.Value)
+			}
+		}
+	}
+}
+
+func (l *linter) lintPathwar(node *yaml.Node) {
+	if node.Kind != yaml.MappingNode {
+		l.reportNode(node, SeverityError, "invalid-value", "x-pathwar should be a mapping")
+		return
+	}
+
+	var challengeNode, flavorNode *yaml.Node
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, value := node.Content[i], node.Content[i+1]
+		switch key.Value {
+		case "challenge":
+			challengeNode = value
+		case "flavor":
+			flavorNode = value
+		case "seasons":
+			if value.Kind != yaml.SequenceNode {
+				l.reportNode(value, SeverityError, "invalid-value", "x-pathwar.seasons should be a list of season slugs")
+			}
+		case "solver":
+			l.lintSolver(value)
+		case "pwinit":
+			if value.Kind != yaml.MappingNode {
+				l.reportNode(value, SeverityError, "invalid-value", "x-pathwar.pwinit should be a mapping")
+				break
+			}
+			l.lintKeys(value, reflect.TypeOf(PwinitOptions{}), "x-pathwar.pwinit")
+			var options PwinitOptions
+			if err := value.Decode(&options); err != nil {
+				l.reportDecodeError(value, err)
+			}
+			l.templatesNode = lookupNode(value, "templates")
+		default:
+			l.reportNode(key, SeverityError, "unsupported-key", "unsupported key %q in x-pathwar", key.Value)
+		}
+	}
+
+	// challenge
+	if challengeNode == nil {
+		l.reportNode(node, SeverityError, "missing-challenge", "missing x-pathwar.challenge")
+	} else {
+		l.lintKeys(challengeNode, reflect.TypeOf(pwdb.Challenge{}), "x-pathwar.challenge")
+		var challenge pwdb.Challenge
+		if err := challengeNode.Decode(&challenge); err != nil {
+			l.reportDecodeError(challengeNode, err)
+		}
+		switch slugNode := lookupNode(challengeNode, "slug"); {
+		case slugNode == nil || challenge.Slug == "":
+			l.reportNode(challengeNode, SeverityError, "missing-slug", "missing x-pathwar.challenge.slug")
+		case !challengeSlugRegex.MatchString(challenge.Slug):
+			l.reportNode(slugNode, SeverityError, "invalid-slug", "invalid slug %q, only lowercase letters, digits and dashes are allowed", challenge.Slug)
+		}
+		if challenge.Name == "" {
+			l.reportNode(challengeNode, SeverityWarning, "missing-name", "missing x-pathwar.challenge.name")
+		}
+	}
+
+	// flavor
+	if flavorNode == nil {
+		return
+	}
+	l.lintKeys(flavorNode, reflect.TypeOf(pwdb.ChallengeFlavor{}), "x-pathwar.flavor")
+	var flavor pwdb.ChallengeFlavor
+	if err := flavorNode.Decode(&flavor); err != nil {
+		l.reportDecodeError(flavorNode, err) // the valid fields are still decoded
+	}
+	if passphrasesNode := lookupNode(flavorNode, "passphrases"); passphrasesNode != nil {
+		l.passphrasesNode = passphrasesNode
+		if flavor.Passphrases < 0 {
+			l.reportNode(passphrasesNode, SeverityError, "invalid-value", "passphrases should be positive")
+		} else if flavor.Passphrases > 0 {
+			l.passphrases = int(flavor.Passphrases)
+		}
+	}
+	if flavor.ProxyPolicy != nil {
+		if err := flavor.ProxyPolicy.Validate(); err != nil {
+			l.reportNode(lookupNode(flavorNode, "proxy-policy"), SeverityError, "invalid-proxy-policy", "%v", err)
+		}
+	}
+}
+
+func (l *linter) lintSolver(node *yaml.Node) {
+	if node.Kind != yaml.MappingNode {
+		l.reportNode(node, SeverityError, "invalid-value", "x-pathwar.solver should be a mapping")
+		return
+	}
+	l.lintKeys(node, reflect.TypeOf(SolverConfig{}), "x-pathwar.solver")
+	var solver SolverConfig
+	if err := node.Decode(&solver); err != nil {
+		l.reportDecodeError(node, err)
+	}
+	switch scriptNode := lookupNode(node, "script"); {
+	case scriptNode == nil || solver.Script == "":
+		l.reportNode(node, SeverityError, "missing-solver-script", "missing x-pathwar.solver.script")
+	default:
+		if _, err := os.Stat(filepath.Join(l.dir, solver.Script)); err != nil {
+			l.reportNode(scriptNode, SeverityError, "missing-solver-script", "solver script %q not found", solver.Script)
+		}
+	}
+	if timeoutNode := lookupNode(node, "timeout"); timeoutNode != nil {
+		if _, err := time.ParseDuration(solver.Timeout); err != nil {
+			l.reportNode(timeoutNode, SeverityError, "invalid-value", "invalid solver timeout %q", solver.Timeout)
+		}
+	}
+}
+
+func (l *linter) lintServices(node *yaml.Node) {
+	publishedPorts := false
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		nameNode, serviceNode := node.Content[i], node.Content[i+1]
+		name := nameNode.Value
+		if serviceNode.Kind != yaml.MappingNode {
+			l.reportNode(serviceNode, SeverityError, "invalid-value", "service %q should be a mapping", name)
+			continue
+		}
+
+		// dangerous and unsupported keys
+		knownKeys := yamlKeys(reflect.TypeOf(Service{}))
+		for j := 0; j+1 < len(serviceNode.Content); j += 2 {
+			key := serviceNode.Content[j]
+			if reason, found := dangerousServiceKeys[key.Value]; found {
+				l.reportNode(key, SeverityError, "dangerous-option", "service %q: %q %s", name, key.Value, reason)
+				continue
+			}
+			if !knownKeys[key.Value] && !strings.HasPrefix(key.Value, "x-") {
+				l.reportNode(key, SeverityError, "unsupported-key", "service %q: unsupported key %q, it is rejected by prepare", name, key.Value)
+			}
+		}
+
+		var service Service
+		if err := serviceNode.Decode(&service); err != nil {
+			l.reportDecodeError(serviceNode, err)
+			continue
+		}
+
+		if capNode := lookupNode(serviceNode, "cap_add"); capNode != nil {
+			for _, capability := range capNode.Content {
+				if dangerousCapabilities[strings.TrimPrefix(capability.Value, "CAP_")] {
+					l.reportNode(capability, SeverityWarning, "dangerous-option", "service %q: capability %q gives a lot of power to the players", name, capability.Value)
+				}
+			}
+		}
+
+		for i, volume := range service.Volumes {
+			volumeNode := lookupNode(serviceNode, "volumes").Content[i]
+			source := volume.SourcePath()
+			switch {
+			case strings.Contains(source, "docker.sock"):
+				l.reportNode(volumeNode, SeverityError, "dangerous-option", "service %q: mounting the docker socket gives full access to the agent", name)
+			case volume.Type == "bind", strings.HasPrefix(source, "/"), strings.HasPrefix(source, "."), strings.HasPrefix(source, "~"):
+				l.reportNode(volumeNode, SeverityWarning, "bind-mount", "service %q: bind mount %q is not available on the agents, use a named volume or the image", name, source)
+			}
+		}
+
+		for i, port := range service.Ports {
+			publishedPorts = true
+			portNode := lookupNode(serviceNode, "ports").Content[i]
+			if port.IsPublished() {
+				l.reportNode(portNode, SeverityWarning, "host-port", "service %q: port %q is bound on the host, it conflicts between instances", name, port.String())
+			}
+		}
+
+		switch {
+		case service.Image == "" && service.Build == nil:
+			l.reportNode(nameNode, SeverityError, "missing-image", "service %q has neither an image nor a build", name)
+		case service.Build != nil:
+			l.lintBuild(name, lookupNode(serviceNode, "build"), *service.Build)
+		default:
+			l.pulledServices++
+		}
+	}
+
+	if !publishedPorts {
+		l.reportNode(node, SeverityWarning, "no-published-port", "no service publishes a port, the challenge will not be reachable through the agent")
+	}
+}
+
+// lintBuild checks the build context of a service and its on-init script
+func (l *linter) lintBuild(name string, buildNode *yaml.Node, build ServiceBuild) {
+	contextDir := filepath.Join(l.dir, build.Context)
+	if info, err := os.Stat(contextDir); err != nil || !info.IsDir() {
+		l.reportNode(buildNode, SeverityError, "build-context-not-found", "service %q: build context %q not found", name, build.Context)
+		return
+	}
+	dockerfileName := build.Dockerfile
+	if dockerfileName == "" {
+		dockerfileName = "Dockerfile"
+	}
+	dockerfilePath := filepath.Join(contextDir, dockerfileName)
+	dockerfile, err := ioutil.ReadFile(dockerfilePath)
+	if err != nil {
+		l.reportNode(buildNode, SeverityError, "dockerfile-not-found", "service %q: no %s in %q", name, dockerfileName, build.Context)
+		return
+	}
+
+	// find the instructions copying the hooks
+	copyLines := map[string]int{}
+	scanner := bufio.NewScanner(bytes.NewReader(dockerfile))
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 3 {
+			continue
+		}
+		instruction := strings.ToUpper(fields[0])
+		if instruction != "COPY" && instruction != "ADD" {
+			continue
+		}
+		args := []string{}
+		for _, field := range fields[1:] {
+			if !strings.HasPrefix(field, "--") {
+				args = append(args, field)
+			}
+		}
+		if len(args) < 2 {
+			continue
+		}
+		sources, destination := args[:len(args)-1], args[len(args)-1]
+		for _, source := range sources {
+			hook := filepath.Base(source)
+			if !isPwinitHook(hook) {
+				continue
+			}
+			copyLines[hook] = lineNumber
+			if destination != "/pwinit/" && destination != "/pwinit/"+hook {
+				l.report(dockerfilePath, lineNumber, 1, SeverityError, hook+"-destination", "%s is copied to %q, pwinit only runs /pwinit/%s", hook, destination, hook)
+			}
+		}
+	}
+
+	for _, hook := range pwinitHooks {
+		l.lintHook(name, contextDir, build.Context, dockerfilePath, hook, copyLines[hook])
+	}
+}
+
+// lintHook checks a pwinit hook script of a build context, and collects the passphrases it reads
+func (l *linter) lintHook(name, contextDir, buildContext, dockerfilePath, hook string, copyLine int) {
+	hookPath := filepath.Join(contextDir, hook)
+	script, err := ioutil.ReadFile(hookPath)
+	switch {
+	case err != nil && copyLine > 0:
+		l.report(dockerfilePath, copyLine, 1, SeverityError, hook+"-not-found", "service %q: %s script not found in %q", name, hook, buildContext)
+		return
+	case err != nil:
+		return // no such hook for this service
+	case copyLine == 0:
+		l.report(hookPath, 1, 1, SeverityWarning, hook+"-not-copied", "service %q: %s is never copied to /pwinit/ by the Dockerfile", name, hook)
+		return
+	}
+
+	if hook == "on-init" {
+		l.onInitScripts++
+	}
+	if !bytes.HasPrefix(script, []byte("#!")) && !bytes.HasPrefix(script, []byte("\x7fELF")) {
+		l.report(hookPath, 1, 1, SeverityError, hook+"-not-executable", "%s should start with a shebang, i.e., #!/bin/sh", hook)
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(script))
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		for _, match := range passphraseRefRegex.FindAllStringSubmatchIndex(scanner.Text(), -1) {
+			arg := scanner.Text()[match[2]:match[3]]
+			index, err := strconv.Atoi(arg)
+			if err != nil {
+				l.dynamicRefs = true
+				continue
+			}
+			if index >= l.passphrases {
+				l.report(hookPath, lineNumber, match[0]+1, SeverityError, "passphrase-out-of-range", "%s reads passphrase %d but the flavor only has %d passphrase(s)", hook, index, l.passphrases)
+			}
+			if l.passphraseRefs == nil {
+				l.passphraseRefs = map[int]bool{}
+			}
+			l.passphraseRefs[index] = true
+		}
+	}
+}
+
+func isPwinitHook(name string) bool {
+	for _, hook := range pwinitHooks {
+		if name == hook {
+			return true
+		}
+	}
+	return false
+}
+
+// lintPassphrases checks that every passphrase of the flavor is used by a hook script or a template
+func (l *linter) lintPassphrases() {
+	node := l.passphrasesNode
+	if node == nil {
+		node = &yaml.Node{Line: 1, Column: 1}
+	}
+	if l.templatesNode != nil {
+		// the templates are in the images, the passphrases they use cannot be checked
+		return
+	}
+	if l.onInitScripts == 0 {
+		if l.pulledServices == 0 {
+			l.reportNode(node, SeverityWarning, "missing-on-init", "no on-init script, the passphrases are never injected in the challenge")
+		}
+		return
+	}
+	if l.dynamicRefs {
+		return
+	}
+	for i := 0; i < l.passphrases; i++ {
+		if !l.passphraseRefs[i] {
+			l.reportNode(node, SeverityWarning, "unused-passphrase", "passphrase %d is never read by a hook script (pwinit passphrase %d)", i, i)
+		}
+	}
+}
+
+// lintMapValues checks the keys of each entry of a networks or volumes section
+func (l *linter) lintMapValues(node *yaml.Node, t reflect.Type) {
+	if node.Kind != yaml.MappingNode {
+		return
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		l.lintKeys(node.Content[i+1], t, node.Content[i].Value)
+	}
+}
+
+// lintKeys reports the keys of a mapping that are not fields of the given struct, recursively
+func (l *linter) lintKeys(node *yaml.Node, t reflect.Type, path string) {
+	for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return
+	}
+	switch node.Kind {
+	case yaml.SequenceNode:
+		for _, item := range node.Content {
+			l.lintKeys(item, t, path)
+		}
+		return
+	case yaml.MappingNode:
+	default:
+		return
+	}
+
+	fields := yamlFields(t)
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, value := node.Content[i], node.Content[i+1]
+		field, found := fields[key.Value]
+		if !found {
+			l.reportNode(key, SeverityError, "unsupported-key", "unsupported key %q in %s", key.Value, path)
+			continue
+		}
+		l.lintKeys(value, field.Type, path+"."+key.Value)
+	}
+}
+
+// yamlFields returns the fields of a struct indexed by their yaml name, following the yaml.v3 naming rules
+func yamlFields(t reflect.Type) map[string]reflect.StructField {
+	fields := map[string]reflect.StructField{}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" { // unexported
+			continue
+		}
+		tag := field.Tag.Get("yaml")
+		name := strings.Split(tag, ",")[0]
+		switch {
+		case name == "-", strings.HasSuffix(tag, ",inline"): // extensions are checked by the caller
+			continue
+		case name == "":
+			name = strings.ToLower(field.Name)
+		}
+		fields[name] = field
+	}
+	return fields
+}
+
+func yamlKeys(t reflect.Type) map[string]bool {
+	keys := map[string]bool{}
+	for name := range yamlFields(t) {
+		keys[name] = true
+	}
+	return keys
+}
+
+// lookupNode returns the value of a key in a mapping node
+func lookupNode(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,309 @@
+package pwcompose
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pathwar.land/pathwar/v2/go/pkg/errcode"
+)
+
+// testingLintFiles is a challenge without diagnostics, the tests override some of its files
+var testingLintFiles = map[string]string{
+	"docker-compose.yml": `version: "3.7"
+x-pathwar:
+  challenge:
+    slug: hello
+    name: Hello
+  flavor:
+    passphrases: 1
+services:
+  front:
+    build: ./front
+    ports:
+      - "80"
+`,
+	"front/Dockerfile": "FROM alpine\nCOPY on-init /pwinit/\n",
+	"front/on-init":    "#!/bin/sh\npwinit passphrase 0 > /flag\n",
+}
+
+// testingLint lints a challenge made of the files of testingLintFiles overridden by files, an empty content removes a file,
+// and returns the diagnostics as "file:line:column: code", with paths relative to the challenge
+func testingLint(t *testing.T, files map[string]string) []string {
+	t.Helper()
+	dir, err := ioutil.TempDir("", "pwcompose-lint")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	merged := map[string]string{}
+	for name, content := range testingLintFiles {
+		merged[name] = content
+	}
+	for name, content := range files {
+		merged[name] = content
+	}
+	for name, content := range merged {
+		if content == "" {
+			continue
+		}
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, ioutil.WriteFile(path, []byte(content), 0644))
+	}
+
+	diagnostics, err := Lint(LintOpts{ChallengeDir: dir})
+	require.NoError(t, err)
+	ret := []string{}
+	for _, diagnostic := range diagnostics {
+		file, err := filepath.Rel(dir, diagnostic.File)
+		require.NoError(t, err)
+		ret = append(ret, fmt.Sprintf("%s:%d:%d: %s", file, diagnostic.Line, diagnostic.Column, diagnostic.Code))
+	}
+	return ret
+}
+
+func TestLint(t *testing.T) {
+	tests := []struct {
+		name     string
+		files    map[string]string
+		expected []string
+	}{
+		{"valid", nil, []string{}},
+		{
+			"invalid-yaml",
+			map[string]string{"docker-compose.yml": "version: \"3.7\"\nx-pathwar: {}\nservices: [\n"},
+			[]string{"docker-compose.yml:3:1: invalid-yaml"},
+		}, {
+			"not-a-mapping",
+			map[string]string{"docker-compose.yml": "- alpine\n"},
+			[]string{"docker-compose.yml:1:1: invalid-yaml"},
+		}, {
+			"unsupported-key",
+			map[string]string{"docker-compose.yml": `version: "3.7"
+configs: {}
+x-pathwar:
+  challenge: {slug: hello, name: Hello}
+  secret: true
+services:
+  front:
+    build: ./front
+    ports: ["80"]
+    configs: []
+    networks:
+      default:
+        aliasez: [www]
+`},
+			[]string{
+				"docker-compose.yml:2:1: unsupported-key",
+				"docker-compose.yml:5:3: unsupported-key",
+				"docker-compose.yml:10:5: unsupported-key",
+			},
+		}, {
+			"unsupported-nested-key",
+			map[string]string{"docker-compose.yml": `x-pathwar:
+  challenge: {slug: hello, name: Hello, authr: me}
+  flavor:
+    passphrases: 1
+    proxy-policy: {timeout: 1s}
+services:
+  front: {build: ./front, ports: ["80"]}
+volumes:
+  data: {driverr: local}
+`},
+			[]string{
+				"docker-compose.yml:2:41: unsupported-key",
+				"docker-compose.yml:5:20: unsupported-key",
+				"docker-compose.yml:9:10: unsupported-key",
+			},
+		}, {
+			"missing-x-pathwar",
+			map[string]string{"docker-compose.yml": "services:\n  front: {build: ./front, ports: [\"80\"]}\n"},
+			[]string{"docker-compose.yml:1:1: missing-x-pathwar"},
+		}, {
+			"missing-services",
+			map[string]string{"docker-compose.yml": "x-pathwar:\n  challenge: {slug: hello, name: Hello}\nservices: {}\n"},
+			[]string{"docker-compose.yml:1:1: missing-services", "docker-compose.yml:1:1: missing-on-init"},
+		}, {
+			"missing-challenge",
+			map[string]string{"docker-compose.yml": "x-pathwar:\n  flavor: {passphrases: 1}\nservices:\n  front: {build: ./front, ports: [\"80\"]}\n"},
+			[]string{"docker-compose.yml:2:3: missing-challenge"},
+		}, {
+			"missing-slug-and-name",
+			map[string]string{"docker-compose.yml": "x-pathwar:\n  challenge:\n    description: hello\nservices:\n  front: {build: ./front, ports: [\"80\"]}\n"},
+			[]string{"docker-compose.yml:3:5: missing-slug", "docker-compose.yml:3:5: missing-name"},
+		}, {
+			"invalid-slug",
+			map[string]string{"docker-compose.yml": "x-pathwar:\n  challenge:\n    name: Hello\n    slug: Hello World\nservices:\n  front: {build: ./front, ports: [\"80\"]}\n"},
+			[]string{"docker-compose.yml:4:11: invalid-slug"},
+		}, {
+			"invalid-value",
+			map[string]string{"docker-compose.yml": `x-pathwar:
+  challenge: {slug: hello, name: Hello}
+  flavor:
+    passphrases: many
+  seasons: global
+services:
+  front:
+    build: ./front
+    ports: ["80"]
+  db: mysql
+`},
+			[]string{
+				"docker-compose.yml:4:1: invalid-value",
+				"docker-compose.yml:5:12: invalid-value",
+				"docker-compose.yml:10:7: invalid-value",
+			},
+		}, {
+			"negative-passphrases",
+			map[string]string{"docker-compose.yml": "x-pathwar:\n  challenge: {slug: hello, name: Hello}\n  flavor:\n    passphrases: -1\nservices:\n  front: {build: ./front, ports: [\"80\"]}\n"},
+			[]string{"docker-compose.yml:4:18: invalid-value"},
+		}, {
+			"invalid-proxy-policy",
+			map[string]string{"docker-compose.yml": `x-pathwar:
+  challenge: {slug: hello, name: Hello}
+  flavor:
+    proxy-policy:
+      read_timeout: forever
+services:
+  front: {build: ./front, ports: ["80"]}
+`},
+			[]string{"docker-compose.yml:5:7: invalid-proxy-policy"},
+		}, {
+			"solver",
+			map[string]string{"docker-compose.yml": `x-pathwar:
+  challenge: {slug: hello, name: Hello}
+  solver:
+    script: solve.sh
+    timeout: soon
+services:
+  front: {build: ./front, ports: ["80"]}
+`},
+			[]string{"docker-compose.yml:4:13: missing-solver-script", "docker-compose.yml:5:14: invalid-value"},
+		}, {
+			"missing-solver-script",
+			map[string]string{"docker-compose.yml": "x-pathwar:\n  challenge: {slug: hello, name: Hello}\n  solver: {image: alpine}\nservices:\n  front: {build: ./front, ports: [\"80\"]}\n"},
+			[]string{"docker-compose.yml:3:11: missing-solver-script"},
+		}, {
+			"templates",
+			map[string]string{"docker-compose.yml": `x-pathwar:
+  challenge: {slug: hello, name: Hello}
+  pwinit:
+    templates:
+      front: [/flag, flag.txt]
+      back: [/flag]
+services:
+  front: {build: ./front, ports: ["80"]}
+`},
+			[]string{"docker-compose.yml:5:22: invalid-value", "docker-compose.yml:6:7: unknown-service"},
+		}, {
+			"dangerous-option",
+			map[string]string{"docker-compose.yml": `x-pathwar:
+  challenge: {slug: hello, name: Hello}
+services:
+  front:
+    build: ./front
+    ports: ["80"]
+    privileged: true
+    cap_add: [NET_BIND_SERVICE, CAP_SYS_ADMIN]
+    volumes:
+      - /var/run/docker.sock:/var/run/docker.sock
+`},
+			[]string{
+				"docker-compose.yml:7:5: dangerous-option",
+				"docker-compose.yml:8:33: dangerous-option",
+				"docker-compose.yml:10:9: dangerous-option",
+			},
+		}, {
+			"bind-mount-and-host-port",
+			map[string]string{"docker-compose.yml": `x-pathwar:
+  challenge: {slug: hello, name: Hello}
+services:
+  front:
+    build: ./front
+    ports:
+      - "80"
+      - "8080:80"
+    volumes:
+      - data:/data
+      - ./www:/var/www
+volumes:
+  data: {}
+`},
+			[]string{"docker-compose.yml:8:9: host-port", "docker-compose.yml:11:9: bind-mount"},
+		}, {
+			"missing-image-and-port",
+			map[string]string{"docker-compose.yml": "x-pathwar:\n  challenge: {slug: hello, name: Hello}\nservices:\n  front:\n    build: ./front\n  db:\n    environment: [FOO=bar]\n"},
+			[]string{"docker-compose.yml:4:3: no-published-port", "docker-compose.yml:6:3: missing-image"},
+		}, {
+			"build-context-not-found",
+			map[string]string{"docker-compose.yml": "x-pathwar:\n  challenge: {slug: hello, name: Hello}\nservices:\n  front:\n    build: ./back\n    ports: [\"80\"]\n"},
+			[]string{"docker-compose.yml:1:1: missing-on-init", "docker-compose.yml:5:12: build-context-not-found"},
+		}, {
+			"dockerfile-not-found",
+			map[string]string{"docker-compose.yml": "x-pathwar:\n  challenge: {slug: hello, name: Hello}\nservices:\n  front:\n    build: {context: ./front, dockerfile: Dockerfile.prod}\n    ports: [\"80\"]\n"},
+			[]string{"docker-compose.yml:1:1: missing-on-init", "docker-compose.yml:5:12: dockerfile-not-found"},
+		}, {
+			"hook-destination",
+			map[string]string{"front/Dockerfile": "FROM alpine\nRUN true\nCOPY --chown=root on-init /on-init\n"},
+			[]string{"front/Dockerfile:3:1: on-init-destination"},
+		}, {
+			"hook-not-found",
+			map[string]string{"front/Dockerfile": "FROM alpine\nCOPY on-init /pwinit/\nCOPY on-start /pwinit/on-start\n"},
+			[]string{"front/Dockerfile:3:1: on-start-not-found"},
+		}, {
+			"hook-not-copied",
+			map[string]string{"front/on-reset": "#!/bin/sh\n"},
+			[]string{"front/on-reset:1:1: on-reset-not-copied"},
+		}, {
+			"hook-not-executable",
+			map[string]string{"front/on-init": "pwinit passphrase 0 > /flag\n"},
+			[]string{"front/on-init:1:1: on-init-not-executable"},
+		}, {
+			"passphrase-out-of-range",
+			map[string]string{"front/on-init": "#!/bin/sh\npwinit passphrase 0 > /flag\necho; pwinit passphrase 1 > /flag2\n"},
+			[]string{"front/on-init:3:7: passphrase-out-of-range"},
+		}, {
+			"missing-on-init",
+			map[string]string{"front/Dockerfile": "FROM alpine\n", "front/on-init": ""},
+			[]string{"docker-compose.yml:7:18: missing-on-init"},
+		}, {
+			"unused-passphrase",
+			map[string]string{"docker-compose.yml": `x-pathwar:
+  challenge: {slug: hello, name: Hello}
+  flavor:
+    passphrases: 2
+services:
+  front: {build: ./front, ports: ["80"]}
+`},
+			[]string{"docker-compose.yml:4:18: unused-passphrase"},
+		}, {
+			"dynamic-passphrase",
+			map[string]string{
+				"docker-compose.yml": "x-pathwar:\n  challenge: {slug: hello, name: Hello}\n  flavor:\n    passphrases: 2\nservices:\n  front: {build: ./front, ports: [\"80\"]}\n",
+				"front/on-init":      "#!/bin/sh\nfor i in 0 1; do pwinit passphrase $i > /flag$i; done\n",
+			},
+			[]string{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, testingLint(t, test.files))
+		})
+	}
+}
+
+func TestLint_Errors(t *testing.T) {
+	_, err := Lint(LintOpts{ChallengeDir: "/no/such/challenge"})
+	assert.Equal(t, errcode.Code(errcode.ErrComposeDirectoryNotFound), errcode.Code(err))
+
+	dir, err := ioutil.TempDir("", "pwcompose-lint")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	_, err = Lint(LintOpts{ChallengeDir: dir})
+	assert.Equal(t, errcode.Code(errcode.ErrComposeReadConfig), errcode.Code(err))
+}
@@ -0,0 +1,163 @@
+package pwcompose
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/client"
+	"github.com/docker/docker/pkg/stdcopy"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+	"pathwar.land/pathwar/v2/go/pkg/errcode"
+)
+
+type LogsOpts struct {
+	// ID is an instance key, a challenge name or ID, a container ID or a container name
+	ID         string
+	Service    string
+	Follow     bool
+	Tail       string
+	Timestamps bool
+	// Prefix prefixes the lines with the container name even if there is a single container
+	Prefix bool
+	Stdout io.Writer
+	Stderr io.Writer
+	Logger *zap.Logger
+}
+
+func NewLogsOpts() LogsOpts {
+	return LogsOpts{
+		Tail: "all",
+	}
+}
+
+func (opts *LogsOpts) applyDefaults() {
+	if opts.Logger == nil {
+		opts.Logger = zap.NewNop()
+	}
+	if opts.Stdout == nil {
+		opts.Stdout = os.Stdout
+	}
+	if opts.Stderr == nil {
+		opts.Stderr = os.Stderr
+	}
+}
+
+// Logs prints the logs of the containers of an instance, lines are prefixed by the container name when there are several containers
+func Logs(ctx context.Context, cli *client.Client, opts LogsOpts) error {
+	opts.applyDefaults()
+	opts.Logger.Debug("logs", zap.Any("opts", opts))
+
+	containersInfo, err := GetContainersInfo(ctx, cli)
+	if err != nil {
+		return errcode.ErrComposeGetContainersInfo.Wrap(err)
+	}
+	containers := findContainers(containersInfo, opts.ID, opts.Service)
+	if len(containers) == 0 {
+		return errcode.ErrComposeNoSuchContainer.Wrap(fmt.Errorf("no container matching %q", opts.ID))
+	}
+
+	var (
+		wg   sync.WaitGroup
+		lock sync.Mutex
+		errs error
+	)
+	for _, match := range containers {
+		stdout, stderr := opts.Stdout, opts.Stderr
+		if len(containers) > 1 || opts.Prefix {
+			prefix := match.Labels[serviceNameLabel] + "." + match.Labels[InstanceKeyLabel] + " | "
+			stdout = &prefixWriter{prefix: prefix, writer: opts.Stdout, lock: &lock}
+			stderr = &prefixWriter{prefix: prefix, writer: opts.Stderr, lock: &lock}
+		}
+
+		wg.Add(1)
+		go func(container container, stdout, stderr io.Writer) {
+			defer wg.Done()
+			err := containerLogs(ctx, cli, container.ID, stdout, stderr, opts)
+			lock.Lock()
+			errs = multierr.Append(errs, err)
+			lock.Unlock()
+		}(match, stdout, stderr)
+	}
+	wg.Wait()
+	return errs
+}
+
+func containerLogs(ctx context.Context, cli *client.Client, containerID string, stdout, stderr io.Writer, opts LogsOpts) error {
+	inspect, err := cli.ContainerInspect(ctx, containerID)
+	if err != nil {
+		return errcode.ErrDockerAPIContainerInspect.Wrap(err)
+	}
+
+	reader, err := cli.ContainerLogs(ctx, containerID, types.ContainerLogsOptions{
+		ShowStdout: true,
+		ShowStderr: true,
+		Follow:     opts.Follow,
+		Tail:       opts.Tail,
+		Timestamps: opts.Timestamps,
+	})
+	if err != nil {
+		return errcode.ErrDockerAPIContainerLogs.Wrap(err)
+	}
+	defer reader.Close()
+
+	// the output of containers with a TTY is not multiplexed
+	if inspect.Config != nil && inspect.Config.Tty {
+		_, err = io.Copy(stdout, reader)
+	} else {
+		_, err = stdcopy.StdCopy(stdout, stderr, reader)
+	}
+	if err != nil {
+		return errcode.ErrDockerAPIContainerLogs.Wrap(err)
+	}
+	if writer, ok := stdout.(*prefixWriter); ok {
+		writer.Flush()
+	}
+	if writer, ok := stderr.(*prefixWriter); ok {
+		writer.Flush()
+	}
+	return nil
+}
+
+// prefixWriter prefixes each line, the lines of several writers sharing the same lock are not mixed
+type prefixWriter struct {
+	prefix string
+	writer io.Writer
+	lock   *sync.Mutex
+	buf    []byte
+}
+
+func (w *prefixWriter) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+	for {
+		idx := bytes.IndexByte(w.buf, '\n')
+		if idx < 0 {
+			break
+		}
+		if err := w.writeLine(w.buf[:idx+1]); err != nil {
+			return 0, err
+		}
+		w.buf = w.buf[idx+1:]
+	}
+	return len(p), nil
+}
+
+// Flush writes the last line if it does not end with a newline
+func (w *prefixWriter) Flush() {
+	if len(w.buf) > 0 {
+		_ = w.writeLine(append(w.buf, '\n'))
+		w.buf = nil
+	}
+}
+
+func (w *prefixWriter) writeLine(line []byte) error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	_, err := w.writer.Write(append([]byte(w.prefix), line...))
+	return err
+}
@@ -1,13 +1,18 @@
 package pwcompose
 
 import (
+	"context"
+	"crypto/ed25519"
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"os"
 	"os/exec"
 	"path"
 	"path/filepath"
+	"strings"
 
+	"github.com/docker/docker/client"
 	"go.uber.org/zap"
 	"gopkg.in/yaml.v3"
 	"pathwar.land/pathwar/v2/go/pkg/errcode"
@@ -16,10 +21,19 @@ import (
 type PrepareOpts struct {
 	ChallengeDir string
 	Prefix       string
-	NoPush       bool
-	JSON         bool
-	Version      string
-	Logger       *zap.Logger
+	// Registry is the host (and port) of the registry the images are pushed to, defaults to the Docker Hub
+	Registry string
+	// RegistryUsername and RegistryPassword are the credentials of the registry, passed to the docker engine
+	RegistryUsername string
+	RegistryPassword string `json:"-"`
+	NoPush           bool
+	JSON             bool
+	Version          string
+	// Bundle generates a bundle archive instead of a plain prepared compose file
+	Bundle bool
+	// SigningKey is the path of the ed25519 private key used to sign the bundle, implies Bundle
+	SigningKey string
+	Logger     *zap.Logger
 }
 
 func NewPrepareOpts() PrepareOpts {
@@ -31,6 +45,14 @@ func NewPrepareOpts() PrepareOpts {
 	}
 }
 
+// imagePrefix returns the prefix of the built images, including the registry
+func (opts PrepareOpts) imagePrefix() string {
+	if opts.Registry == "" {
+		return opts.Prefix
+	}
+	return opts.Registry + "/" + opts.Prefix
+}
+
 func (opts *PrepareOpts) applyDefaults() {
 	if opts.Logger == nil {
 		opts.Logger = zap.NewNop()
@@ -38,9 +60,12 @@ func (opts *PrepareOpts) applyDefaults() {
 	if opts.ChallengeDir == "" {
 		opts.ChallengeDir = "."
 	}
+	opts.Registry = normalizeRegistry(opts.Registry)
 }
 
-func Prepare(opts PrepareOpts) (string, error) {
+// Prepare generates the prepared compose file of a challenge; unless NoPush is set, it builds and pushes the images,
+// and pins each service to the digest of its image
+func Prepare(ctx context.Context, cli *client.Client, opts PrepareOpts) (string, error) {
 	opts.applyDefaults()
 	opts.Logger.Debug("prepare", zap.Any("opts", opts))
 
@@ -57,7 +82,6 @@ func Prepare(opts PrepareOpts) (string, error) {
 		challengeName   = filepath.Base(cleanPath)
 		origComposePath = path.Join(cleanPath, "docker-compose.yml")
 		tmpComposePath  = path.Join(cleanPath, "docker-compose.tmp.yml")
-		dabPath         = path.Join(cleanPath, challengeName+".dab")
 	)
 
 	if _, err := os.Stat(cleanPath); os.IsNotExist(err) {
@@ -89,26 +113,9 @@ func Prepare(opts PrepareOpts) (string, error) {
 		return "", errcode.ErrComposeInvalidConfig.Wrap(err)
 	}
 
-	// check yaml and add image name if not defined
-	for name, service := range composeStruct.Services {
-		if service.Labels == nil {
-			service.Labels = map[string]string{}
-		}
-		if service.Image == "" {
-			if !opts.NoPush {
-				service.Image = opts.Prefix + challengeName + ":" + name
-				service.Labels[serviceOrigin] = "was-built"
-			} else {
-				service.Build = path.Join(cleanPath, service.Build)
-				service.Labels[serviceOrigin] = "was-built-dev"
-			}
-		} else {
-			service.Labels[serviceOrigin] = "was-pulled"
-		}
-		service.Labels[challengeNameLabel] = challengeName
-		service.Labels[serviceNameLabel] = name
-		service.Labels[challengeVersionLabel] = opts.Version
-		composeStruct.Services[name] = service
+	buildDirs, err := prepareServices(&composeStruct, challengeName, cleanPath, opts)
+	if err != nil {
+		return "", err
 	}
 
 	// create tmp docker-compose file
@@ -132,7 +139,7 @@ func Prepare(opts PrepareOpts) (string, error) {
 	tmpFile.Close()
 
 	if !opts.NoPush {
-		// build and push images to dockerhub (don't forget to setup your credentials just type : "docker login" in bash)
+		// build images
 		args = append(composeCliCommonArgs(tmpComposePath), "build")
 		opts.Logger.Debug("docker-compose", zap.Strings("args", args))
 		cmd = exec.Command("docker-compose", args...)
@@ -146,42 +153,33 @@ func Prepare(opts PrepareOpts) (string, error) {
 			return "", errcode.ErrComposeBuild.Wrap(err)
 		}
 
-		args = append(composeCliCommonArgs(tmpComposePath), "bundle", "--push-images")
-		opts.Logger.Debug("docker-compose", zap.Strings("args", args))
-		cmd = exec.Command("docker-compose", args...)
-		cmd.Dir = cleanPath
-		if opts.Logger.Check(zap.DebugLevel, "") != nil {
-			cmd.Stdout = os.Stderr
-			cmd.Stderr = os.Stderr
-		}
-		err = cmd.Run()
-		if err != nil {
-			return "", errcode.ErrComposeBundle.Wrap(err)
+		// push the built images and pin all the images by digest
+		auth := RegistryAuth{
+			ServerAddress: opts.Registry,
+			Username:      opts.RegistryUsername,
+			Password:      opts.RegistryPassword,
 		}
-		defer func() {
-			if err = os.Remove(dabPath); err != nil {
-				opts.Logger.Warn("rm dab file", zap.Error(err))
-			}
-		}()
-
-		// parse json from .dab file
-		composeDabfileJSON := dabfile{}
-		composeDabfile, err := ioutil.ReadFile(dabPath)
-		if err != nil {
-			return "", errcode.ErrComposeReadDab.Wrap(err)
-		}
-		if err = json.Unmarshal(composeDabfile, &composeDabfileJSON); err != nil {
-			return "", errcode.ErrComposeParseDab.Wrap(err)
-		}
-
-		// replace images from original docker-compose file with the one pushed to dockerhub
+		pullOpts := NewPullOpts()
+		pullOpts.RegistryAuth = auth
+		pullOpts.Logger = opts.Logger
 		for name, service := range composeStruct.Services {
-			service.Image = composeDabfileJSON.Services[name].Image
-			service.Build = "" // ensure service only has an `image:` without a `build:`
+			if service.Labels[serviceOrigin] == "was-built" {
+				service.Image, err = pushImage(ctx, cli, service.Image, auth, opts.Logger)
+			} else {
+				service.Image, err = ensureImage(ctx, cli, service.Image, pullOpts)
+			}
+			if err != nil {
+				return "", err
+			}
+			service.Build = nil // ensure service only has an `image:` without a `build:`
 			composeStruct.Services[name] = service
 		}
 	}
 
+	if opts.Bundle || opts.SigningKey != "" {
+		return prepareBundle(composeStruct, challengeName, cleanPath, buildDirs, opts)
+	}
+
 	if opts.JSON {
 		out, err := json.MarshalIndent(&composeStruct, "", "  ")
 		if err != nil {
@@ -198,3 +196,121 @@ func Prepare(opts PrepareOpts) (string, error) {
 
 	return string(finalData), nil
 }
+
+// prepareServices checks the services and adds their image name if not defined and the pathwar labels,
+// it returns the build directory of each service
+func prepareServices(composeStruct *PathwarConfig, challengeName string, challengeDir string, opts PrepareOpts) (map[string]string, error) {
+	if keys := unsupportedKeys(composeStruct.Extensions); len(keys) > 0 {
+		return nil, errcode.ErrComposeUnsupportedKey.Wrap(fmt.Errorf("unsupported keys: %s", strings.Join(keys, ", ")))
+	}
+
+	buildDirs := map[string]string{}
+	for name, service := range composeStruct.Services {
+		if keys := unsupportedKeys(service.Extensions); len(keys) > 0 {
+			return nil, errcode.ErrComposeUnsupportedKey.Wrap(fmt.Errorf("service %q: unsupported keys: %s", name, strings.Join(keys, ", ")))
+		}
+		if service.Build != nil {
+			buildDirs[name] = path.Join(challengeDir, service.Build.Context)
+			// the built images are labelled too, so the agents know they can garbage-collect them
+			if service.Build.Labels == nil {
+				service.Build.Labels = Mapping{}
+			}
+			service.Build.Labels[challengeNameLabel] = challengeName
+			service.Build.Labels[serviceNameLabel] = name
+		}
+		if service.Labels == nil {
+			service.Labels = map[string]string{}
+		}
+		if service.Image == "" {
+			if !opts.NoPush {
+				service.Image = opts.imagePrefix() + challengeName + ":" + name
+				service.Labels[serviceOrigin] = "was-built"
+			} else {
+				if service.Build == nil {
+					service.Build = &ServiceBuild{}
+				}
+				service.Build.Context = path.Join(challengeDir, service.Build.Context)
+				service.Labels[serviceOrigin] = "was-built-dev"
+			}
+		} else {
+			service.Labels[serviceOrigin] = "was-pulled"
+		}
+		service.Labels[challengeNameLabel] = challengeName
+		service.Labels[serviceNameLabel] = name
+		service.Labels[challengeVersionLabel] = opts.Version
+		composeStruct.Services[name] = service
+	}
+	return buildDirs, nil
+}
+
+// prepareBundle packs the prepared compose file with its metadata, on-init hooks, attachments and images
+func prepareBundle(composeStruct PathwarConfig, challengeName string, challengeDir string, buildDirs map[string]string, opts PrepareOpts) (string, error) {
+	composeData, err := yaml.Marshal(&composeStruct)
+	if err != nil {
+		return "", errcode.ErrComposeMarshalConfig.Wrap(err)
+	}
+
+	bundle := Bundle{
+		Manifest: BundleManifest{
+			Challenge: challengeName,
+			Pathwar:   composeStruct.Pathwar,
+			Images:    map[string]string{},
+		},
+		Compose:     string(composeData),
+		OnInit:      map[string][]byte{},
+		Attachments: map[string][]byte{},
+	}
+	for name, service := range composeStruct.Services {
+		if service.Image != "" {
+			bundle.Manifest.Images[name] = service.Image
+		}
+	}
+
+	for name, buildDir := range buildDirs {
+		onInit, err := ioutil.ReadFile(filepath.Join(buildDir, "on-init"))
+		switch {
+		case os.IsNotExist(err):
+			continue
+		case err != nil:
+			return "", errcode.ErrComposeCreateBundle.Wrap(err)
+		}
+		bundle.OnInit[name] = onInit
+	}
+
+	attachmentsDir := filepath.Join(challengeDir, "attachments")
+	if _, err := os.Stat(attachmentsDir); err == nil {
+		err = filepath.Walk(attachmentsDir, func(filePath string, info os.FileInfo, err error) error {
+			if err != nil || info.IsDir() {
+				return err
+			}
+			content, err := ioutil.ReadFile(filePath)
+			if err != nil {
+				return err
+			}
+			relPath, err := filepath.Rel(attachmentsDir, filePath)
+			if err != nil {
+				return err
+			}
+			bundle.Attachments[filepath.ToSlash(relPath)] = content
+			return nil
+		})
+		if err != nil {
+			return "", errcode.ErrComposeCreateBundle.Wrap(err)
+		}
+	}
+
+	var key ed25519.PrivateKey
+	if opts.SigningKey != "" {
+		key, err = ReadPrivateKey(opts.SigningKey)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	signed, err := NewSignedBundle(bundle, key)
+	if err != nil {
+		return "", err
+	}
+	opts.Logger.Debug("bundle created", zap.String("hash", signed.Hash), zap.Bool("signed", key != nil), zap.Int("size", len(signed.Archive)))
+	return signed.Marshal()
+}
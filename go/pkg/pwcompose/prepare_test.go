@@ -0,0 +1,272 @@
+package pwcompose
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+	"pathwar.land/pathwar/v2/go/pkg/errcode"
+)
+
+const testingCompose = `
+version: "3.7"
+x-defaults: &defaults
+  restart: always
+services:
+  front:
+    <<: *defaults
+    container_name: front
+    build:
+      context: ./front
+      dockerfile: Dockerfile.prod
+      args:
+        - VERSION=1.2.3
+        - FROM_ENV
+      target: prod
+      labels:
+        com.example.build: "true"
+      cache_from: [alpine:3.11]
+      network: host
+      shm_size: 64M
+    command: php-fpm -d 'memory_limit = 256M'
+    entrypoint: ["/docker-entrypoint.sh"]
+    environment:
+      - FOO=bar
+      - EMPTY=
+      - FROM_ENV
+    env_file: .env
+    ports:
+      - "80"
+      - target: 8080
+        published: 8081
+        protocol: tcp
+        mode: host
+    expose: [9000, "9001"]
+    networks:
+      default:
+        aliases: [www]
+    volumes:
+      - data:/data:ro
+      - type: tmpfs
+        target: /cache
+        tmpfs:
+          size: 1000
+    volumes_from: [db]
+    tmpfs: /tmp
+    depends_on: [db]
+    links: [db:database]
+    extra_hosts: ["somehost:162.242.195.82"]
+    dns: 8.8.8.8
+    dns_search: [example.com]
+    hostname: front
+    domainname: example.com
+    user: www-data
+    working_dir: /var/www
+    read_only: true
+    stdin_open: true
+    tty: true
+    init: true
+    cap_add: [NET_BIND_SERVICE]
+    cap_drop: [ALL]
+    sysctls:
+      - net.core.somaxconn=1024
+    ulimits:
+      nproc: 65535
+      nofile:
+        soft: 20000
+        hard: 40000
+    shm_size: 128M
+    stop_signal: SIGQUIT
+    stop_grace_period: 30s
+    healthcheck:
+      test: curl -f http://localhost
+      interval: 10s
+      timeout: 5s
+      retries: 3
+      start_period: 5s
+    logging:
+      driver: json-file
+      options:
+        max-size: 10m
+    labels:
+      - com.example.front=true
+    x-custom:
+      key: value
+  db:
+    image: mysql:5.7
+    networks: [default]
+    environment:
+      MYSQL_ROOT_PASSWORD: root
+    healthcheck:
+      test: ["CMD", "mysqladmin", "ping"]
+      disable: false
+volumes:
+  data:
+x-pathwar:
+  challenge:
+    slug: testing
+  flavor:
+    passphrases: 1
+`
+
+func TestPrepareUp_PreservesFields(t *testing.T) {
+	var original PathwarConfig
+	require.NoError(t, yaml.Unmarshal([]byte(testingCompose), &original))
+
+	// the fixture should use every option supported by pathwar
+	front := original.Services["front"]
+	frontValue := reflect.ValueOf(front)
+	for i := 0; i < frontValue.NumField(); i++ {
+		field := frontValue.Type().Field(i).Name
+		if field == "Image" { // front is built, db is pulled
+			continue
+		}
+		assert.Falsef(t, frontValue.Field(i).IsZero(), "field %s is not set in the fixture", field)
+	}
+	assert.Equal(t, ShellCommand{"php-fpm", "-d", "memory_limit = 256M"}, front.Command)
+	assert.Equal(t, HealthcheckTest{"CMD-SHELL", "curl -f http://localhost"}, front.Healthcheck.Test)
+	assert.Nil(t, front.Environment["FROM_ENV"])
+	require.NotNil(t, front.Environment["EMPTY"])
+	assert.Equal(t, "", *front.Environment["EMPTY"])
+	assert.Equal(t, int64(65535), front.Ulimits["nproc"].Single)
+	assert.Equal(t, "80", front.Ports[0].Short)
+	assert.Equal(t, uint32(8081), front.Ports[1].Published)
+
+	// prepare
+	var prepared PathwarConfig
+	require.NoError(t, yaml.Unmarshal([]byte(testingCompose), &prepared))
+	opts := NewPrepareOpts()
+	opts.Prefix = "pathwar/"
+	buildDirs, err := prepareServices(&prepared, "testing", "/challenges/testing", opts)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"front": "/challenges/testing/front"}, buildDirs)
+	assert.Equal(t, "testing", prepared.Services["front"].Build.Labels[challengeNameLabel])
+	assert.Equal(t, "front", prepared.Services["front"].Build.Labels[serviceNameLabel])
+	preparedCompose, err := yaml.Marshal(&prepared)
+	require.NoError(t, err)
+
+	// up
+	var up PathwarConfig
+	require.NoError(t, yaml.Unmarshal(preparedCompose, &up))
+	challengeID := prepareInstance(&up, "42")
+	assert.Equal(t, "testing@dev", challengeID)
+	upCompose, err := yaml.Marshal(&up)
+	require.NoError(t, err)
+
+	var final PathwarConfig
+	require.NoError(t, yaml.Unmarshal(upCompose, &final))
+	originalPathwar, err := yaml.Marshal(original.Pathwar)
+	require.NoError(t, err)
+	finalPathwar, err := yaml.Marshal(final.Pathwar)
+	require.NoError(t, err)
+	assert.Equal(t, string(originalPathwar), string(finalPathwar))
+	assert.Equal(t, original.Extensions, final.Extensions)
+	require.Len(t, final.Services, len(original.Services))
+	for name, originalService := range original.Services {
+		finalService := final.Services[name]
+		if finalService.Build != nil { // the build labels are set by prepare
+			delete(finalService.Build.Labels, challengeNameLabel)
+			delete(finalService.Build.Labels, serviceNameLabel)
+		}
+		originalValue := reflect.ValueOf(originalService)
+		finalValue := reflect.ValueOf(finalService)
+		for i := 0; i < originalValue.NumField(); i++ {
+			field := originalValue.Type().Field(i).Name
+			switch field {
+			case "Image", "ContainerName", "Restart", "Labels": // set by prepare and up
+				continue
+			}
+			assert.Equalf(t, originalValue.Field(i).Interface(), finalValue.Field(i).Interface(), "%s.%s", name, field)
+		}
+		for key, value := range originalService.Labels {
+			assert.Equalf(t, value, finalService.Labels[key], "%s.Labels[%s]", name, key)
+		}
+		assert.Equal(t, "42", finalService.Labels[InstanceKeyLabel])
+		assert.Equal(t, "unless-stopped", finalService.Restart)
+	}
+	assert.Equal(t, "pathwar/testing:front", final.Services["front"].Image)
+	assert.Equal(t, "mysql:5.7", final.Services["db"].Image)
+}
+
+func TestPrepareInstance_SelfDestruct(t *testing.T) {
+	config := PathwarConfig{
+		Services: map[string]Service{"front": {Image: "nginx", Labels: map[string]string{challengeNameLabel: "testing", serviceNameLabel: "front"}}},
+		Pathwar:  PathwarMetadata{Pwinit: &PwinitOptions{SelfDestruct: true}},
+	}
+	prepareInstance(&config, "42")
+	assert.Equal(t, "no", config.Services["front"].Restart)
+
+	// "no" is a string for docker-compose, not a boolean
+	out, err := yaml.Marshal(&config)
+	require.NoError(t, err)
+	assert.Contains(t, string(out), `restart: "no"`)
+}
+
+func TestPrepareServices_UnsupportedKeys(t *testing.T) {
+	tests := []struct {
+		name    string
+		compose string
+	}{
+		{"service", "services:\n  front:\n    image: nginx\n    privileged: true\n"},
+		{"top-level", "services:\n  front:\n    image: nginx\nsecrets:\n  foo:\n    file: ./foo\n"},
+	}
+	for _, test := range tests {
+		var config PathwarConfig
+		require.NoError(t, yaml.Unmarshal([]byte(test.compose), &config), test.name)
+		_, err := prepareServices(&config, "testing", ".", NewPrepareOpts())
+		assert.Equalf(t, errcode.ErrComposeUnsupportedKey.Code(), errcode.Code(err), "%s: %v", test.name, err)
+	}
+}
+
+func TestSetPwinitEntrypoint(t *testing.T) {
+	service := Service{Command: ShellCommand{"nginx", "-g", "daemon off;"}}
+	setPwinitEntrypoint(&service, []string{"/docker-entrypoint.sh"}, []string{"nginx"})
+	assert.Equal(t, ShellCommand{"/bin/pwinit", "entrypoint"}, service.Entrypoint)
+	assert.Equal(t, ShellCommand{"/docker-entrypoint.sh", "nginx", "-g", "daemon off;"}, service.Command)
+}
+
+func TestSetPwinitUser(t *testing.T) {
+	tests := []struct {
+		name               string
+		serviceUser        string
+		imageUser          string
+		options            *PwinitOptions
+		expectedUser       string
+		expectedEntrypoint ShellCommand
+	}{
+		{"root image", "", "", nil, "", ShellCommand{"/bin/pwinit", "entrypoint"}},
+		{"root service", "root:root", "www-data", nil, "root:root", ShellCommand{"/bin/pwinit", "entrypoint"}},
+		{"image user", "", "www-data", nil, "0:0", ShellCommand{"/bin/pwinit", "entrypoint", "--user=www-data", "--"}},
+		{"service user", "1000:1000", "www-data", nil, "0:0", ShellCommand{"/bin/pwinit", "entrypoint", "--user=1000:1000", "--"}},
+		{
+			"x-pathwar user", "1000", "www-data",
+			&PwinitOptions{User: "nobody", Group: "nogroup", SelfDestruct: true, KeepCapabilities: true},
+			"0:0", ShellCommand{"/bin/pwinit", "entrypoint", "--user=nobody", "--group=nogroup", "--keep-capabilities", "--self-destruct", "--"},
+		},
+		{"self-destruct only", "", "", &PwinitOptions{SelfDestruct: true}, "", ShellCommand{"/bin/pwinit", "entrypoint", "--self-destruct", "--"}},
+	}
+	for _, test := range tests {
+		service := Service{User: test.serviceUser}
+		setPwinitEntrypoint(&service, nil, []string{"nginx"})
+		setPwinitUser(&service, test.imageUser, test.options)
+		assert.Equal(t, test.expectedUser, service.User, test.name)
+		assert.Equal(t, test.expectedEntrypoint, service.Entrypoint, test.name)
+	}
+}
+
+func TestUpOpts_ServiceFiles(t *testing.T) {
+	opts := UpOpts{
+		OnInit:      map[string][]byte{"front": []byte("#!/bin/sh\n")},
+		Attachments: map[string][]byte{"docs/README.md": []byte("hello")},
+	}
+	assert.Equal(t, map[string][]byte{
+		"/pwinit/on-init":                    []byte("#!/bin/sh\n"),
+		"/pwinit/attachments/docs/README.md": []byte("hello"),
+	}, opts.serviceFiles("front"))
+	assert.Equal(t, map[string][]byte{
+		"/pwinit/attachments/docs/README.md": []byte("hello"),
+	}, opts.serviceFiles("db"))
+	assert.Empty(t, UpOpts{}.serviceFiles("front"))
+}
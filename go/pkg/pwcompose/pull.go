@@ -0,0 +1,225 @@
+package pwcompose
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/client"
+	"github.com/dustin/go-humanize"
+	"go.uber.org/zap"
+	"gopkg.in/yaml.v3"
+	"pathwar.land/pathwar/v2/go/pkg/errcode"
+)
+
+type PullOpts struct {
+	PreparedCompose  string
+	ProgressInterval time.Duration
+	// RegistryAuth is used to pull the images of its registry
+	RegistryAuth RegistryAuth
+	Logger       *zap.Logger
+}
+
+func NewPullOpts() PullOpts {
+	return PullOpts{
+		ProgressInterval: 5 * time.Second,
+	}
+}
+
+func (opts *PullOpts) applyDefaults() {
+	if opts.Logger == nil {
+		opts.Logger = zap.NewNop()
+	}
+	if opts.ProgressInterval == 0 {
+		opts.ProgressInterval = 5 * time.Second
+	}
+}
+
+// Images returns the sorted list of images used by a prepared challenge
+func Images(preparedCompose string) ([]string, error) {
+	preparedComposeStruct := PathwarConfig{}
+	err := yaml.Unmarshal([]byte(preparedCompose), &preparedComposeStruct)
+	if err != nil {
+		return nil, errcode.ErrComposeParseConfig.Wrap(err)
+	}
+
+	unique := map[string]bool{}
+	for _, service := range preparedComposeStruct.Services {
+		if service.Image != "" {
+			unique[service.Image] = true
+		}
+	}
+	images := make([]string, 0, len(unique))
+	for image := range unique {
+		images = append(images, image)
+	}
+	sort.Strings(images)
+	return images, nil
+}
+
+// Pull ensures that the images of a prepared challenge are available locally, pulling the missing ones,
+// and returns the images pinned by digest
+func Pull(ctx context.Context, cli *client.Client, opts PullOpts) (map[string]string, error) {
+	opts.applyDefaults()
+
+	images, err := Images(opts.PreparedCompose)
+	if err != nil {
+		return nil, err
+	}
+
+	pinned := map[string]string{}
+	for _, image := range images {
+		digest, err := ensureImage(ctx, cli, image, opts)
+		if err != nil {
+			return nil, err
+		}
+		pinned[image] = digest
+	}
+	return pinned, nil
+}
+
+// ensureImage pulls an image if it is not available locally, and returns it pinned by digest
+func ensureImage(ctx context.Context, cli *client.Client, image string, opts PullOpts) (string, error) {
+	if _, _, err := cli.ImageInspectWithRaw(ctx, image); err != nil {
+		if !client.IsErrImageNotFound(err) {
+			return "", errcode.ErrDockerAPIImageInspect.Wrap(err)
+		}
+		if err := pullImage(ctx, cli, image, opts); err != nil {
+			return "", err
+		}
+	}
+	return imageDigest(ctx, cli, image)
+}
+
+type pullMessage struct {
+	ID             string `json:"id"`
+	Status         string `json:"status"`
+	Error          string `json:"error"`
+	ProgressDetail struct {
+		Current int64 `json:"current"`
+		Total   int64 `json:"total"`
+	} `json:"progressDetail"`
+}
+
+func pullImage(ctx context.Context, cli *client.Client, image string, opts PullOpts) error {
+	logger := opts.Logger.With(zap.String("image", image))
+	before := time.Now()
+	logger.Info("pulling image")
+
+	registryAuth, err := opts.RegistryAuth.encodeFor(image)
+	if err != nil {
+		return err
+	}
+	out, err := cli.ImagePull(ctx, image, types.ImagePullOptions{RegistryAuth: registryAuth})
+	if err != nil {
+		return errcode.ErrDockerAPIImagePull.Wrap(err)
+	}
+	defer out.Close()
+
+	type layer struct{ current, total int64 }
+	var (
+		layers       = map[string]*layer{}
+		decoder      = json.NewDecoder(out)
+		lastProgress = time.Now()
+	)
+	for {
+		var msg pullMessage
+		if err := decoder.Decode(&msg); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return errcode.ErrComposeReadPullProgress.Wrap(err)
+		}
+		if msg.Error != "" {
+			return errcode.ErrDockerAPIImagePull.Wrap(errors.New(msg.Error))
+		}
+		if msg.ID != "" {
+			if _, found := layers[msg.ID]; !found {
+				layers[msg.ID] = &layer{}
+			}
+			if msg.ProgressDetail.Total > 0 {
+				layers[msg.ID].current = msg.ProgressDetail.Current
+				layers[msg.ID].total = msg.ProgressDetail.Total
+			}
+			if msg.Status == "Pull complete" || msg.Status == "Already exists" {
+				layers[msg.ID].current = layers[msg.ID].total
+			}
+		}
+		logger.Debug("pull progress", zap.String("layer", msg.ID), zap.String("status", msg.Status))
+
+		if time.Since(lastProgress) >= opts.ProgressInterval {
+			var current, total int64
+			for _, layer := range layers {
+				current += layer.current
+				total += layer.total
+			}
+			logger.Info(
+				"pull progress",
+				zap.String("downloaded", humanize.Bytes(uint64(current))),
+				zap.String("total", humanize.Bytes(uint64(total))),
+				zap.Int("layers", len(layers)),
+			)
+			lastProgress = time.Now()
+		}
+	}
+
+	logger.Info("image pulled", zap.Duration("duration", time.Since(before)), zap.Int("layers", len(layers)))
+	return nil
+}
+
+// imageDigest returns the image reference pinned by digest, or the reference itself if it cannot be pinned
+// (already pinned, or locally built)
+func imageDigest(ctx context.Context, cli *client.Client, image string) (string, error) {
+	if strings.Contains(image, "@sha256:") {
+		return image, nil
+	}
+	inspect, _, err := cli.ImageInspectWithRaw(ctx, image)
+	if err != nil {
+		return "", errcode.ErrDockerAPIImageInspect.Wrap(err)
+	}
+	repository := imageRepository(image)
+	for _, repoDigest := range inspect.RepoDigests {
+		if imageRepository(repoDigest) == repository || imageRepository(repoDigest) == "docker.io/library/"+repository {
+			return repoDigest, nil
+		}
+	}
+	return image, nil
+}
+
+// pinImages replaces the images of the services by their digest when available locally
+func pinImages(ctx context.Context, cli *client.Client, config *PathwarConfig) error {
+	for name, service := range config.Services {
+		if service.Image == "" || strings.Contains(service.Image, "@sha256:") {
+			continue
+		}
+		if _, _, err := cli.ImageInspectWithRaw(ctx, service.Image); err != nil {
+			if client.IsErrImageNotFound(err) {
+				continue // will be pulled by docker-compose
+			}
+			return errcode.ErrDockerAPIImageInspect.Wrap(err)
+		}
+		digest, err := imageDigest(ctx, cli, service.Image)
+		if err != nil {
+			return err
+		}
+		service.Image = digest
+		config.Services[name] = service
+	}
+	return nil
+}
+
+// imageRepository returns the repository part of an image reference, without tag nor digest
+func imageRepository(image string) string {
+	if idx := strings.Index(image, "@"); idx != -1 {
+		image = image[:idx]
+	}
+	if idx := strings.LastIndex(image, ":"); idx != -1 && !strings.Contains(image[idx:], "/") {
+		image = image[:idx]
+	}
+	return image
+}
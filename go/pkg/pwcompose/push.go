@@ -0,0 +1,125 @@
+package pwcompose
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/client"
+	"go.uber.org/zap"
+	"pathwar.land/pathwar/v2/go/pkg/errcode"
+)
+
+const dockerHubRegistry = "docker.io"
+
+// RegistryAuth contains the credentials of a docker registry, they are passed to the docker engine with each request
+// and never written to disk.
+type RegistryAuth struct {
+	// ServerAddress is the host (and port) of the registry, i.e., "localhost:5000", defaults to the Docker Hub
+	ServerAddress string
+	Username      string
+	Password      string `json:"-"`
+}
+
+// encodeFor returns the X-Registry-Auth header for an image, the credentials are only sent to their own registry
+func (auth RegistryAuth) encodeFor(image string) (string, error) {
+	config := types.AuthConfig{}
+	server := normalizeRegistry(auth.ServerAddress)
+	if server == "" {
+		server = dockerHubRegistry
+	}
+	if (auth.Username != "" || auth.Password != "") && imageRegistry(image) == server {
+		config = types.AuthConfig{
+			Username:      auth.Username,
+			Password:      auth.Password,
+			ServerAddress: normalizeRegistry(auth.ServerAddress),
+		}
+	}
+	data, err := json.Marshal(config)
+	if err != nil {
+		return "", errcode.ErrComposeRegistryAuth.Wrap(err)
+	}
+	return base64.URLEncoding.EncodeToString(data), nil
+}
+
+// normalizeRegistry returns the host (and port) of a registry as it appears in the image references, i.e.,
+// "https://localhost:5000/" becomes "localhost:5000"
+func normalizeRegistry(registry string) string {
+	registry = strings.TrimPrefix(registry, "https://")
+	registry = strings.TrimPrefix(registry, "http://")
+	return strings.TrimRight(registry, "/")
+}
+
+// imageRegistry returns the registry host of an image reference, following the rules of the docker CLI
+func imageRegistry(image string) string {
+	idx := strings.Index(image, "/")
+	if idx == -1 {
+		return dockerHubRegistry
+	}
+	host := image[:idx]
+	if !strings.ContainsAny(host, ".:") && host != "localhost" {
+		return dockerHubRegistry
+	}
+	if host == "index.docker.io" || host == "registry-1.docker.io" {
+		return dockerHubRegistry
+	}
+	return host
+}
+
+type pushMessage struct {
+	ID     string `json:"id"`
+	Status string `json:"status"`
+	Error  string `json:"error"`
+	Aux    struct {
+		Tag    string `json:"Tag"`
+		Digest string `json:"Digest"`
+	} `json:"aux"`
+}
+
+// pushImage pushes a local image to its registry and returns it pinned by digest
+func pushImage(ctx context.Context, cli *client.Client, image string, auth RegistryAuth, logger *zap.Logger) (string, error) {
+	logger = logger.With(zap.String("image", image))
+	before := time.Now()
+	logger.Info("pushing image")
+
+	registryAuth, err := auth.encodeFor(image)
+	if err != nil {
+		return "", err
+	}
+	out, err := cli.ImagePush(ctx, image, types.ImagePushOptions{RegistryAuth: registryAuth})
+	if err != nil {
+		return "", errcode.ErrDockerAPIImagePush.Wrap(err)
+	}
+	defer out.Close()
+
+	var digest string
+	decoder := json.NewDecoder(out)
+	for {
+		var msg pushMessage
+		if err := decoder.Decode(&msg); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return "", errcode.ErrComposeReadPushProgress.Wrap(err)
+		}
+		if msg.Error != "" {
+			return "", errcode.ErrDockerAPIImagePush.Wrap(errors.New(msg.Error))
+		}
+		if msg.Aux.Digest != "" {
+			digest = msg.Aux.Digest
+		}
+		logger.Debug("push progress", zap.String("layer", msg.ID), zap.String("status", msg.Status))
+	}
+	if digest == "" {
+		return "", errcode.ErrDockerAPIImagePush.Wrap(errors.New("the registry did not return a digest"))
+	}
+
+	pinned := imageRepository(image) + "@" + digest
+	logger.Info("image pushed", zap.Duration("duration", time.Since(before)), zap.String("pinned", pinned))
+	return pinned, nil
+}
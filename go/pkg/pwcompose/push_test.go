@@ -0,0 +1,71 @@
+package pwcompose
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+
+	"github.com/docker/docker/api/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistryAuth(t *testing.T) {
+	tests := []struct {
+		auth     RegistryAuth
+		image    string
+		expected types.AuthConfig
+	}{
+		{RegistryAuth{}, "nginx", types.AuthConfig{}},
+		{RegistryAuth{Username: "u", Password: "p"}, "pathwar/helloworld:front", types.AuthConfig{Username: "u", Password: "p"}},
+		{RegistryAuth{Username: "u", Password: "p"}, "localhost:5000/pathwar/helloworld:front", types.AuthConfig{}},
+		{RegistryAuth{ServerAddress: "localhost:5000", Username: "u", Password: "p"}, "localhost:5000/pathwar/helloworld:front", types.AuthConfig{Username: "u", Password: "p", ServerAddress: "localhost:5000"}},
+		{RegistryAuth{ServerAddress: "localhost:5000", Username: "u", Password: "p"}, "mysql:5.7", types.AuthConfig{}},
+		{RegistryAuth{ServerAddress: "registry.example.com", Username: "u", Password: "p"}, "registry.example.com/mysql@sha256:abcdef", types.AuthConfig{Username: "u", Password: "p", ServerAddress: "registry.example.com"}},
+		{RegistryAuth{ServerAddress: "https://localhost:5000/", Username: "u", Password: "p"}, "localhost:5000/pathwar/helloworld:front", types.AuthConfig{Username: "u", Password: "p", ServerAddress: "localhost:5000"}},
+	}
+	for _, test := range tests {
+		encoded, err := test.auth.encodeFor(test.image)
+		require.NoError(t, err)
+		data, err := base64.URLEncoding.DecodeString(encoded)
+		require.NoError(t, err)
+		var config types.AuthConfig
+		require.NoError(t, json.Unmarshal(data, &config))
+		assert.Equalf(t, test.expected, config, "%s %+v", test.image, test.auth)
+	}
+}
+
+func TestPrepareOpts_ImagePrefix(t *testing.T) {
+	opts := NewPrepareOpts()
+	opts.applyDefaults()
+	assert.Equal(t, "pathwar/", opts.imagePrefix())
+	for _, registry := range []string{"localhost:5000", "localhost:5000/", "http://localhost:5000/"} {
+		opts.Registry = registry
+		opts.applyDefaults()
+		assert.Equal(t, "localhost:5000/pathwar/", opts.imagePrefix(), registry)
+
+		// the credentials are sent to the registry of the built images
+		image := opts.imagePrefix() + "helloworld:front"
+		anonymous, err := RegistryAuth{}.encodeFor(image)
+		require.NoError(t, err)
+		encoded, err := RegistryAuth{ServerAddress: opts.Registry, Username: "u", Password: "p"}.encodeFor(image)
+		require.NoError(t, err)
+		assert.NotEqual(t, anonymous, encoded, registry)
+	}
+}
+
+func TestNormalizeRegistry(t *testing.T) {
+	tests := []struct {
+		registry string
+		expected string
+	}{
+		{"", ""},
+		{"localhost:5000", "localhost:5000"},
+		{"localhost:5000/", "localhost:5000"},
+		{"https://registry.example.com//", "registry.example.com"},
+		{"http://localhost:5000", "localhost:5000"},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.expected, normalizeRegistry(test.registry), test.registry)
+	}
+}
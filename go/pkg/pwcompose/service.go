@@ -0,0 +1,423 @@
+package pwcompose
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// The types below follow the compose file format 3.7, most of the options accept several syntaxes,
+// they are kept as written when possible so a prepared compose file stays close to the original one.
+
+// ShellCommand is a command written as a list of arguments or as a string split like a shell does.
+type ShellCommand []string
+
+func (c *ShellCommand) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		args, err := splitCommand(node.Value)
+		if err != nil {
+			return fmt.Errorf("line %d: %w", node.Line, err)
+		}
+		*c = args
+		return nil
+	}
+	var args []string
+	if err := node.Decode(&args); err != nil {
+		return err
+	}
+	*c = args
+	return nil
+}
+
+// HealthcheckTest is the test of a healthcheck, the string syntax is a shortcut for CMD-SHELL.
+type HealthcheckTest []string
+
+func (t *HealthcheckTest) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		*t = HealthcheckTest{"CMD-SHELL", node.Value}
+		return nil
+	}
+	var test []string
+	if err := node.Decode(&test); err != nil {
+		return err
+	}
+	*t = test
+	return nil
+}
+
+// StringOrList is a list of strings that can be written as a single string.
+type StringOrList []string
+
+func (s *StringOrList) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		*s = StringOrList{node.Value}
+		return nil
+	}
+	var list []string
+	if err := node.Decode(&list); err != nil {
+		return err
+	}
+	*s = list
+	return nil
+}
+
+// Mapping is a map written as a mapping or as a list of "key=value", i.e., labels or sysctls.
+type Mapping map[string]string
+
+func (m *Mapping) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind != yaml.SequenceNode {
+		var mapping map[string]string
+		if err := node.Decode(&mapping); err != nil {
+			return err
+		}
+		*m = mapping
+		return nil
+	}
+	var list []string
+	if err := node.Decode(&list); err != nil {
+		return err
+	}
+	mapping := make(Mapping, len(list))
+	for _, item := range list {
+		parts := strings.SplitN(item, "=", 2)
+		if len(parts) == 2 {
+			mapping[parts[0]] = parts[1]
+		} else {
+			mapping[parts[0]] = ""
+		}
+	}
+	*m = mapping
+	return nil
+}
+
+// MappingWithEquals is a map written as a mapping or as a list of "key=value", i.e., environment or build args.
+// A key without value is nil, it is resolved from the environment of docker-compose.
+type MappingWithEquals map[string]*string
+
+func (m *MappingWithEquals) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind != yaml.SequenceNode {
+		var mapping map[string]*string
+		if err := node.Decode(&mapping); err != nil {
+			return err
+		}
+		*m = mapping
+		return nil
+	}
+	var list []string
+	if err := node.Decode(&list); err != nil {
+		return err
+	}
+	mapping := make(MappingWithEquals, len(list))
+	for _, item := range list {
+		parts := strings.SplitN(item, "=", 2)
+		if len(parts) == 2 {
+			value := parts[1]
+			mapping[parts[0]] = &value
+		} else {
+			mapping[parts[0]] = nil
+		}
+	}
+	*m = mapping
+	return nil
+}
+
+// ServiceBuild is the build configuration of a service, the string syntax only sets the context.
+type ServiceBuild struct {
+	Context    string            `yaml:"context,omitempty" json:"context,omitempty"`
+	Dockerfile string            `yaml:"dockerfile,omitempty" json:"dockerfile,omitempty"`
+	Args       MappingWithEquals `yaml:"args,omitempty" json:"args,omitempty"`
+	Target     string            `yaml:"target,omitempty" json:"target,omitempty"`
+	Labels     Mapping           `yaml:"labels,omitempty" json:"labels,omitempty"`
+	CacheFrom  []string          `yaml:"cache_from,omitempty" json:"cache_from,omitempty"`
+	Network    string            `yaml:"network,omitempty" json:"network,omitempty"`
+	ShmSize    string            `yaml:"shm_size,omitempty" json:"shm_size,omitempty"`
+}
+
+type serviceBuild ServiceBuild
+
+func (b *ServiceBuild) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		*b = ServiceBuild{Context: node.Value}
+		return nil
+	}
+	return node.Decode((*serviceBuild)(b))
+}
+
+func (b ServiceBuild) isShort() bool {
+	return b.Dockerfile == "" && b.Args == nil && b.Target == "" && b.Labels == nil && b.CacheFrom == nil && b.Network == "" && b.ShmSize == ""
+}
+
+func (b ServiceBuild) MarshalYAML() (interface{}, error) {
+	if b.isShort() {
+		return b.Context, nil
+	}
+	return serviceBuild(b), nil
+}
+
+func (b ServiceBuild) MarshalJSON() ([]byte, error) {
+	if b.isShort() {
+		return json.Marshal(b.Context)
+	}
+	return json.Marshal(serviceBuild(b))
+}
+
+// ServicePort is a port of a service, in the short ("8080:80/tcp") or in the long syntax.
+type ServicePort struct {
+	Target    uint32 `yaml:"target,omitempty" json:"target,omitempty"`
+	Published uint32 `yaml:"published,omitempty" json:"published,omitempty"`
+	Protocol  string `yaml:"protocol,omitempty" json:"protocol,omitempty"`
+	Mode      string `yaml:"mode,omitempty" json:"mode,omitempty"`
+
+	// Short is the port in the short syntax, the other fields are not set in this case
+	Short string `yaml:"-" json:"-"`
+}
+
+type servicePort ServicePort
+
+func (p *ServicePort) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		*p = ServicePort{Short: node.Value}
+		return nil
+	}
+	return node.Decode((*servicePort)(p))
+}
+
+func (p ServicePort) MarshalYAML() (interface{}, error) {
+	if p.Short != "" {
+		return p.Short, nil
+	}
+	return servicePort(p), nil
+}
+
+func (p ServicePort) MarshalJSON() ([]byte, error) {
+	if p.Short != "" {
+		return json.Marshal(p.Short)
+	}
+	return json.Marshal(servicePort(p))
+}
+
+// IsPublished returns true if the port is bound on the host.
+func (p ServicePort) IsPublished() bool {
+	if p.Short != "" {
+		return strings.Contains(p.Short, ":")
+	}
+	return p.Published != 0
+}
+
+func (p ServicePort) String() string {
+	switch {
+	case p.Short != "":
+		return p.Short
+	case p.Published != 0:
+		return fmt.Sprintf("%d:%d", p.Published, p.Target)
+	default:
+		return fmt.Sprintf("%d", p.Target)
+	}
+}
+
+// ServiceVolume is a volume of a service, in the short ("source:target:mode") or in the long syntax.
+type ServiceVolume struct {
+	Type        string `yaml:"type,omitempty" json:"type,omitempty"`
+	Source      string `yaml:"source,omitempty" json:"source,omitempty"`
+	Target      string `yaml:"target,omitempty" json:"target,omitempty"`
+	ReadOnly    bool   `yaml:"read_only,omitempty" json:"read_only,omitempty"`
+	Consistency string `yaml:"consistency,omitempty" json:"consistency,omitempty"`
+	Volume      *struct {
+		NoCopy bool `yaml:"nocopy,omitempty" json:"nocopy,omitempty"`
+	} `yaml:"volume,omitempty" json:"volume,omitempty"`
+	Bind *struct {
+		Propagation string `yaml:"propagation,omitempty" json:"propagation,omitempty"`
+	} `yaml:"bind,omitempty" json:"bind,omitempty"`
+	Tmpfs *struct {
+		Size int64 `yaml:"size,omitempty" json:"size,omitempty"`
+	} `yaml:"tmpfs,omitempty" json:"tmpfs,omitempty"`
+
+	// Short is the volume in the short syntax, the other fields are not set in this case
+	Short string `yaml:"-" json:"-"`
+}
+
+type serviceVolume ServiceVolume
+
+func (v *ServiceVolume) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		*v = ServiceVolume{Short: node.Value}
+		return nil
+	}
+	return node.Decode((*serviceVolume)(v))
+}
+
+func (v ServiceVolume) MarshalYAML() (interface{}, error) {
+	if v.Short != "" {
+		return v.Short, nil
+	}
+	return serviceVolume(v), nil
+}
+
+func (v ServiceVolume) MarshalJSON() ([]byte, error) {
+	if v.Short != "" {
+		return json.Marshal(v.Short)
+	}
+	return json.Marshal(serviceVolume(v))
+}
+
+// SourcePath returns the source of the volume, i.e., a named volume or a path on the host.
+func (v ServiceVolume) SourcePath() string {
+	if v.Short != "" {
+		return strings.SplitN(v.Short, ":", 2)[0]
+	}
+	return v.Source
+}
+
+// ServiceNetwork is the configuration of a service in a network, the list syntax only sets the network names.
+type ServiceNetwork struct {
+	Aliases     []string `yaml:"aliases,omitempty" json:"aliases,omitempty"`
+	IPv4Address string   `yaml:"ipv4_address,omitempty" json:"ipv4_address,omitempty"`
+	IPv6Address string   `yaml:"ipv6_address,omitempty" json:"ipv6_address,omitempty"`
+}
+
+// ServiceNetworks are the networks of a service, by network name.
+type ServiceNetworks map[string]*ServiceNetwork
+
+func (n *ServiceNetworks) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind != yaml.SequenceNode {
+		var networks map[string]*ServiceNetwork
+		if err := node.Decode(&networks); err != nil {
+			return err
+		}
+		*n = networks
+		return nil
+	}
+	var list []string
+	if err := node.Decode(&list); err != nil {
+		return err
+	}
+	networks := make(ServiceNetworks, len(list))
+	for _, name := range list {
+		networks[name] = nil
+	}
+	*n = networks
+	return nil
+}
+
+// MarshalYAML uses the list syntax when no network has options.
+func (n ServiceNetworks) MarshalYAML() (interface{}, error) {
+	names := make([]string, 0, len(n))
+	for name, network := range n {
+		if network != nil {
+			return map[string]*ServiceNetwork(n), nil
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// Healthcheck configures the check used by docker to know if a service is healthy.
+type Healthcheck struct {
+	Test        HealthcheckTest `yaml:"test,omitempty" json:"test,omitempty"`
+	Interval    string          `yaml:"interval,omitempty" json:"interval,omitempty"`
+	Timeout     string          `yaml:"timeout,omitempty" json:"timeout,omitempty"`
+	Retries     int             `yaml:"retries,omitempty" json:"retries,omitempty"`
+	StartPeriod string          `yaml:"start_period,omitempty" json:"start_period,omitempty"`
+	Disable     bool            `yaml:"disable,omitempty" json:"disable,omitempty"`
+}
+
+// Ulimit is a resource limit, written as a single value or with a soft and a hard limit.
+type Ulimit struct {
+	Soft int64 `yaml:"soft,omitempty" json:"soft,omitempty"`
+	Hard int64 `yaml:"hard,omitempty" json:"hard,omitempty"`
+
+	// Single is the value of both limits when written as a single value
+	Single int64 `yaml:"-" json:"-"`
+}
+
+type ulimit Ulimit
+
+func (u *Ulimit) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		*u = Ulimit{}
+		return node.Decode(&u.Single)
+	}
+	return node.Decode((*ulimit)(u))
+}
+
+func (u Ulimit) MarshalYAML() (interface{}, error) {
+	if u.Single != 0 {
+		return u.Single, nil
+	}
+	return ulimit(u), nil
+}
+
+func (u Ulimit) MarshalJSON() ([]byte, error) {
+	if u.Single != 0 {
+		return json.Marshal(u.Single)
+	}
+	return json.Marshal(ulimit(u))
+}
+
+// Logging configures the logging driver of a service.
+type Logging struct {
+	Driver  string            `yaml:"driver,omitempty" json:"driver,omitempty"`
+	Options map[string]string `yaml:"options,omitempty" json:"options,omitempty"`
+}
+
+// unsupportedKeys returns the keys of the extensions that are not "x-" extension fields
+func unsupportedKeys(extensions map[string]interface{}) []string {
+	keys := []string{}
+	for key := range extensions {
+		if !strings.HasPrefix(key, "x-") {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// splitCommand splits a command like a POSIX shell, supporting quotes and backslash escapes
+func splitCommand(command string) ([]string, error) {
+	var (
+		args    = []string{}
+		current strings.Builder
+		inArg   bool
+		quote   rune
+		escaped bool
+	)
+	for _, r := range command {
+		switch {
+		case escaped:
+			current.WriteRune(r)
+			escaped = false
+		case r == '\\' && quote != '\'':
+			escaped = true
+			inArg = true
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				current.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote = r
+			inArg = true
+		case r == ' ' || r == '\t' || r == '\n':
+			if inArg {
+				args = append(args, current.String())
+				current.Reset()
+				inArg = false
+			}
+		default:
+			current.WriteRune(r)
+			inArg = true
+		}
+	}
+	if quote != 0 || escaped {
+		return nil, fmt.Errorf("unterminated quote or escape in command %q", command)
+	}
+	if inArg {
+		args = append(args, current.String())
+	}
+	return args, nil
+}
@@ -0,0 +1,322 @@
+package pwcompose
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	dockercontainer "github.com/docker/docker/api/types/container"
+	dockernetwork "github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/client"
+	"github.com/docker/docker/pkg/stdcopy"
+	"go.uber.org/zap"
+	"gopkg.in/yaml.v3"
+	"pathwar.land/pathwar/v2/go/internal/randstring"
+	"pathwar.land/pathwar/v2/go/pkg/errcode"
+	"pathwar.land/pathwar/v2/go/pkg/pwdb"
+	"pathwar.land/pathwar/v2/go/pkg/pwinit"
+)
+
+type TestOpts struct {
+	ChallengeDir string
+	// Timeout overrides the timeout of the solver configuration
+	Timeout time.Duration
+	// Keep leaves the challenge running after the test
+	Keep bool
+	// Output receives the logs of the solver and of the challenge when the test fails
+	Output io.Writer
+	Logger *zap.Logger
+}
+
+func NewTestOpts() TestOpts {
+	return TestOpts{
+		ChallengeDir: ".",
+	}
+}
+
+func (opts *TestOpts) applyDefaults() {
+	if opts.Logger == nil {
+		opts.Logger = zap.NewNop()
+	}
+	if opts.ChallengeDir == "" {
+		opts.ChallengeDir = "."
+	}
+	if opts.Output == nil {
+		opts.Output = os.Stderr
+	}
+}
+
+// Test starts a challenge with known passphrases, runs its solver against it and checks that the solver prints
+// the expected passphrases
+// nolint:gocyclo
+func Test(ctx context.Context, cli *client.Client, opts TestOpts) error {
+	opts.applyDefaults()
+	opts.Logger.Debug("test", zap.Any("opts", opts))
+
+	// build the challenge locally
+	prepareOpts := NewPrepareOpts()
+	prepareOpts.ChallengeDir = opts.ChallengeDir
+	prepareOpts.NoPush = true
+	prepareOpts.Version = testVersion
+	prepareOpts.Logger = opts.Logger
+	preparedCompose, err := Prepare(ctx, cli, prepareOpts)
+	if err != nil {
+		return err
+	}
+	config := PathwarConfig{}
+	if err := yaml.Unmarshal([]byte(preparedCompose), &config); err != nil {
+		return errcode.ErrComposeParseConfig.Wrap(err)
+	}
+	solver := config.Pathwar.Solver
+	if solver == nil || solver.Script == "" {
+		return errcode.ErrComposeMissingSolver
+	}
+	script, err := ioutil.ReadFile(filepath.Join(opts.ChallengeDir, solver.Script))
+	if err != nil {
+		return errcode.ErrComposeReadSolver.Wrap(err)
+	}
+	timeout := opts.Timeout
+	if timeout == 0 {
+		timeout = defaultSolverTimeout
+		if solver.Timeout != "" {
+			timeout, err = time.ParseDuration(solver.Timeout)
+			if err != nil {
+				return errcode.ErrComposeReadSolver.Wrap(err)
+			}
+		}
+	}
+
+	// start the challenge with known passphrases
+	passphrases, pwinitConfig := testPassphrases(config.Pathwar.Flavor)
+	upOpts := NewUpOpts()
+	upOpts.PreparedCompose = preparedCompose
+	upOpts.InstanceKey = testInstanceKey
+	upOpts.ForceRecreate = true
+	upOpts.Build = true
+	upOpts.PwinitConfig = &pwinitConfig
+	upOpts.Logger = opts.Logger
+	if _, err := Up(ctx, cli, upOpts); err != nil {
+		return err
+	}
+	var challengeID string
+	for _, service := range config.Services {
+		challengeID = service.ChallengeID()
+		break
+	}
+	if !opts.Keep {
+		defer func() {
+			err := Clean(ctx, cli, CleanOpts{ContainerIDs: []string{challengeID}, RemoveVolumes: true, Logger: opts.Logger})
+			if err != nil {
+				opts.Logger.Warn("down challenge", zap.Error(err))
+			}
+		}()
+	}
+
+	// find the targeted service
+	containersInfo, err := GetContainersInfo(ctx, cli)
+	if err != nil {
+		return errcode.ErrComposeGetContainersInfo.Wrap(err)
+	}
+	target, err := solverTarget(findContainers(containersInfo, challengeID, solver.Service), solver)
+	if err != nil {
+		return err
+	}
+	serviceName := target.Labels[serviceNameLabel]
+	port := solver.Port
+	if port == 0 {
+		port = int(target.Ports[0].PrivatePort)
+	}
+	url := fmt.Sprintf("http://%s:%d/", serviceName, port)
+	opts.Logger.Debug("solver target", zap.String("container", target.name()), zap.String("url", url))
+
+	// run the solver
+	result, err := runSolver(ctx, cli, solver, script, target, url, timeout, opts)
+	if err != nil {
+		return err
+	}
+
+	missing := missingPassphrases(result.stdout, passphrases)
+	if !result.timedOut && result.exitCode == 0 && len(missing) == 0 {
+		opts.Logger.Debug("solver succeeded", zap.String("stdout", result.stdout))
+		return nil
+	}
+
+	// print the logs to help the author to understand what went wrong
+	fmt.Fprintf(opts.Output, "solver stdout:\n%s\nsolver stderr:\n%s\nchallenge logs:\n", result.stdout, result.stderr)
+	logsOpts := NewLogsOpts()
+	logsOpts.ID = challengeID
+	logsOpts.Stdout = opts.Output
+	logsOpts.Stderr = opts.Output
+	logsOpts.Logger = opts.Logger
+	if err := Logs(ctx, cli, logsOpts); err != nil {
+		opts.Logger.Warn("challenge logs", zap.Error(err))
+	}
+	switch {
+	case result.timedOut:
+		return errcode.ErrComposeSolverFailed.Wrap(fmt.Errorf("solver timed out after %s", timeout))
+	case result.exitCode != 0:
+		return errcode.ErrComposeSolverFailed.Wrap(fmt.Errorf("solver exited with code %d", result.exitCode))
+	}
+	return errcode.ErrComposeSolverFailed.Wrap(fmt.Errorf("missing passphrases in the solver output: %s", strings.Join(missing, ", ")))
+}
+
+// testPassphrases returns the passphrases expected from the solver and the pwinit configuration producing them
+func testPassphrases(flavor pwdb.ChallengeFlavor) ([]string, pwinit.InitConfig) {
+	passphrases := make([]string, flavor.PassphrasesCount())
+	config := pwinit.InitConfig{}
+	if flavor.PerUserPassphrases {
+		// the solver plays the player of the test prefix hash, sent in the Host header
+		config.PassphraseSecret = fmt.Sprintf("test-%s", randstring.RandString(20))
+		for idx := range passphrases {
+			passphrases[idx] = pwinit.DerivePassphrase(config.PassphraseSecret, idx, testPrefixHash)
+		}
+		return passphrases, config
+	}
+	for idx := range passphrases {
+		passphrases[idx] = fmt.Sprintf("test-%s", randstring.RandString(10))
+	}
+	config.Passphrases = passphrases
+	return passphrases, config
+}
+
+// missingPassphrases returns the indexes, i.e., "#0", of the passphrases not printed by the solver
+func missingPassphrases(stdout string, passphrases []string) []string {
+	var missing []string
+	for idx, passphrase := range passphrases {
+		if !strings.Contains(stdout, passphrase) {
+			missing = append(missing, fmt.Sprintf("#%d", idx))
+		}
+	}
+	return missing
+}
+
+// solverTarget returns the container targeted by the solver, the first one exposing a port by default
+func solverTarget(containers []container, solver *SolverConfig) (container, error) {
+	for _, candidate := range containers {
+		if len(candidate.Ports) == 0 && solver.Port == 0 {
+			continue
+		}
+		sort.Slice(candidate.Ports, func(i, j int) bool {
+			return candidate.Ports[i].PrivatePort < candidate.Ports[j].PrivatePort
+		})
+		return candidate, nil
+	}
+	if solver.Service != "" {
+		return container{}, errcode.ErrComposeNoSuchContainer.Wrap(fmt.Errorf("no port exposed by service %q", solver.Service))
+	}
+	return container{}, errcode.ErrComposeNoSuchContainer.Wrap(fmt.Errorf("no service exposing a port"))
+}
+
+type solverResult struct {
+	stdout, stderr string
+	exitCode       int64
+	timedOut       bool
+}
+
+// runSolver runs the solver script in a container attached to the network of the targeted container
+func runSolver(ctx context.Context, cli *client.Client, solver *SolverConfig, script []byte, target container, url string, timeout time.Duration, opts TestOpts) (*solverResult, error) {
+	image := solver.Image
+	if image == "" {
+		image = defaultSolverImage
+	}
+	if _, _, err := cli.ImageInspectWithRaw(ctx, image); err != nil {
+		if !client.IsErrImageNotFound(err) {
+			return nil, errcode.ErrDockerAPIImageInspect.Wrap(err)
+		}
+		pullOpts := NewPullOpts()
+		pullOpts.Logger = opts.Logger
+		if err := pullImage(ctx, cli, image, pullOpts); err != nil {
+			return nil, err
+		}
+	}
+
+	networks := make([]string, 0)
+	if target.NetworkSettings != nil {
+		for name := range target.NetworkSettings.Networks {
+			networks = append(networks, name)
+		}
+	}
+	sort.Strings(networks)
+	if len(networks) == 0 {
+		return nil, errcode.ErrComposeSolverFailed.Wrap(fmt.Errorf("container %q is not attached to a network", target.name()))
+	}
+
+	scriptPath := "/pathwar/" + filepath.Base(solver.Script)
+	created, err := cli.ContainerCreate(ctx,
+		&dockercontainer.Config{
+			Image:      image,
+			Entrypoint: []string{scriptPath},
+			User:       "root",
+			Env: []string{
+				"PATHWAR_URL=" + url,
+				"PATHWAR_SERVICE=" + target.Labels[serviceNameLabel],
+				"PATHWAR_HOST=" + testPrefixHash + ".localhost",
+			},
+		},
+		nil,
+		&dockernetwork.NetworkingConfig{
+			EndpointsConfig: map[string]*dockernetwork.EndpointSettings{networks[0]: {}},
+		},
+		fmt.Sprintf("%s.solver.%s", target.Labels[challengeNameLabel], testInstanceKey),
+	)
+	if err != nil {
+		return nil, errcode.ErrDockerAPIContainerCreate.Wrap(err)
+	}
+	defer func() {
+		err := cli.ContainerRemove(ctx, created.ID, types.ContainerRemoveOptions{Force: true, RemoveVolumes: true})
+		if err != nil {
+			opts.Logger.Warn("remove solver container", zap.Error(err))
+		}
+	}()
+
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	err = tw.WriteHeader(&tar.Header{Name: scriptPath, Mode: 0755, Size: int64(len(script))})
+	if err == nil {
+		_, err = tw.Write(script)
+	}
+	if err == nil {
+		err = tw.Close()
+	}
+	if err != nil {
+		return nil, errcode.ErrComposeReadSolver.Wrap(err)
+	}
+	if err := cli.CopyToContainer(ctx, created.ID, "/", &buf, types.CopyToContainerOptions{}); err != nil {
+		return nil, errcode.ErrComposeReadSolver.Wrap(err)
+	}
+
+	if err := cli.ContainerStart(ctx, created.ID, types.ContainerStartOptions{}); err != nil {
+		return nil, errcode.ErrDockerAPIContainerStart.Wrap(err)
+	}
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	result := solverResult{}
+	result.exitCode, err = cli.ContainerWait(waitCtx, created.ID)
+	switch {
+	case waitCtx.Err() == context.DeadlineExceeded:
+		result.timedOut = true
+	case err != nil:
+		return nil, errcode.ErrDockerAPIContainerWait.Wrap(err)
+	}
+
+	reader, err := cli.ContainerLogs(ctx, created.ID, types.ContainerLogsOptions{ShowStdout: true, ShowStderr: true})
+	if err != nil {
+		return nil, errcode.ErrDockerAPIContainerLogs.Wrap(err)
+	}
+	defer reader.Close()
+	var stdout, stderr bytes.Buffer
+	if _, err := stdcopy.StdCopy(&stdout, &stderr, reader); err != nil {
+		return nil, errcode.ErrDockerAPIContainerLogs.Wrap(err)
+	}
+	result.stdout, result.stderr = stdout.String(), stderr.String()
+	return &result, nil
+}
@@ -0,0 +1,214 @@
+package pwcompose
+
+import (
+	"archive/tar"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	dockercontainer "github.com/docker/docker/api/types/container"
+	dockernetwork "github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/client"
+	"github.com/docker/docker/pkg/stdcopy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pathwar.land/pathwar/v2/go/pkg/errcode"
+	"pathwar.land/pathwar/v2/go/pkg/pwdb"
+	"pathwar.land/pathwar/v2/go/pkg/pwinit"
+)
+
+func TestTestPassphrases(t *testing.T) {
+	passphrases, config := testPassphrases(pwdb.ChallengeFlavor{Passphrases: 3})
+	require.Len(t, passphrases, 3)
+	assert.Equal(t, passphrases, config.Passphrases)
+	assert.Empty(t, config.PassphraseSecret)
+	assert.NotEqual(t, passphrases[0], passphrases[1])
+
+	// a flavor declaring no passphrases has one, as registered by the API
+	passphrases, config = testPassphrases(pwdb.ChallengeFlavor{})
+	assert.Len(t, passphrases, 1)
+	assert.Equal(t, passphrases, config.Passphrases)
+
+	// the solver plays the player of the test prefix hash
+	passphrases, config = testPassphrases(pwdb.ChallengeFlavor{Passphrases: 2, PerUserPassphrases: true})
+	require.Len(t, passphrases, 2)
+	assert.Empty(t, config.Passphrases)
+	require.NotEmpty(t, config.PassphraseSecret)
+	for idx, passphrase := range passphrases {
+		assert.Equal(t, pwinit.DerivePassphrase(config.PassphraseSecret, idx, testPrefixHash), passphrase)
+	}
+}
+
+func TestMissingPassphrases(t *testing.T) {
+	passphrases := []string{"test-a", "test-b"}
+	tests := []struct {
+		name     string
+		stdout   string
+		expected []string
+	}{
+		{"all", "test-a\ntest-b\n", nil},
+		{"any-order", "found test-b and test-a", nil},
+		{"one", "test-b\n", []string{"#0"}},
+		{"none", "", []string{"#0", "#1"}},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.expected, missingPassphrases(test.stdout, passphrases), test.name)
+	}
+}
+
+func TestSolverTarget(t *testing.T) {
+	newContainer := func(id string, ports ...uint16) container {
+		c := container{ID: id}
+		for _, port := range ports {
+			c.Ports = append(c.Ports, types.Port{PrivatePort: port})
+		}
+		return c
+	}
+	tests := []struct {
+		name          string
+		containers    []container
+		solver        SolverConfig
+		expectedID    string
+		expectedPorts []uint16
+		expectedErr   error
+	}{
+		{"first-exposing-a-port", []container{newContainer("db"), newContainer("front", 8080, 80)}, SolverConfig{}, "front", []uint16{80, 8080}, nil},
+		{"port-override", []container{newContainer("db")}, SolverConfig{Port: 3306}, "db", nil, nil},
+		{"no-port", []container{newContainer("db")}, SolverConfig{}, "", nil, errcode.ErrComposeNoSuchContainer},
+		{"service-without-port", []container{newContainer("db")}, SolverConfig{Service: "db"}, "", nil, errcode.ErrComposeNoSuchContainer},
+		{"no-container", nil, SolverConfig{}, "", nil, errcode.ErrComposeNoSuchContainer},
+	}
+	for _, test := range tests {
+		target, err := solverTarget(test.containers, &test.solver)
+		assert.Equalf(t, errcode.Code(test.expectedErr), errcode.Code(err), "%s: %v", test.name, err)
+		if err != nil {
+			continue
+		}
+		assert.Equal(t, test.expectedID, target.ID, test.name)
+		var ports []uint16
+		for _, port := range target.Ports {
+			ports = append(ports, port.PrivatePort)
+		}
+		assert.Equal(t, test.expectedPorts, ports, test.name)
+	}
+}
+
+// testingSolverDaemon is a fake docker daemon running the solver container
+type testingSolverDaemon struct {
+	exitCode int64
+	hang     bool
+	stdout   string
+	stderr   string
+
+	config  dockercontainer.Config
+	network string
+	script  *tar.Header
+	removed bool
+}
+
+func (d *testingSolverDaemon) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	path := r.URL.Path[strings.Index(r.URL.Path[1:], "/")+1:] // strip the API version
+	switch {
+	case r.Method == http.MethodGet && strings.HasPrefix(path, "/images/"):
+		fmt.Fprint(w, `{}`)
+	case r.Method == http.MethodPost && path == "/containers/create":
+		var body struct {
+			dockercontainer.Config
+			NetworkingConfig dockernetwork.NetworkingConfig
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		d.config = body.Config
+		for name := range body.NetworkingConfig.EndpointsConfig {
+			d.network = name
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"Id": "solver"}`)
+	case r.Method == http.MethodPut && path == "/containers/solver/archive":
+		header, err := tar.NewReader(r.Body).Next()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		d.script = header
+	case r.Method == http.MethodPost && path == "/containers/solver/start":
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodPost && path == "/containers/solver/wait":
+		if d.hang {
+			<-r.Context().Done()
+			return
+		}
+		fmt.Fprintf(w, `{"StatusCode": %d}`, d.exitCode)
+	case r.Method == http.MethodGet && path == "/containers/solver/logs":
+		fmt.Fprint(stdcopy.NewStdWriter(w, stdcopy.Stdout), d.stdout)
+		fmt.Fprint(stdcopy.NewStdWriter(w, stdcopy.Stderr), d.stderr)
+	case r.Method == http.MethodDelete && path == "/containers/solver":
+		d.removed = true
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func TestRunSolver(t *testing.T) {
+	target := container{
+		Labels:          map[string]string{challengeNameLabel: "testing", serviceNameLabel: "front"},
+		NetworkSettings: &types.SummaryNetworkSettings{Networks: map[string]*dockernetwork.EndpointSettings{"testing_default": {}}},
+	}
+	solver := &SolverConfig{Script: "./solver/solve.sh"}
+	opts := NewTestOpts()
+	opts.applyDefaults()
+	run := func(daemon *testingSolverDaemon, target container, timeout time.Duration) (*solverResult, error) {
+		server := httptest.NewServer(daemon)
+		defer server.Close()
+		cli, err := client.NewClient("tcp://"+server.Listener.Addr().String(), "1.25", nil, nil)
+		require.NoError(t, err)
+		return runSolver(context.Background(), cli, solver, []byte("#!/bin/sh\n"), target, "http://front:80/", timeout, opts)
+	}
+
+	// the script runs on the network of the target and prints the passphrases
+	daemon := testingSolverDaemon{stdout: "test-a\n", stderr: "debug\n"}
+	result, err := run(&daemon, target, time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, solverResult{stdout: "test-a\n", stderr: "debug\n"}, *result)
+	assert.Equal(t, defaultSolverImage, daemon.config.Image)
+	assert.Equal(t, []string{"/pathwar/solve.sh"}, []string(daemon.config.Entrypoint))
+	assert.Contains(t, daemon.config.Env, "PATHWAR_URL=http://front:80/")
+	assert.Contains(t, daemon.config.Env, "PATHWAR_SERVICE=front")
+	assert.Contains(t, daemon.config.Env, "PATHWAR_HOST="+testPrefixHash+".localhost")
+	assert.Equal(t, "testing_default", daemon.network)
+	require.NotNil(t, daemon.script)
+	assert.Equal(t, "/pathwar/solve.sh", daemon.script.Name)
+	assert.Equal(t, int64(0755), daemon.script.Mode)
+	assert.True(t, daemon.removed)
+
+	// failures are reported with the output of the solver
+	daemon = testingSolverDaemon{exitCode: 2, stderr: "curl: (7) Failed to connect\n"}
+	result, err = run(&daemon, target, time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), result.exitCode)
+	assert.False(t, result.timedOut)
+	assert.Equal(t, "curl: (7) Failed to connect\n", result.stderr)
+	assert.True(t, daemon.removed)
+
+	daemon = testingSolverDaemon{hang: true, stdout: "partial"}
+	result, err = run(&daemon, target, 100*time.Millisecond)
+	require.NoError(t, err)
+	assert.True(t, result.timedOut)
+	assert.Equal(t, "partial", result.stdout)
+	assert.True(t, daemon.removed)
+
+	// the solver cannot reach a container without network
+	daemon = testingSolverDaemon{}
+	_, err = run(&daemon, container{Labels: target.Labels}, time.Minute)
+	assert.Equal(t, errcode.Code(errcode.ErrComposeSolverFailed), errcode.Code(err))
+	assert.Empty(t, daemon.config.Image)
+}
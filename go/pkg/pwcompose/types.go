@@ -12,48 +12,119 @@ type PathwarConfig struct {
 	Networks map[string]network
 	Volumes  map[string]volume
 	Services map[string]Service
-	Pathwar  struct {
-		Challenge pwdb.Challenge       `yaml:"challenge" json:"challenge"`
-		Flavor    pwdb.ChallengeFlavor `yaml:"flavor" json:"flavor"`
-	} `yaml:"x-pathwar" json:"pathwar"`
+	Pathwar  PathwarMetadata `yaml:"x-pathwar" json:"pathwar"`
+	// Extensions are the other top-level keys, only "x-" extension fields are supported
+	Extensions map[string]interface{} `yaml:",inline" json:",omitempty"`
+}
+
+// PathwarMetadata is the x-pathwar section of a compose file.
+type PathwarMetadata struct {
+	Challenge pwdb.Challenge       `yaml:"challenge" json:"challenge"`
+	Flavor    pwdb.ChallengeFlavor `yaml:"flavor" json:"flavor"`
+	// Seasons are the IDs or slugs of the seasons the flavor is registered in, defaults to the global season
+	Seasons []string `yaml:"seasons,omitempty" json:"seasons,omitempty"`
+	// Solver is used by `pathwar compose test` to check that the challenge is solvable
+	Solver *SolverConfig `yaml:"solver,omitempty" json:"solver,omitempty"`
+	// Pwinit configures how pwinit starts the services
+	Pwinit *PwinitOptions `yaml:"pwinit,omitempty" json:"pwinit,omitempty"`
+}
+
+// PwinitOptions is the x-pathwar.pwinit section of a compose file.
+//
+// The services start as root so that pwinit runs the on-init hook with full privileges, then pwinit switches to the
+// user of the service before executing the original entrypoint.
+type PwinitOptions struct {
+	// User is the user (name or uid) the services run as, defaults to the user of the service, then to the USER of the image
+	User string `yaml:"user,omitempty" json:"user,omitempty"`
+	// Group is the group (name or gid) the services run as, defaults to the primary group of the user
+	Group string `yaml:"group,omitempty" json:"group,omitempty"`
+	// SelfDestruct removes pwinit from the containers before starting the services, since they cannot start again, the
+	// containers are not restarted by docker and the agent recreates the instances whose containers exited
+	SelfDestruct bool `yaml:"self-destruct,omitempty" json:"self-destruct,omitempty"`
+	// Templates are the files rendered by pwinit before the on-init hook, by service, with the Go template syntax, i.e.,
+	// {{ passphrase 0 }}, {{ passphrase 0 | sha256 }} or {{ random "db-password" }}, see pwinit.InitConfig.TemplateFuncs
+	Templates map[string][]string `yaml:"templates,omitempty" json:"templates,omitempty"`
+	// KeepCapabilities allows the user to gain privileges again, i.e., with setuid binaries for privilege escalation challenges
+	KeepCapabilities bool `yaml:"keep-capabilities,omitempty" json:"keep-capabilities,omitempty"`
+}
+
+// SolverConfig is the x-pathwar.solver section of a compose file.
+//
+// The script runs in a container attached to the network of the challenge, with the URL of the targeted service in
+// the PATHWAR_URL environment variable, and should print the passphrases it found on its standard output. With
+// per-user passphrases, the script should send the PATHWAR_HOST environment variable as Host header, like the proxy.
+type SolverConfig struct {
+	// Script is the path of the solver script, relative to the challenge directory
+	Script string `yaml:"script" json:"script"`
+	// Image is the image used to run the script, defaults to an image with a shell and curl
+	Image string `yaml:"image,omitempty" json:"image,omitempty"`
+	// Service is the targeted service, defaults to the first service exposing a port
+	Service string `yaml:"service,omitempty" json:"service,omitempty"`
+	// Port is the targeted port, defaults to the first port exposed by the service
+	Port int `yaml:"port,omitempty" json:"port,omitempty"`
+	// Timeout is the maximum duration of the script, i.e., "2m", defaults to one minute
+	Timeout string `yaml:"timeout,omitempty" json:"timeout,omitempty"`
 }
 
 type network struct {
 	Driver, External string            `yaml:",omitempty"`
 	DriverOpts       map[string]string `yaml:"driver_opts,omitempty"`
+	Labels           map[string]string `yaml:",omitempty"`
 }
 
 type volume struct {
 	Driver, External string            `yaml:",omitempty"`
 	DriverOpts       map[string]string `yaml:"driver_opts,omitempty"`
+	Labels           map[string]string `yaml:",omitempty"`
 }
 
+// Service is a service of a compose file, it covers the options of the compose file format 3.7 supported by pathwar
 type Service struct {
-	ContainerName                             string            `yaml:"container_name,omitempty"`
-	Image                                     string            `yaml:",omitempty"`
-	Networks, Ports, Expose, Volumes, Command []string          `yaml:",omitempty"`
-	VolumesFrom                               []string          `yaml:"volumes_from,omitempty"`
-	DependsOn                                 []string          `yaml:"depends_on,omitempty"`
-	CapAdd                                    []string          `yaml:"cap_add,omitempty"`
-	Build                                     string            `yaml:",omitempty"`
-	Entrypoint                                []string          `yaml:",omitempty"`
-	Restart                                   string            `yaml:",omitempty"`
-	Environment                               map[string]string `yaml:",omitempty"`
-	Labels                                    map[string]string `yaml:"labels,omitempty"`
+	ContainerName   string            `yaml:"container_name,omitempty"`
+	Image           string            `yaml:",omitempty"`
+	Build           *ServiceBuild     `yaml:",omitempty"`
+	Command         ShellCommand      `yaml:",omitempty"`
+	Entrypoint      ShellCommand      `yaml:",omitempty"`
+	Environment     MappingWithEquals `yaml:",omitempty"`
+	EnvFile         StringOrList      `yaml:"env_file,omitempty"`
+	Ports           []ServicePort     `yaml:",omitempty"`
+	Expose          []string          `yaml:",omitempty"`
+	Networks        ServiceNetworks   `yaml:",omitempty"`
+	Volumes         []ServiceVolume   `yaml:",omitempty"`
+	VolumesFrom     []string          `yaml:"volumes_from,omitempty"`
+	Tmpfs           StringOrList      `yaml:",omitempty"`
+	DependsOn       []string          `yaml:"depends_on,omitempty"`
+	Links           []string          `yaml:",omitempty"`
+	ExtraHosts      []string          `yaml:"extra_hosts,omitempty"`
+	DNS             StringOrList      `yaml:"dns,omitempty"`
+	DNSSearch       StringOrList      `yaml:"dns_search,omitempty"`
+	Hostname        string            `yaml:",omitempty"`
+	Domainname      string            `yaml:",omitempty"`
+	User            string            `yaml:",omitempty"`
+	WorkingDir      string            `yaml:"working_dir,omitempty"`
+	ReadOnly        bool              `yaml:"read_only,omitempty"`
+	StdinOpen       bool              `yaml:"stdin_open,omitempty"`
+	Tty             bool              `yaml:",omitempty"`
+	Init            *bool             `yaml:",omitempty"`
+	CapAdd          []string          `yaml:"cap_add,omitempty"`
+	CapDrop         []string          `yaml:"cap_drop,omitempty"`
+	Sysctls         Mapping           `yaml:",omitempty"`
+	Ulimits         map[string]Ulimit `yaml:",omitempty"`
+	ShmSize         string            `yaml:"shm_size,omitempty"`
+	StopSignal      string            `yaml:"stop_signal,omitempty"`
+	StopGracePeriod string            `yaml:"stop_grace_period,omitempty"`
+	Healthcheck     *Healthcheck      `yaml:",omitempty"`
+	Logging         *Logging          `yaml:",omitempty"`
+	Restart         string            `yaml:",omitempty"`
+	Labels          Mapping           `yaml:"labels,omitempty"`
+	// Extensions are the other keys of the service, only "x-" extension fields are supported
+	Extensions map[string]interface{} `yaml:",inline" json:",omitempty"`
 }
 
 func (s Service) ChallengeID() string {
 	return fmt.Sprintf("%s@%s", s.Labels[challengeNameLabel], s.Labels[challengeVersionLabel])
 }
 
-type dabfile struct {
-	Services map[string]dabservice
-}
-
-type dabservice struct {
-	Image string
-}
-
 type ContainersInfo struct {
 	RunningFlavors    map[string]challengeFlavors
 	RunningContainers map[string]container
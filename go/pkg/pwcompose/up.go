@@ -11,10 +11,10 @@ import (
 	"os/exec"
 	"path"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/docker/docker/api/types"
-	"github.com/docker/docker/api/types/strslice"
 	"github.com/docker/docker/client"
 	"go.uber.org/zap"
 	"gopkg.in/yaml.v3"
@@ -27,9 +27,15 @@ type UpOpts struct {
 	PreparedCompose string
 	InstanceKey     string
 	ForceRecreate   bool
-	ProxyNetworkID  string
-	PwinitConfig    *pwinit.InitConfig
-	Logger          *zap.Logger
+	// Build rebuilds the images of the services built locally, even if they already exist
+	Build          bool
+	ProxyNetworkID string
+	PwinitConfig   *pwinit.InitConfig
+	// OnInit are the on-init hooks of the bundle, by service name, they replace the ones of the images
+	OnInit map[string][]byte
+	// Attachments are the files of the bundle copied in /pwinit/attachments/ of each service, for the hooks
+	Attachments map[string][]byte
+	Logger      *zap.Logger
 }
 
 func NewUpOpts() UpOpts {
@@ -76,22 +82,12 @@ func Up(ctx context.Context, cli *client.Client, opts UpOpts) (map[string]Servic
 		return nil, errcode.ErrComposeParseConfig.Wrap(err)
 	}
 
-	var challengeID string
-	// generate instanceIDs and set them as container_name
-	for name, service := range preparedComposeStruct.Services {
-		challengeName := service.Labels[challengeNameLabel]
-		serviceName := service.Labels[serviceNameLabel]
-		imageHash := "local"
-		if strings.Contains(service.Image, "@sha256:") {
-			imageHash = strings.Split(service.Image, "@sha256:")[1][:6]
-		}
-		service.ContainerName = fmt.Sprintf("%s.%s.%s.%s", challengeName, serviceName, imageHash, opts.InstanceKey)
-		service.Restart = "unless-stopped"
-		service.Labels[InstanceKeyLabel] = opts.InstanceKey
-		preparedComposeStruct.Services[name] = service
-		if challengeID == "" {
-			challengeID = service.ChallengeID()
-		}
+	challengeID := prepareInstance(&preparedComposeStruct, opts.InstanceKey)
+
+	// pin images by digest when they are already available locally
+	err = pinImages(ctx, cli, &preparedComposeStruct)
+	if err != nil {
+		return nil, errcode.ErrComposePinImages.Wrap(err)
 	}
 
 	// down containers if force recreate
@@ -129,6 +125,9 @@ func Up(ctx context.Context, cli *client.Client, opts UpOpts) (map[string]Servic
 
 	// create containers
 	args := append(composeCliCommonArgs(tmpPreparedComposePath), "up", "--no-start", "--quiet-pull")
+	if opts.Build {
+		args = append(args, "--build")
+	}
 	opts.Logger.Debug("docker-compose", zap.Strings("args", args))
 	cmd := exec.Command("docker-compose", args...)
 	if opts.Logger.Check(zap.DebugLevel, "") != nil {
@@ -146,6 +145,7 @@ func Up(ctx context.Context, cli *client.Client, opts UpOpts) (map[string]Servic
 	if err != nil {
 		return nil, errcode.ErrComposeGetContainersInfo.Wrap(err)
 	}
+	architectures := map[string]string{}
 	for _, container := range containersInfo.RunningContainers {
 		if challengeID == container.ChallengeID() {
 			// update entrypoints to run pwinit first
@@ -158,25 +158,10 @@ func Up(ctx context.Context, cli *client.Client, opts UpOpts) (map[string]Servic
 					continue
 				}
 				// find service from compose file of current container
-				entrypoint := []string{}
-				if len(imageInspect.Config.Entrypoint) > 0 {
-					entrypoint = imageInspect.Config.Entrypoint
-				}
-				if len(service.Entrypoint) > 0 {
-					entrypoint = service.Entrypoint
-				}
-				command := []string{}
-				if len(imageInspect.Config.Cmd) > 0 {
-					command = imageInspect.Config.Cmd
-				}
-				if len(service.Command) > 0 {
-					command = service.Command
-				}
-				service.Entrypoint = strslice.StrSlice{"/bin/pwinit", "entrypoint"}
-				newCommand := entrypoint
-				newCommand = append(newCommand, command...)
-				service.Command = newCommand
+				setPwinitEntrypoint(&service, imageInspect.Config.Entrypoint, imageInspect.Config.Cmd)
+				setPwinitUser(&service, imageInspect.Config.User, preparedComposeStruct.Pathwar.Pwinit)
 				preparedComposeStruct.Services[name] = service
+				architectures[name] = imageInspect.Architecture
 			}
 		}
 	}
@@ -216,14 +201,18 @@ func Up(ctx context.Context, cli *client.Client, opts UpOpts) (map[string]Servic
 		return nil, errcode.ErrComposeGetContainersInfo.Wrap(err)
 	}
 
+	if opts.PwinitConfig.Seed == "" {
+		opts.PwinitConfig.Seed = randstring.RandString(32)
+	}
 	for _, container := range containersInfo.RunningContainers {
 		if challengeID != container.ChallengeID() {
 			continue
 		}
 
-		buf, err := buildPWInitTar(*opts.PwinitConfig)
+		pwinitConfig := servicePwinitConfig(*opts.PwinitConfig, preparedComposeStruct.Pathwar.Pwinit, container.Labels[serviceNameLabel])
+		buf, err := buildPWInitTar(pwinitConfig, architectures[container.Labels[serviceNameLabel]], opts.serviceFiles(container.Labels[serviceNameLabel]))
 		if err != nil {
-			return nil, errcode.ErrCopyPWInitToContainer.Wrap(err)
+			return nil, errcode.ErrCopyPWInitToContainer.Wrap(fmt.Errorf("%s: %w", container.Labels[serviceNameLabel], err))
 		}
 		opts.Logger.Debug("copy pwinit into the container", zap.String("container-id", container.ID))
 		err = cli.CopyToContainer(ctx, container.ID, "/", buf, types.CopyToContainerOptions{})
@@ -265,6 +254,125 @@ func Up(ctx context.Context, cli *client.Client, opts UpOpts) (map[string]Servic
 	return preparedComposeStruct.Services, nil
 }
 
+// prepareInstance sets the container names, the restart policy and the instance labels of a prepared compose file,
+// it returns the challenge ID
+func prepareInstance(preparedComposeStruct *PathwarConfig, instanceKey string) string {
+	var (
+		challengeID   string
+		challengeName string
+	)
+	// a self-destructed pwinit cannot start the services again, their exited containers are recreated by the agent
+	restart := "unless-stopped"
+	if options := preparedComposeStruct.Pathwar.Pwinit; options != nil && options.SelfDestruct {
+		restart = "no"
+	}
+
+	// generate instanceIDs and set them as container_name
+	for name, service := range preparedComposeStruct.Services {
+		challengeName = service.Labels[challengeNameLabel]
+		serviceName := service.Labels[serviceNameLabel]
+		imageHash := "local"
+		if strings.Contains(service.Image, "@sha256:") {
+			imageHash = strings.Split(service.Image, "@sha256:")[1][:6]
+		}
+		service.ContainerName = fmt.Sprintf("%s.%s.%s.%s", challengeName, serviceName, imageHash, instanceKey)
+		service.Restart = restart
+		service.Labels[InstanceKeyLabel] = instanceKey
+		preparedComposeStruct.Services[name] = service
+		if challengeID == "" {
+			challengeID = service.ChallengeID()
+		}
+	}
+
+	// label named volumes to allow garbage collecting them
+	for name, volume := range preparedComposeStruct.Volumes {
+		if volume.External != "" {
+			continue
+		}
+		if volume.Labels == nil {
+			volume.Labels = map[string]string{}
+		}
+		volume.Labels[challengeNameLabel] = challengeName
+		volume.Labels[InstanceKeyLabel] = instanceKey
+		preparedComposeStruct.Volumes[name] = volume
+	}
+	return challengeID
+}
+
+// setPwinitEntrypoint makes pwinit the entrypoint of a service, followed by the original entrypoint and command
+func setPwinitEntrypoint(service *Service, imageEntrypoint []string, imageCmd []string) {
+	entrypoint := []string{}
+	if len(imageEntrypoint) > 0 {
+		entrypoint = imageEntrypoint
+	}
+	if len(service.Entrypoint) > 0 {
+		entrypoint = service.Entrypoint
+	}
+	command := []string{}
+	if len(imageCmd) > 0 {
+		command = imageCmd
+	}
+	if len(service.Command) > 0 {
+		command = service.Command
+	}
+	service.Entrypoint = ShellCommand{"/bin/pwinit", "entrypoint"}
+	newCommand := append([]string{}, entrypoint...)
+	newCommand = append(newCommand, command...)
+	service.Command = newCommand
+}
+
+// setPwinitUser makes a service start as root so that pwinit can run the on-init hook before switching to the user
+// declared in x-pathwar.pwinit, in the service, or in the image; it must be called after setPwinitEntrypoint
+func setPwinitUser(service *Service, imageUser string, options *PwinitOptions) {
+	if options == nil {
+		options = &PwinitOptions{}
+	}
+	user := imageUser
+	if service.User != "" {
+		user = service.User
+	}
+	if options.User != "" {
+		user = options.User
+	}
+
+	flags := []string{}
+	if !isRootUser(user) {
+		flags = append(flags, "--user="+user)
+		if options.Group != "" {
+			flags = append(flags, "--group="+options.Group)
+		}
+		if options.KeepCapabilities {
+			flags = append(flags, "--keep-capabilities")
+		}
+		service.User = "0:0"
+	}
+	if options.SelfDestruct {
+		flags = append(flags, "--self-destruct")
+	}
+	if len(flags) > 0 {
+		// the flags are passed on the command line to be kept when the container restarts
+		service.Entrypoint = append(service.Entrypoint, append(flags, "--")...)
+	}
+}
+
+// servicePwinitConfig returns the pwinit config of a service, with the templates declared for it in x-pathwar.pwinit
+func servicePwinitConfig(config pwinit.InitConfig, options *PwinitOptions, serviceName string) pwinit.InitConfig {
+	config.Templates = nil
+	if options != nil {
+		config.Templates = options.Templates[serviceName]
+	}
+	return config
+}
+
+// isRootUser returns true if a docker user spec ("user", "uid", "user:group", ...) is root or empty
+func isRootUser(user string) bool {
+	switch strings.SplitN(user, ":", 2)[0] {
+	case "", "0", "root":
+		return true
+	}
+	return false
+}
+
 func updateDockerComposeTempFile(preparedComposeStruct PathwarConfig, tmpPreparedComposePath string) error {
 	// create tmp docker-compose file
 	tmpData, err := yaml.Marshal(&preparedComposeStruct)
@@ -287,9 +395,23 @@ func updateDockerComposeTempFile(preparedComposeStruct PathwarConfig, tmpPrepare
 	return nil
 }
 
-func buildPWInitTar(config pwinit.InitConfig) (*bytes.Buffer, error) {
+// serviceFiles returns the files of the bundle injected in a service with pwinit, by absolute path
+func (opts UpOpts) serviceFiles(service string) map[string][]byte {
+	files := map[string][]byte{}
+	if onInit, found := opts.OnInit[service]; found {
+		files[pwinitOnInitPath] = onInit
+	}
+	for name, content := range opts.Attachments {
+		files[path.Join(pwinitAttachmentsDir, name)] = content
+	}
+	return files
+}
+
+// buildPWInitTar returns a tar with the pwinit binary matching the architecture of the image, its config and the
+// other files to inject
+func buildPWInitTar(config pwinit.InitConfig, arch string, files map[string][]byte) (*bytes.Buffer, error) {
 	var pwInitBuf []byte
-	pwInitBuf, err := pwinit.Binary()
+	pwInitBuf, err := pwinit.BinaryFor(arch)
 	if err != nil {
 		return nil, errcode.ErrGetPWInitBinary.Wrap(err)
 	}
@@ -318,9 +440,9 @@ func buildPWInitTar(config pwinit.InitConfig) (*bytes.Buffer, error) {
 	}
 	err = tw.WriteHeader(&tar.Header{
 		Name: "/pwinit/config.json",
-		Mode: 0755,
+		// only readable by the on-init hook, pwinit gives the /pwinit directory to the user of the service afterwards
+		Mode: 0600,
 		Size: int64(len(pwInitConfigJSON)),
-		// FIXME: chown it to container's default user
 	})
 	if err != nil {
 		return nil, errcode.ErrWritePWInitConfigFileHeader.Wrap(err)
@@ -330,6 +452,29 @@ func buildPWInitTar(config pwinit.InitConfig) (*bytes.Buffer, error) {
 		return nil, errcode.ErrWritePWInitConfigFile.Wrap(err)
 	}
 
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		mode := int64(0644)
+		if name == pwinitOnInitPath {
+			mode = 0755
+		}
+		err = tw.WriteHeader(&tar.Header{
+			Name: name,
+			Mode: mode,
+			Size: int64(len(files[name])),
+		})
+		if err != nil {
+			return nil, errcode.ErrWritePWInitFileHeader.Wrap(err)
+		}
+		if _, err = tw.Write(files[name]); err != nil {
+			return nil, errcode.ErrWritePWInitFile.Wrap(err)
+		}
+	}
+
 	if err = tw.Close(); err != nil {
 		return nil, errcode.ErrWritePWInitCloseTarWriter.Wrap(err)
 	}
@@ -0,0 +1,297 @@
+package pwcompose
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/client"
+	"go.uber.org/zap"
+	"gopkg.in/yaml.v3"
+	"pathwar.land/pathwar/v2/go/pkg/errcode"
+)
+
+type WatchOpts struct {
+	Up UpOpts
+	// Interval is the delay between two checks of the build directories
+	Interval time.Duration
+	// Stdout and Stderr receive the builds and the logs of the containers
+	Stdout io.Writer
+	Stderr io.Writer
+	Logger *zap.Logger
+}
+
+func NewWatchOpts() WatchOpts {
+	return WatchOpts{
+		Up:       NewUpOpts(),
+		Interval: time.Second,
+	}
+}
+
+func (opts *WatchOpts) applyDefaults() {
+	if opts.Logger == nil {
+		opts.Logger = zap.NewNop()
+	}
+	if opts.Up.Logger == nil {
+		opts.Up.Logger = opts.Logger
+	}
+	if opts.Interval == 0 {
+		opts.Interval = time.Second
+	}
+	if opts.Stdout == nil {
+		opts.Stdout = os.Stdout
+	}
+	if opts.Stderr == nil {
+		opts.Stderr = os.Stderr
+	}
+	// the passphrases are generated once, and reinjected in the rebuilt containers
+	opts.Up.applyDefaults()
+}
+
+// Watch starts a challenge prepared with --no-push, then rebuilds and restarts a service each time its build directory
+// changes, until the context is done.
+//
+// The changes of the compose file itself are not watched.
+// nolint:gocyclo
+func Watch(ctx context.Context, cli *client.Client, opts WatchOpts) error {
+	opts.applyDefaults()
+	logger := opts.Logger
+	logger.Debug("watch", zap.Any("opts", opts))
+
+	config := PathwarConfig{}
+	if err := yaml.Unmarshal([]byte(opts.Up.PreparedCompose), &config); err != nil {
+		return errcode.ErrComposeParseConfig.Wrap(err)
+	}
+
+	// services by build directory
+	buildDirs := map[string][]string{}
+	for name, service := range config.Services {
+		if service.Build == nil || !filepath.IsAbs(service.Build.Context) {
+			continue
+		}
+		buildDirs[service.Build.Context] = append(buildDirs[service.Build.Context], name)
+	}
+	if len(buildDirs) == 0 {
+		return errcode.ErrComposeWatch.Wrap(fmt.Errorf("no service is built locally, the challenge should be prepared with --no-push"))
+	}
+
+	snapshots := map[string]string{}
+	for dir := range buildDirs {
+		snapshot, err := snapshotDir(dir)
+		if err != nil {
+			return errcode.ErrComposeWatch.Wrap(err)
+		}
+		snapshots[dir] = snapshot
+	}
+
+	services, err := Up(ctx, cli, opts.Up)
+	if err != nil {
+		return err
+	}
+
+	// keep the instance as started by Up, and the services before the pwinit entrypoint was set
+	instance := config
+	challengeID := prepareInstance(&instance, opts.Up.InstanceKey)
+	original := map[string]Service{}
+	for name, service := range instance.Services {
+		original[name] = service
+	}
+	instance.Services = services
+
+	tmpDir, err := ioutil.TempDir("", "pwcompose")
+	if err != nil {
+		return errcode.ErrComposeCreateTempDir.Wrap(err)
+	}
+	defer func() {
+		if err = os.RemoveAll(tmpDir); err != nil {
+			logger.Warn("rm tmp dir", zap.Error(err))
+		}
+	}()
+	tmpComposePath := filepath.Join(tmpDir, challengeID, "docker-compose.yml") // same project name as Up
+	if err = os.MkdirAll(path.Dir(tmpComposePath), os.ModePerm); err != nil {
+		return errcode.ErrComposeCreateTempDir.Wrap(err)
+	}
+
+	containers, err := instanceContainers(ctx, cli, challengeID, opts.Up.InstanceKey)
+	if err != nil {
+		return err
+	}
+	for _, c := range containers {
+		go followLogs(ctx, cli, c.ID, opts)
+	}
+	logger.Info("watching the build directories", zap.String("challenge", challengeID), zap.Int("services", len(services)))
+
+	ticker := time.NewTicker(opts.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		dirs := make([]string, 0, len(buildDirs))
+		for dir := range buildDirs {
+			dirs = append(dirs, dir)
+		}
+		sort.Strings(dirs)
+		for _, dir := range dirs {
+			snapshot, err := snapshotDir(dir)
+			if err != nil {
+				logger.Warn("snapshot build directory", zap.String("dir", dir), zap.Error(err))
+				continue
+			}
+			if snapshot == snapshots[dir] {
+				continue
+			}
+			snapshots[dir] = snapshot
+
+			for _, name := range buildDirs[dir] {
+				before := time.Now()
+				logger.Info("change detected, rebuilding", zap.String("service", name))
+				containerID, err := recreateService(ctx, cli, &instance, original[name], name, tmpComposePath, opts)
+				if err != nil {
+					// keep watching, the next change may fix the build
+					logger.Error("rebuild failed", zap.String("service", name), zap.Error(err))
+					continue
+				}
+				logger.Info("service restarted", zap.String("service", name), zap.Duration("duration", time.Since(before)))
+				go followLogs(ctx, cli, containerID, opts)
+			}
+		}
+	}
+}
+
+// recreateService rebuilds the image of a service, and recreates its container with pwinit and the same passphrases
+func recreateService(ctx context.Context, cli *client.Client, instance *PathwarConfig, service Service, name string, tmpComposePath string, opts WatchOpts) (string, error) {
+	challengeID := service.ChallengeID()
+
+	// build the image and create the container without pwinit
+	instance.Services[name] = service
+	if err := updateDockerComposeTempFile(*instance, tmpComposePath); err != nil {
+		return "", errcode.ErrComposeUpdateTempFile.Wrap(err)
+	}
+	if err := runCompose(tmpComposePath, opts, "up", "--no-start", "--no-deps", "--build", name); err != nil {
+		return "", errcode.ErrComposeBuild.Wrap(err)
+	}
+
+	// recreate the container with the pwinit entrypoint
+	c, err := serviceContainer(ctx, cli, challengeID, opts.Up.InstanceKey, name)
+	if err != nil {
+		return "", err
+	}
+	imageInspect, _, err := cli.ImageInspectWithRaw(ctx, c.ImageID)
+	if err != nil {
+		return "", errcode.ErrDockerAPIImageInspect.Wrap(err)
+	}
+	setPwinitEntrypoint(&service, imageInspect.Config.Entrypoint, imageInspect.Config.Cmd)
+	setPwinitUser(&service, imageInspect.Config.User, instance.Pathwar.Pwinit)
+	instance.Services[name] = service
+	if err := updateDockerComposeTempFile(*instance, tmpComposePath); err != nil {
+		return "", errcode.ErrComposeUpdateTempFile.Wrap(err)
+	}
+	if err := runCompose(tmpComposePath, opts, "up", "--no-start", "--no-deps", name); err != nil {
+		return "", errcode.ErrComposeRunCreate.Wrap(err)
+	}
+
+	// reinject pwinit and start
+	c, err = serviceContainer(ctx, cli, challengeID, opts.Up.InstanceKey, name)
+	if err != nil {
+		return "", err
+	}
+	buf, err := buildPWInitTar(servicePwinitConfig(*opts.Up.PwinitConfig, instance.Pathwar.Pwinit, name), imageInspect.Architecture, opts.Up.serviceFiles(name))
+	if err != nil {
+		return "", errcode.ErrCopyPWInitToContainer.Wrap(err)
+	}
+	if err := cli.CopyToContainer(ctx, c.ID, "/", buf, types.CopyToContainerOptions{}); err != nil {
+		return "", errcode.ErrCopyPWInitToContainer.Wrap(err)
+	}
+	if err := runCompose(tmpComposePath, opts, "up", "-d", "--no-deps", name); err != nil {
+		return "", errcode.ErrComposeRunUp.Wrap(err)
+	}
+	if opts.Up.ProxyNetworkID != "" && c.NeedsNginxProxy() {
+		if err := cli.NetworkConnect(ctx, opts.Up.ProxyNetworkID, c.ID, nil); err != nil {
+			return "", errcode.ErrContainerConnectNetwork.Wrap(err)
+		}
+	}
+	return c.ID, nil
+}
+
+func runCompose(composePath string, opts WatchOpts, args ...string) error {
+	args = append(composeCliCommonArgs(composePath), args...)
+	opts.Logger.Debug("docker-compose", zap.Strings("args", args))
+	cmd := exec.Command("docker-compose", args...)
+	cmd.Stdout = opts.Stdout
+	cmd.Stderr = opts.Stderr
+	return cmd.Run()
+}
+
+func followLogs(ctx context.Context, cli *client.Client, containerID string, opts WatchOpts) {
+	logsOpts := NewLogsOpts()
+	logsOpts.ID = containerID
+	logsOpts.Follow = true
+	logsOpts.Prefix = true
+	logsOpts.Stdout = opts.Stdout
+	logsOpts.Stderr = opts.Stderr
+	logsOpts.Logger = opts.Logger
+	if err := Logs(ctx, cli, logsOpts); err != nil && ctx.Err() == nil {
+		opts.Logger.Debug("follow logs", zap.String("container", containerID), zap.Error(err))
+	}
+}
+
+// instanceContainers returns the containers of an instance
+func instanceContainers(ctx context.Context, cli *client.Client, challengeID string, instanceKey string) ([]container, error) {
+	containersInfo, err := GetContainersInfo(ctx, cli)
+	if err != nil {
+		return nil, errcode.ErrComposeGetContainersInfo.Wrap(err)
+	}
+	containers := []container{}
+	for _, c := range findContainers(containersInfo, challengeID, "") {
+		if c.Labels[InstanceKeyLabel] == instanceKey {
+			containers = append(containers, c)
+		}
+	}
+	return containers, nil
+}
+
+func serviceContainer(ctx context.Context, cli *client.Client, challengeID string, instanceKey string, name string) (container, error) {
+	containers, err := instanceContainers(ctx, cli, challengeID, instanceKey)
+	if err != nil {
+		return container{}, err
+	}
+	for _, c := range containers {
+		if c.Labels[serviceNameLabel] == name {
+			return c, nil
+		}
+	}
+	return container{}, errcode.ErrComposeNoSuchContainer.Wrap(fmt.Errorf("no container for service %q", name))
+}
+
+// snapshotDir returns a hash of the paths, sizes and modification times of the files of a directory
+func snapshotDir(dir string) (string, error) {
+	hasher := sha256.New()
+	err := filepath.Walk(dir, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() && info.Name() == ".git" {
+			return filepath.SkipDir
+		}
+		fmt.Fprintf(hasher, "%s %d %d %s\n", filePath, info.Size(), info.ModTime().UnixNano(), info.Mode())
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hasher.Sum(nil)), nil
+}
@@ -0,0 +1,50 @@
+package pwcompose
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapshotDir(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pwcompose")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "Dockerfile"), []byte("FROM alpine"), 0644))
+	first, err := snapshotDir(dir)
+	require.NoError(t, err)
+	same, err := snapshotDir(dir)
+	require.NoError(t, err)
+	assert.Equal(t, first, same)
+
+	// changes in .git are ignored
+	require.NoError(t, os.Mkdir(filepath.Join(dir, ".git"), 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, ".git", "HEAD"), []byte("ref"), 0644))
+	withGit, err := snapshotDir(dir)
+	require.NoError(t, err)
+	assert.NotEqual(t, first, withGit) // creating .git changes the modification time of the directory
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, ".git", "HEAD"), []byte("other ref"), 0644))
+	gitChanged, err := snapshotDir(dir)
+	require.NoError(t, err)
+	assert.Equal(t, withGit, gitChanged)
+
+	// content changes are detected
+	later := time.Now().Add(time.Minute)
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "Dockerfile"), []byte("FROM debian"), 0644))
+	require.NoError(t, os.Chtimes(filepath.Join(dir, "Dockerfile"), later, later))
+	changed, err := snapshotDir(dir)
+	require.NoError(t, err)
+	assert.NotEqual(t, withGit, changed)
+
+	// new files are detected
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "index.html"), []byte(""), 0644))
+	added, err := snapshotDir(dir)
+	require.NoError(t, err)
+	assert.NotEqual(t, changed, added)
+}
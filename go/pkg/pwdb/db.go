@@ -20,6 +20,7 @@ func Configure(db *gorm.DB, sfn *snowflake.Node, opts Opts) (*gorm.DB, error) {
 	// db.Callback().Create().Remove("gorm:update_time_stamp")
 	// db.Callback().Update().Remove("gorm:update_time_stamp")
 	db.Callback().Create().Before("gorm:create").Register("pathwar_before_create", beforeCreate(sfn))
+	db = db.Set(snowflakeKey, sfn)
 	db = db.Set("gorm:auto_preload", false)
 	db = db.Set("gorm:association_autoupdate", false)
 	db.BlockGlobalUpdate(true)
@@ -31,6 +32,18 @@ func Configure(db *gorm.DB, sfn *snowflake.Node, opts Opts) (*gorm.DB, error) {
 	return db, nil
 }
 
+// snowflakeKey stores the id generator in the db, for the inserts skipping the create callbacks
+const snowflakeKey = "pathwar:snowflake"
+
+// NewID generates an id like the ones of the created entities, for the raw inserts
+func NewID(db *gorm.DB) (int64, error) {
+	value, ok := db.Get(snowflakeKey)
+	if !ok {
+		return 0, errcode.ErrInitSnowflake
+	}
+	return value.(*snowflake.Node).Generate().Int64(), nil
+}
+
 func beforeCreate(sfn *snowflake.Node) func(*gorm.Scope) {
 	return func(scope *gorm.Scope) {
 		id := sfn.Generate().Int64()
@@ -290,6 +290,11 @@ func GetIDBySlugAndKind(db *gorm.DB, slug string, kind string) (int64, error) {
 			Model(ChallengeFlavor{}).
 			Where("id = ? OR slug = ? OR slug = ?", slug, slug, slug+"@default").
 			Pluck("id", &ids).Error
+	case "agent":
+		err = db.
+			Model(Agent{}).
+			Where("id = ? OR slug = ? OR name = ?", slug, slug, slug).
+			Pluck("id", &ids).Error
 	case "challenge-instance":
 		err = db.
 			Model(ChallengeInstance{}).
@@ -9,7 +9,28 @@ import (
 func migrate(db *gorm.DB, opts Opts) error {
 	migrateOpts := gormigrate.DefaultOptions
 	migrateOpts.UseTransaction = true
-	m := gormigrate.New(db, migrateOpts, []*gormigrate.Migration{})
+	m := gormigrate.New(db, migrateOpts, []*gormigrate.Migration{
+		{
+			// the signed compose bundles do not fit in the former varchar(100000) column
+			ID: "challenge_flavor_compose_bundle_longtext",
+			Migrate: func(tx *gorm.DB) error {
+				if tx.Dialect().GetName() != "mysql" {
+					return nil
+				}
+				return tx.Model(&ChallengeFlavor{}).ModifyColumn("compose_bundle", "longtext").Error
+			},
+		},
+		{
+			// the achievements granted by the activities or the time are not bound to a validation
+			ID: "achievement_nullable_challenge_validation_id",
+			Migrate: func(tx *gorm.DB) error {
+				if tx.Dialect().GetName() != "mysql" {
+					return nil
+				}
+				return tx.Model(&Achievement{}).ModifyColumn("challenge_validation_id", "bigint NULL").Error
+			},
+		},
+	})
 
 	// only called on fresh database
 	m.InitSchema(func(tx *gorm.DB) error {
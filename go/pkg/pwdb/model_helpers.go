@@ -3,6 +3,7 @@ package pwdb
 import (
 	"encoding/json"
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/martinlindhe/base36"
@@ -31,6 +32,15 @@ func (cf ChallengeFlavor) NameAndVersion() string {
 	return fmt.Sprintf("%s@%s", cf.Challenge.Name, cf.Version)
 }
 
+// PassphrasesCount returns the number of passphrases of the flavor, a flavor declaring none has one, like when it is
+// registered by the API
+func (cf ChallengeFlavor) PassphrasesCount() int {
+	if cf.Passphrases <= 0 {
+		return 1
+	}
+	return int(cf.Passphrases)
+}
+
 func (instance *ChallengeInstance) ParseInstanceConfig() (*pwinit.InitConfig, error) {
 	var configData pwinit.InitConfig
 	err := json.Unmarshal(instance.GetInstanceConfig(), &configData)
@@ -40,6 +50,52 @@ func (instance *ChallengeInstance) ParseInstanceConfig() (*pwinit.InitConfig, er
 	return &configData, nil
 }
 
+// ParseProxyPolicy returns the flavor's proxy policy, or nil if none is configured.
+func (cf *ChallengeFlavor) ParseProxyPolicy() (*ChallengeFlavor_ProxyPolicy, error) {
+	if cf.GetProxyPolicyConfig() == "" {
+		return nil, nil
+	}
+	var policy ChallengeFlavor_ProxyPolicy
+	err := json.Unmarshal([]byte(cf.GetProxyPolicyConfig()), &policy)
+	if err != nil {
+		return nil, errcode.ErrParseProxyPolicy.Wrap(err)
+	}
+	return &policy, nil
+}
+
+var (
+	nginxSizeRegex       = regexp.MustCompile(`^[0-9]+[kKmMgG]?$`)
+	nginxDurationRegex   = regexp.MustCompile(`^[0-9]+(ms|s|m|h)?$`)
+	nginxHeaderNameRegex = regexp.MustCompile(`^[a-zA-Z0-9-]+$`)
+)
+
+// Validate ensures that the policy can be safely injected in an nginx configuration.
+func (p *ChallengeFlavor_ProxyPolicy) Validate() error {
+	if p == nil {
+		return nil
+	}
+	if p.ClientMaxBodySize != "" && !nginxSizeRegex.MatchString(p.ClientMaxBodySize) {
+		return errcode.ErrInvalidProxyPolicy.Wrap(fmt.Errorf("invalid client_max_body_size: %q", p.ClientMaxBodySize))
+	}
+	for _, timeout := range []string{p.ConnectTimeout, p.SendTimeout, p.ReadTimeout} {
+		if timeout != "" && !nginxDurationRegex.MatchString(timeout) {
+			return errcode.ErrInvalidProxyPolicy.Wrap(fmt.Errorf("invalid timeout: %q", timeout))
+		}
+	}
+	if p.RateLimitBurst < 0 {
+		return errcode.ErrInvalidProxyPolicy.Wrap(fmt.Errorf("invalid rate_limit_burst: %d", p.RateLimitBurst))
+	}
+	for key, value := range p.Headers {
+		if !nginxHeaderNameRegex.MatchString(key) {
+			return errcode.ErrInvalidProxyPolicy.Wrap(fmt.Errorf("invalid header name: %q", key))
+		}
+		if strings.ContainsAny(value, "\"\\;{}\r\n") {
+			return errcode.ErrInvalidProxyPolicy.Wrap(fmt.Errorf("invalid header value: %q", value))
+		}
+	}
+	return nil
+}
+
 func ChallengeInstancePrefixHash(instanceID string, userID int64, salt string) (string, error) {
 	stringToHash := fmt.Sprintf("%s%d%s", instanceID, userID, salt)
 	hashBytes := make([]byte, 8)
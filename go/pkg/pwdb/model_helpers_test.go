@@ -0,0 +1,46 @@
+package pwdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pathwar.land/pathwar/v2/go/pkg/errcode"
+)
+
+func TestChallengeFlavor_ProxyPolicy_Validate(t *testing.T) {
+	tests := []struct {
+		name        string
+		policy      *ChallengeFlavor_ProxyPolicy
+		expectedErr error
+	}{
+		{"nil", nil, nil},
+		{"empty", &ChallengeFlavor_ProxyPolicy{}, nil},
+		{"valid", &ChallengeFlavor_ProxyPolicy{
+			ClientMaxBodySize: "100m",
+			ConnectTimeout:    "5s",
+			SendTimeout:       "500ms",
+			ReadTimeout:       "300",
+			Headers:           map[string]string{"X-Frame-Options": "DENY", "Cache-Control": "no-store, max-age=0"},
+			RateLimit:         -1,
+			RateLimitBurst:    20,
+		}, nil},
+		{"invalid-body-size", &ChallengeFlavor_ProxyPolicy{ClientMaxBodySize: "10 MB"}, errcode.ErrInvalidProxyPolicy},
+		{"invalid-connect-timeout", &ChallengeFlavor_ProxyPolicy{ConnectTimeout: "1d"}, errcode.ErrInvalidProxyPolicy},
+		{"invalid-send-timeout", &ChallengeFlavor_ProxyPolicy{SendTimeout: "-1s"}, errcode.ErrInvalidProxyPolicy},
+		{"invalid-read-timeout", &ChallengeFlavor_ProxyPolicy{ReadTimeout: "90s;"}, errcode.ErrInvalidProxyPolicy},
+		{"negative-burst", &ChallengeFlavor_ProxyPolicy{RateLimitBurst: -1}, errcode.ErrInvalidProxyPolicy},
+		{"invalid-header-name", &ChallengeFlavor_ProxyPolicy{Headers: map[string]string{"X Foo": "bar"}}, errcode.ErrInvalidProxyPolicy},
+		{"header-value-injection", &ChallengeFlavor_ProxyPolicy{Headers: map[string]string{"X-Foo": "bar\"; return 200"}}, errcode.ErrInvalidProxyPolicy},
+		{"header-value-newline", &ChallengeFlavor_ProxyPolicy{Headers: map[string]string{"X-Foo": "bar\nX-Bar: baz"}}, errcode.ErrInvalidProxyPolicy},
+	}
+	for _, test := range tests {
+		err := test.policy.Validate()
+		assert.Equalf(t, errcode.Code(test.expectedErr), errcode.Code(err), "%s: %v", test.name, err)
+	}
+}
+
+func TestChallengeFlavor_PassphrasesCount(t *testing.T) {
+	assert.Equal(t, 1, ChallengeFlavor{}.PassphrasesCount())
+	assert.Equal(t, 1, ChallengeFlavor{Passphrases: 1}.PassphrasesCount())
+	assert.Equal(t, 4, ChallengeFlavor{Passphrases: 4}.PassphrasesCount())
+}
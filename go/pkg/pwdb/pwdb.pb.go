@@ -96,6 +96,9 @@ const (
 	ChallengeInstance_Available       ChallengeInstance_Status = 3
 	ChallengeInstance_NeedRedump      ChallengeInstance_Status = 4
 	ChallengeInstance_Disabled        ChallengeInstance_Status = 5
+	ChallengeInstance_StartupFailed   ChallengeInstance_Status = 6
+	ChallengeInstance_NeedReset       ChallengeInstance_Status = 7
+	ChallengeInstance_Validated       ChallengeInstance_Status = 8
 )
 
 var ChallengeInstance_Status_name = map[int32]string{
@@ -105,6 +108,9 @@ var ChallengeInstance_Status_name = map[int32]string{
 	3: "Available",
 	4: "NeedRedump",
 	5: "Disabled",
+	6: "StartupFailed",
+	7: "NeedReset",
+	8: "Validated",
 }
 
 var ChallengeInstance_Status_value = map[string]int32{
@@ -114,6 +120,9 @@ var ChallengeInstance_Status_value = map[string]int32{
 	"Available":       3,
 	"NeedRedump":      4,
 	"Disabled":        5,
+	"StartupFailed":   6,
+	"NeedReset":       7,
+	"Validated":       8,
 }
 
 func (x ChallengeInstance_Status) String() string {
@@ -132,6 +141,7 @@ const (
 	Agent_Inactive Agent_Status = 2
 	Agent_Timeout  Agent_Status = 3
 	Agent_Error    Agent_Status = 4
+	Agent_Draining Agent_Status = 5
 )
 
 var Agent_Status_name = map[int32]string{
@@ -140,6 +150,7 @@ var Agent_Status_name = map[int32]string{
 	2: "Inactive",
 	3: "Timeout",
 	4: "Error",
+	5: "Draining",
 }
 
 var Agent_Status_value = map[string]int32{
@@ -148,6 +159,7 @@ var Agent_Status_value = map[string]int32{
 	"Inactive": 2,
 	"Timeout":  3,
 	"Error":    4,
+	"Draining": 5,
 }
 
 func (x Agent_Status) String() string {
@@ -335,6 +347,37 @@ func (ChallengeValidation_Status) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_debbe06253822cef, []int{13, 0}
 }
 
+type ChallengeValidation_Medal int32
+
+const (
+	ChallengeValidation_NoMedal     ChallengeValidation_Medal = 0
+	ChallengeValidation_GoldMedal   ChallengeValidation_Medal = 1
+	ChallengeValidation_SilverMedal ChallengeValidation_Medal = 2
+	ChallengeValidation_BronzeMedal ChallengeValidation_Medal = 3
+)
+
+var ChallengeValidation_Medal_name = map[int32]string{
+	0: "NoMedal",
+	1: "GoldMedal",
+	2: "SilverMedal",
+	3: "BronzeMedal",
+}
+
+var ChallengeValidation_Medal_value = map[string]int32{
+	"NoMedal":     0,
+	"GoldMedal":   1,
+	"SilverMedal": 2,
+	"BronzeMedal": 3,
+}
+
+func (x ChallengeValidation_Medal) String() string {
+	return proto.EnumName(ChallengeValidation_Medal_name, int32(x))
+}
+
+func (ChallengeValidation_Medal) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_debbe06253822cef, []int{13, 1}
+}
+
 type ChallengeSubscription_Status int32
 
 const (
@@ -509,20 +552,23 @@ func (Achievement_Type) EnumDescriptor() ([]byte, []int) {
 type Activity_Kind int32
 
 const (
-	Activity_Unknown                       Activity_Kind = 0
-	Activity_UserRegister                  Activity_Kind = 1
-	Activity_UserLogin                     Activity_Kind = 2
-	Activity_UserSetPreferences            Activity_Kind = 3
-	Activity_UserDeleteAccount             Activity_Kind = 4
-	Activity_SeasonChallengeBuy            Activity_Kind = 5
-	Activity_ChallengeSubscriptionValidate Activity_Kind = 6
-	Activity_CouponValidate                Activity_Kind = 7
-	Activity_AgentRegister                 Activity_Kind = 8
-	Activity_AgentChallengeInstanceCreate  Activity_Kind = 9
-	Activity_AgentChallengeInstanceUpdate  Activity_Kind = 10
-	Activity_TeamCreation                  Activity_Kind = 11
-	Activity_TeamInviteSend                Activity_Kind = 12
-	Activity_TeamInviteAccept              Activity_Kind = 13
+	Activity_Unknown                        Activity_Kind = 0
+	Activity_UserRegister                   Activity_Kind = 1
+	Activity_UserLogin                      Activity_Kind = 2
+	Activity_UserSetPreferences             Activity_Kind = 3
+	Activity_UserDeleteAccount              Activity_Kind = 4
+	Activity_SeasonChallengeBuy             Activity_Kind = 5
+	Activity_ChallengeSubscriptionValidate  Activity_Kind = 6
+	Activity_CouponValidate                 Activity_Kind = 7
+	Activity_AgentRegister                  Activity_Kind = 8
+	Activity_AgentChallengeInstanceCreate   Activity_Kind = 9
+	Activity_AgentChallengeInstanceUpdate   Activity_Kind = 10
+	Activity_TeamCreation                   Activity_Kind = 11
+	Activity_TeamInviteSend                 Activity_Kind = 12
+	Activity_TeamInviteAccept               Activity_Kind = 13
+	Activity_AgentChallengeInstanceThrottle Activity_Kind = 14
+	Activity_AgentDrain                     Activity_Kind = 15
+	Activity_TeamScoreChange                Activity_Kind = 16
 )
 
 var Activity_Kind_name = map[int32]string{
@@ -540,23 +586,29 @@ var Activity_Kind_name = map[int32]string{
 	11: "TeamCreation",
 	12: "TeamInviteSend",
 	13: "TeamInviteAccept",
+	14: "AgentChallengeInstanceThrottle",
+	15: "AgentDrain",
+	16: "TeamScoreChange",
 }
 
 var Activity_Kind_value = map[string]int32{
-	"Unknown":                       0,
-	"UserRegister":                  1,
-	"UserLogin":                     2,
-	"UserSetPreferences":            3,
-	"UserDeleteAccount":             4,
-	"SeasonChallengeBuy":            5,
-	"ChallengeSubscriptionValidate": 6,
-	"CouponValidate":                7,
-	"AgentRegister":                 8,
-	"AgentChallengeInstanceCreate":  9,
-	"AgentChallengeInstanceUpdate":  10,
-	"TeamCreation":                  11,
-	"TeamInviteSend":                12,
-	"TeamInviteAccept":              13,
+	"Unknown":                        0,
+	"UserRegister":                   1,
+	"UserLogin":                      2,
+	"UserSetPreferences":             3,
+	"UserDeleteAccount":              4,
+	"SeasonChallengeBuy":             5,
+	"ChallengeSubscriptionValidate":  6,
+	"CouponValidate":                 7,
+	"AgentRegister":                  8,
+	"AgentChallengeInstanceCreate":   9,
+	"AgentChallengeInstanceUpdate":   10,
+	"TeamCreation":                   11,
+	"TeamInviteSend":                 12,
+	"TeamInviteAccept":               13,
+	"AgentChallengeInstanceThrottle": 14,
+	"AgentDrain":                     15,
+	"TeamScoreChange":                16,
 }
 
 func (x Activity_Kind) String() string {
@@ -700,12 +752,13 @@ func (m *Challenge) GetFlavors() []*ChallengeFlavor {
 }
 
 type ChallengeFlavor struct {
-	ID                 int64                           `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" gorm:"primary_key" yaml:"id,omitempty"`
-	CreatedAt          *time.Time                      `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at,omitempty" yaml:"created_at,omitempty"`
-	UpdatedAt          *time.Time                      `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3,stdtime" json:"updated_at,omitempty" yaml:"updated_at,omitempty"`
-	Version            string                          `protobuf:"bytes,100,opt,name=version,proto3" json:"version,omitempty"`
-	SourceURL          string                          `protobuf:"bytes,104,opt,name=source_url,json=sourceUrl,proto3" json:"source_url,omitempty"`
-	ComposeBundle      string                          `protobuf:"bytes,105,opt,name=compose_bundle,json=composeBundle,proto3" json:"compose_bundle,omitempty" gorm:"size:100000"`
+	ID        int64      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" gorm:"primary_key" yaml:"id,omitempty"`
+	CreatedAt *time.Time `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at,omitempty" yaml:"created_at,omitempty"`
+	UpdatedAt *time.Time `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3,stdtime" json:"updated_at,omitempty" yaml:"updated_at,omitempty"`
+	Version   string     `protobuf:"bytes,100,opt,name=version,proto3" json:"version,omitempty"`
+	SourceURL string     `protobuf:"bytes,104,opt,name=source_url,json=sourceUrl,proto3" json:"source_url,omitempty"`
+	// signed bundles embed a base64 archive, so they can be larger than the 64k of a text column
+	ComposeBundle      string                          `protobuf:"bytes,105,opt,name=compose_bundle,json=composeBundle,proto3" json:"compose_bundle,omitempty" gorm:"type:longtext"`
 	Driver             ChallengeFlavor_Driver          `protobuf:"varint,106,opt,name=driver,proto3,enum=pathwar.db.ChallengeFlavor_Driver" json:"driver,omitempty" yaml:"-"`
 	PurchasePrice      int64                           `protobuf:"varint,107,opt,name=purchase_price,json=purchasePrice,proto3" json:"purchase_price,omitempty" yaml:"purchase_price"`
 	ValidationReward   int64                           `protobuf:"varint,108,opt,name=validation_reward,json=validationReward,proto3" json:"validation_reward,omitempty" yaml:"validation_reward"`
@@ -717,10 +770,16 @@ type ChallengeFlavor struct {
 	TagList            string                          `protobuf:"bytes,114,opt,name=tag_list,json=tagList,proto3" json:"tag_list,omitempty" yaml:"-"`
 	RedumpPolicy       []*ChallengeFlavor_RedumpPolicy `protobuf:"bytes,115,rep,name=redump_policy,json=redumpPolicy,proto3" json:"redump_policy,omitempty" gorm:"-" yaml:"redump-policy"`
 	RedumpPolicyConfig string                          `protobuf:"bytes,116,opt,name=redump_policy_config,json=redumpPolicyConfig,proto3" json:"redump_policy_config,omitempty" yaml:"-"`
-	Challenge          *Challenge                      `protobuf:"bytes,200,opt,name=challenge,proto3" json:"challenge,omitempty" gorm:"foreignkey:ChallengeID" yaml:"challenge,omitempty"`
-	ChallengeID        int64                           `protobuf:"varint,201,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty" sql:"not null" gorm:"index" yaml:"challenge_id,omitempty"`
-	SeasonChallenges   []*SeasonChallenge              `protobuf:"bytes,202,rep,name=season_challenges,json=seasonChallenges,proto3" json:"season_challenges,omitempty" gorm:"PRELOAD:false;foreignkey:FlavorID" yaml:"season_challenges,omitempty"`
-	Instances          []*ChallengeInstance            `protobuf:"bytes,203,rep,name=instances,proto3" json:"instances,omitempty" gorm:"PRELOAD:false;foreignkey:FlavorID" yaml:"instances,omitempty"`
+	ProxyPolicy        *ChallengeFlavor_ProxyPolicy    `protobuf:"bytes,117,opt,name=proxy_policy,json=proxyPolicy,proto3" json:"proxy_policy,omitempty" gorm:"-" yaml:"proxy-policy,omitempty"`
+	ProxyPolicyConfig  string                          `protobuf:"bytes,118,opt,name=proxy_policy_config,json=proxyPolicyConfig,proto3" json:"proxy_policy_config,omitempty" yaml:"-"`
+	// each player gets its own passphrases, derived from a secret of the instance and the player's prefix hash
+	PerUserPassphrases bool `protobuf:"varint,119,opt,name=per_user_passphrases,json=perUserPassphrases,proto3" json:"per_user_passphrases,omitempty" yaml:"per-user-passphrases,omitempty"`
+	// points added to the score of the teams validating the flavor, defaults to the validation reward
+	Points           int64                `protobuf:"varint,120,opt,name=points,proto3" json:"points,omitempty" yaml:"points,omitempty"`
+	Challenge        *Challenge           `protobuf:"bytes,200,opt,name=challenge,proto3" json:"challenge,omitempty" gorm:"foreignkey:ChallengeID" yaml:"challenge,omitempty"`
+	ChallengeID      int64                `protobuf:"varint,201,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty" sql:"not null" gorm:"index" yaml:"challenge_id,omitempty"`
+	SeasonChallenges []*SeasonChallenge   `protobuf:"bytes,202,rep,name=season_challenges,json=seasonChallenges,proto3" json:"season_challenges,omitempty" gorm:"PRELOAD:false;foreignkey:FlavorID" yaml:"season_challenges,omitempty"`
+	Instances        []*ChallengeInstance `protobuf:"bytes,203,rep,name=instances,proto3" json:"instances,omitempty" gorm:"PRELOAD:false;foreignkey:FlavorID" yaml:"instances,omitempty"`
 }
 
 func (m *ChallengeFlavor) Reset()         { *m = ChallengeFlavor{} }
@@ -875,6 +934,34 @@ func (m *ChallengeFlavor) GetRedumpPolicyConfig() string {
 	return ""
 }
 
+func (m *ChallengeFlavor) GetProxyPolicy() *ChallengeFlavor_ProxyPolicy {
+	if m != nil {
+		return m.ProxyPolicy
+	}
+	return nil
+}
+
+func (m *ChallengeFlavor) GetProxyPolicyConfig() string {
+	if m != nil {
+		return m.ProxyPolicyConfig
+	}
+	return ""
+}
+
+func (m *ChallengeFlavor) GetPerUserPassphrases() bool {
+	if m != nil {
+		return m.PerUserPassphrases
+	}
+	return false
+}
+
+func (m *ChallengeFlavor) GetPoints() int64 {
+	if m != nil {
+		return m.Points
+	}
+	return 0
+}
+
 func (m *ChallengeFlavor) GetChallenge() *Challenge {
 	if m != nil {
 		return m.Challenge
@@ -955,11 +1042,124 @@ func (m *ChallengeFlavor_RedumpPolicy) GetDelay() string {
 	return ""
 }
 
+// ProxyPolicy overrides the agent's default nginx settings for the flavor's upstreams
+type ChallengeFlavor_ProxyPolicy struct {
+	ClientMaxBodySize string            `protobuf:"bytes,1,opt,name=client_max_body_size,json=clientMaxBodySize,proto3" json:"client_max_body_size,omitempty" yaml:"client_max_body_size,omitempty"`
+	ConnectTimeout    string            `protobuf:"bytes,2,opt,name=connect_timeout,json=connectTimeout,proto3" json:"connect_timeout,omitempty" yaml:"connect_timeout,omitempty"`
+	SendTimeout       string            `protobuf:"bytes,3,opt,name=send_timeout,json=sendTimeout,proto3" json:"send_timeout,omitempty" yaml:"send_timeout,omitempty"`
+	ReadTimeout       string            `protobuf:"bytes,4,opt,name=read_timeout,json=readTimeout,proto3" json:"read_timeout,omitempty" yaml:"read_timeout,omitempty"`
+	Headers           map[string]string `protobuf:"bytes,5,rep,name=headers,proto3" json:"headers,omitempty" yaml:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	DisableWebsocket  bool              `protobuf:"varint,6,opt,name=disable_websocket,json=disableWebsocket,proto3" json:"disable_websocket,omitempty" yaml:"disable_websocket,omitempty"`
+	// rate and connection limits, by user and by client IP (0: agent's default, -1: disabled)
+	RateLimit      int64 `protobuf:"varint,7,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty" yaml:"rate_limit,omitempty"`
+	RateLimitBurst int64 `protobuf:"varint,8,opt,name=rate_limit_burst,json=rateLimitBurst,proto3" json:"rate_limit_burst,omitempty" yaml:"rate_limit_burst,omitempty"`
+	ConnLimit      int64 `protobuf:"varint,9,opt,name=conn_limit,json=connLimit,proto3" json:"conn_limit,omitempty" yaml:"conn_limit,omitempty"`
+}
+
+func (m *ChallengeFlavor_ProxyPolicy) Reset()         { *m = ChallengeFlavor_ProxyPolicy{} }
+func (m *ChallengeFlavor_ProxyPolicy) String() string { return proto.CompactTextString(m) }
+func (*ChallengeFlavor_ProxyPolicy) ProtoMessage()    {}
+func (*ChallengeFlavor_ProxyPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_debbe06253822cef, []int{1, 1}
+}
+func (m *ChallengeFlavor_ProxyPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChallengeFlavor_ProxyPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChallengeFlavor_ProxyPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChallengeFlavor_ProxyPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChallengeFlavor_ProxyPolicy.Merge(m, src)
+}
+func (m *ChallengeFlavor_ProxyPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChallengeFlavor_ProxyPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChallengeFlavor_ProxyPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChallengeFlavor_ProxyPolicy proto.InternalMessageInfo
+
+func (m *ChallengeFlavor_ProxyPolicy) GetClientMaxBodySize() string {
+	if m != nil {
+		return m.ClientMaxBodySize
+	}
+	return ""
+}
+
+func (m *ChallengeFlavor_ProxyPolicy) GetConnectTimeout() string {
+	if m != nil {
+		return m.ConnectTimeout
+	}
+	return ""
+}
+
+func (m *ChallengeFlavor_ProxyPolicy) GetSendTimeout() string {
+	if m != nil {
+		return m.SendTimeout
+	}
+	return ""
+}
+
+func (m *ChallengeFlavor_ProxyPolicy) GetReadTimeout() string {
+	if m != nil {
+		return m.ReadTimeout
+	}
+	return ""
+}
+
+func (m *ChallengeFlavor_ProxyPolicy) GetHeaders() map[string]string {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+func (m *ChallengeFlavor_ProxyPolicy) GetDisableWebsocket() bool {
+	if m != nil {
+		return m.DisableWebsocket
+	}
+	return false
+}
+
+func (m *ChallengeFlavor_ProxyPolicy) GetRateLimit() int64 {
+	if m != nil {
+		return m.RateLimit
+	}
+	return 0
+}
+
+func (m *ChallengeFlavor_ProxyPolicy) GetRateLimitBurst() int64 {
+	if m != nil {
+		return m.RateLimitBurst
+	}
+	return 0
+}
+
+func (m *ChallengeFlavor_ProxyPolicy) GetConnLimit() int64 {
+	if m != nil {
+		return m.ConnLimit
+	}
+	return 0
+}
+
 type SeasonChallenge struct {
 	ID            int64                    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" gorm:"primary_key"`
 	CreatedAt     *time.Time               `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at,omitempty"`
 	UpdatedAt     *time.Time               `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3,stdtime" json:"updated_at,omitempty"`
 	Slug          string                   `protobuf:"bytes,100,opt,name=slug,proto3" json:"slug,omitempty"`
+	Points        int64                    `protobuf:"varint,101,opt,name=points,proto3" json:"points,omitempty" gorm:"-"`
+	Solves        int64                    `protobuf:"varint,102,opt,name=solves,proto3" json:"solves,omitempty" gorm:"-"`
+	FirstBloods   []*ChallengeValidation   `protobuf:"bytes,103,rep,name=first_bloods,json=firstBloods,proto3" json:"first_bloods,omitempty" gorm:"-"`
 	Flavor        *ChallengeFlavor         `protobuf:"bytes,200,opt,name=flavor,proto3" json:"flavor,omitempty" gorm:"foreignkey:FlavorID"`
 	FlavorID      int64                    `protobuf:"varint,201,opt,name=flavor_id,json=flavorId,proto3" json:"flavor_id,omitempty" sql:"not null" gorm:"index;unique_index:idx_seasonchallenge_flavor_season"`
 	Season        *Season                  `protobuf:"bytes,202,opt,name=season,proto3" json:"season,omitempty" gorm:"foreignkey:SeasonID"`
@@ -1028,6 +1228,27 @@ func (m *SeasonChallenge) GetSlug() string {
 	return ""
 }
 
+func (m *SeasonChallenge) GetPoints() int64 {
+	if m != nil {
+		return m.Points
+	}
+	return 0
+}
+
+func (m *SeasonChallenge) GetSolves() int64 {
+	if m != nil {
+		return m.Solves
+	}
+	return 0
+}
+
+func (m *SeasonChallenge) GetFirstBloods() []*ChallengeValidation {
+	if m != nil {
+		return m.FirstBloods
+	}
+	return nil
+}
+
 func (m *SeasonChallenge) GetFlavor() *ChallengeFlavor {
 	if m != nil {
 		return m.Flavor
@@ -1078,12 +1299,14 @@ type ChallengeInstance struct {
 	// usage metrics to be updated by the agent (unique users, requests, etc)
 	// timestamp last_marked_as_tainted
 	// driver (docker)
-	Slug     string           `protobuf:"bytes,108,opt,name=slug,proto3" json:"slug,omitempty"`
-	Agent    *Agent           `protobuf:"bytes,200,opt,name=agent,proto3" json:"agent,omitempty" gorm:"foreignkey:AgentID"`
-	AgentID  int64            `protobuf:"varint,201,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty" sql:"not null" gorm:"index"`
-	Flavor   *ChallengeFlavor `protobuf:"bytes,202,opt,name=flavor,proto3" json:"flavor,omitempty" gorm:"foreignkey:FlavorID"`
-	FlavorID int64            `protobuf:"varint,203,opt,name=flavor_id,json=flavorId,proto3" json:"flavor_id,omitempty" sql:"not null" gorm:"index"`
-	NginxURL string           `protobuf:"bytes,250,opt,name=nginx_url,json=nginxUrl,proto3" json:"nginx_url,omitempty" gorm:"-"`
+	Slug                 string           `protobuf:"bytes,108,opt,name=slug,proto3" json:"slug,omitempty"`
+	StartupAttempts      int64            `protobuf:"varint,109,opt,name=startup_attempts,json=startupAttempts,proto3" json:"startup_attempts,omitempty"`
+	LastStartupAttemptAt *time.Time       `protobuf:"bytes,110,opt,name=last_startup_attempt_at,json=lastStartupAttemptAt,proto3,stdtime" json:"last_startup_attempt_at,omitempty"`
+	Agent                *Agent           `protobuf:"bytes,200,opt,name=agent,proto3" json:"agent,omitempty" gorm:"foreignkey:AgentID"`
+	AgentID              int64            `protobuf:"varint,201,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty" sql:"not null" gorm:"index"`
+	Flavor               *ChallengeFlavor `protobuf:"bytes,202,opt,name=flavor,proto3" json:"flavor,omitempty" gorm:"foreignkey:FlavorID"`
+	FlavorID             int64            `protobuf:"varint,203,opt,name=flavor_id,json=flavorId,proto3" json:"flavor_id,omitempty" sql:"not null" gorm:"index"`
+	NginxURL             string           `protobuf:"bytes,250,opt,name=nginx_url,json=nginxUrl,proto3" json:"nginx_url,omitempty" gorm:"-"`
 }
 
 func (m *ChallengeInstance) Reset()         { *m = ChallengeInstance{} }
@@ -1203,6 +1426,20 @@ func (m *ChallengeInstance) GetSlug() string {
 	return ""
 }
 
+func (m *ChallengeInstance) GetStartupAttempts() int64 {
+	if m != nil {
+		return m.StartupAttempts
+	}
+	return 0
+}
+
+func (m *ChallengeInstance) GetLastStartupAttemptAt() *time.Time {
+	if m != nil {
+		return m.LastStartupAttemptAt
+	}
+	return nil
+}
+
 func (m *ChallengeInstance) GetAgent() *Agent {
 	if m != nil {
 		return m.Agent
@@ -1260,6 +1497,7 @@ type Agent struct {
 	AuthSalt           string               `protobuf:"bytes,115,opt,name=auth_salt,json=authSalt,proto3" json:"auth_salt,omitempty"`
 	DefaultAgent       bool                 `protobuf:"varint,116,opt,name=default_agent,json=defaultAgent,proto3" json:"default_agent,omitempty"`
 	Slug               string               `protobuf:"bytes,117,opt,name=slug,proto3" json:"slug,omitempty"`
+	DrainDeadline      *time.Time           `protobuf:"bytes,118,opt,name=drain_deadline,json=drainDeadline,proto3,stdtime" json:"drain_deadline,omitempty"`
 	ChallengeInstances []*ChallengeInstance `protobuf:"bytes,200,rep,name=challenge_instances,json=challengeInstances,proto3" json:"challenge_instances,omitempty" gorm:"PRELOAD:false"`
 }
 
@@ -1443,6 +1681,13 @@ func (m *Agent) GetSlug() string {
 	return ""
 }
 
+func (m *Agent) GetDrainDeadline() *time.Time {
+	if m != nil {
+		return m.DrainDeadline
+	}
+	return nil
+}
+
 func (m *Agent) GetChallengeInstances() []*ChallengeInstance {
 	if m != nil {
 		return m.ChallengeInstances
@@ -2170,8 +2415,15 @@ type Season struct {
 	IsGlobal     bool                `protobuf:"varint,104,opt,name=is_global,json=isGlobal,proto3" json:"is_global,omitempty"`
 	Slug         string              `protobuf:"bytes,105,opt,name=slug,proto3" json:"slug,omitempty"`
 	IsTesting    bool                `protobuf:"varint,106,opt,name=is_testing,json=isTesting,proto3" json:"is_testing,omitempty"`
-	Teams        []*Team             `protobuf:"bytes,200,rep,name=teams,proto3" json:"teams,omitempty" gorm:"PRELOAD:false"`
-	Coupons      []*Coupon           `protobuf:"bytes,201,rep,name=coupons,proto3" json:"coupons,omitempty" gorm:"PRELOAD:false"`
+	// with dynamic scoring, the points of a season challenge decay from initial to minimum as teams solve it, reaching minimum after decay solves,
+	// a flavor without points follows the curve, the curve of a flavor with points is scaled by them,
+	// i.e., a flavor worth twice the initial points starts and ends twice higher
+	DynamicScoring bool      `protobuf:"varint,107,opt,name=dynamic_scoring,json=dynamicScoring,proto3" json:"dynamic_scoring,omitempty"`
+	ScoringInitial int64     `protobuf:"varint,108,opt,name=scoring_initial,json=scoringInitial,proto3" json:"scoring_initial,omitempty"`
+	ScoringMinimum int64     `protobuf:"varint,109,opt,name=scoring_minimum,json=scoringMinimum,proto3" json:"scoring_minimum,omitempty"`
+	ScoringDecay   int64     `protobuf:"varint,110,opt,name=scoring_decay,json=scoringDecay,proto3" json:"scoring_decay,omitempty"`
+	Teams          []*Team   `protobuf:"bytes,200,rep,name=teams,proto3" json:"teams,omitempty" gorm:"PRELOAD:false"`
+	Coupons        []*Coupon `protobuf:"bytes,201,rep,name=coupons,proto3" json:"coupons,omitempty" gorm:"PRELOAD:false"`
 }
 
 func (m *Season) Reset()         { *m = Season{} }
@@ -2277,6 +2529,34 @@ func (m *Season) GetIsTesting() bool {
 	return false
 }
 
+func (m *Season) GetDynamicScoring() bool {
+	if m != nil {
+		return m.DynamicScoring
+	}
+	return false
+}
+
+func (m *Season) GetScoringInitial() int64 {
+	if m != nil {
+		return m.ScoringInitial
+	}
+	return 0
+}
+
+func (m *Season) GetScoringMinimum() int64 {
+	if m != nil {
+		return m.ScoringMinimum
+	}
+	return 0
+}
+
+func (m *Season) GetScoringDecay() int64 {
+	if m != nil {
+		return m.ScoringDecay
+	}
+	return 0
+}
+
 func (m *Season) GetTeams() []*Team {
 	if m != nil {
 		return m.Teams
@@ -2292,19 +2572,21 @@ func (m *Season) GetCoupons() []*Coupon {
 }
 
 type Team struct {
-	ID                     int64                    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" gorm:"primary_key"`
-	CreatedAt              *time.Time               `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at,omitempty"`
-	UpdatedAt              *time.Time               `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3,stdtime" json:"updated_at,omitempty"`
-	DeletedAt              *time.Time               `protobuf:"bytes,4,opt,name=deleted_at,json=deletedAt,proto3,stdtime" json:"deleted_at,omitempty"`
-	IsGlobal               bool                     `protobuf:"varint,100,opt,name=is_global,json=isGlobal,proto3" json:"is_global,omitempty"`
-	DeletionStatus         DeletionStatus           `protobuf:"varint,101,opt,name=deletion_status,json=deletionStatus,proto3,enum=pathwar.db.DeletionStatus" json:"deletion_status,omitempty"`
-	Cash                   int64                    `protobuf:"varint,102,opt,name=cash,proto3" json:"cash,omitempty"`
-	Score                  int64                    `protobuf:"varint,103,opt,name=score,proto3" json:"score,omitempty"`
-	GoldMedals             int64                    `protobuf:"varint,104,opt,name=gold_medals,json=goldMedals,proto3" json:"gold_medals,omitempty"`
-	SilverMedals           int64                    `protobuf:"varint,105,opt,name=silver_medals,json=silverMedals,proto3" json:"silver_medals,omitempty"`
-	BronzeMedals           int64                    `protobuf:"varint,106,opt,name=bronze_medals,json=bronzeMedals,proto3" json:"bronze_medals,omitempty"`
-	NbAchievements         int64                    `protobuf:"varint,107,opt,name=nb_achievements,json=nbAchievements,proto3" json:"nb_achievements,omitempty"`
-	Slug                   string                   `protobuf:"bytes,108,opt,name=slug,proto3" json:"slug,omitempty"`
+	ID             int64          `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" gorm:"primary_key"`
+	CreatedAt      *time.Time     `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at,omitempty"`
+	UpdatedAt      *time.Time     `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3,stdtime" json:"updated_at,omitempty"`
+	DeletedAt      *time.Time     `protobuf:"bytes,4,opt,name=deleted_at,json=deletedAt,proto3,stdtime" json:"deleted_at,omitempty"`
+	IsGlobal       bool           `protobuf:"varint,100,opt,name=is_global,json=isGlobal,proto3" json:"is_global,omitempty"`
+	DeletionStatus DeletionStatus `protobuf:"varint,101,opt,name=deletion_status,json=deletionStatus,proto3,enum=pathwar.db.DeletionStatus" json:"deletion_status,omitempty"`
+	Cash           int64          `protobuf:"varint,102,opt,name=cash,proto3" json:"cash,omitempty"`
+	Score          int64          `protobuf:"varint,103,opt,name=score,proto3" json:"score,omitempty"`
+	GoldMedals     int64          `protobuf:"varint,104,opt,name=gold_medals,json=goldMedals,proto3" json:"gold_medals,omitempty"`
+	SilverMedals   int64          `protobuf:"varint,105,opt,name=silver_medals,json=silverMedals,proto3" json:"silver_medals,omitempty"`
+	BronzeMedals   int64          `protobuf:"varint,106,opt,name=bronze_medals,json=bronzeMedals,proto3" json:"bronze_medals,omitempty"`
+	NbAchievements int64          `protobuf:"varint,107,opt,name=nb_achievements,json=nbAchievements,proto3" json:"nb_achievements,omitempty"`
+	Slug           string         `protobuf:"bytes,108,opt,name=slug,proto3" json:"slug,omitempty"`
+	// time of the validation that gave the team its current score, the earliest ranks first on ties
+	LastScoredAt           *time.Time               `protobuf:"bytes,109,opt,name=last_scored_at,json=lastScoredAt,proto3,stdtime" json:"last_scored_at,omitempty"`
 	Season                 *Season                  `protobuf:"bytes,200,opt,name=season,proto3" json:"season,omitempty" gorm:"foreignkey:SeasonID"`
 	SeasonID               int64                    `protobuf:"varint,201,opt,name=season_id,json=seasonId,proto3" json:"season_id,omitempty" sql:"not null" gorm:"index;unique_index:idx_team_season_organization"`
 	Organization           *Organization            `protobuf:"bytes,202,opt,name=organization,proto3" json:"organization,omitempty" gorm:"foreignkey:OrganizationID"`
@@ -2438,6 +2720,13 @@ func (m *Team) GetSlug() string {
 	return ""
 }
 
+func (m *Team) GetLastScoredAt() *time.Time {
+	if m != nil {
+		return m.LastScoredAt
+	}
+	return nil
+}
+
 func (m *Team) GetSeason() *Season {
 	if m != nil {
 		return m.Season
@@ -2636,6 +2925,7 @@ type ChallengeValidation struct {
 	CorrectorComment        string                     `protobuf:"bytes,102,opt,name=corrector_comment,json=correctorComment,proto3" json:"corrector_comment,omitempty"`
 	Passphrases             string                     `protobuf:"bytes,105,opt,name=passphrases,proto3" json:"passphrases,omitempty"`
 	Slug                    string                     `protobuf:"bytes,106,opt,name=slug,proto3" json:"slug,omitempty"`
+	Medal                   ChallengeValidation_Medal  `protobuf:"varint,107,opt,name=medal,proto3,enum=pathwar.db.ChallengeValidation_Medal" json:"medal,omitempty"`
 	ChallengeSubscription   *ChallengeSubscription     `protobuf:"bytes,200,opt,name=challenge_subscription,json=challengeSubscription,proto3" json:"challenge_subscription,omitempty" gorm:"foreignkey:ChallengeSubscriptionID"`
 	ChallengeSubscriptionID int64                      `protobuf:"varint,201,opt,name=challenge_subscription_id,json=challengeSubscriptionId,proto3" json:"challenge_subscription_id,omitempty" sql:"not null" gorm:"index"`
 	Author                  *User                      `protobuf:"bytes,202,opt,name=author,proto3" json:"author,omitempty" gorm:"foreignkey:AuthorID"`
@@ -2733,6 +3023,13 @@ func (m *ChallengeValidation) GetSlug() string {
 	return ""
 }
 
+func (m *ChallengeValidation) GetMedal() ChallengeValidation_Medal {
+	if m != nil {
+		return m.Medal
+	}
+	return ChallengeValidation_NoMedal
+}
+
 func (m *ChallengeValidation) GetChallengeSubscription() *ChallengeSubscription {
 	if m != nil {
 		return m.ChallengeSubscription
@@ -3359,7 +3656,7 @@ type Achievement struct {
 	ID                    int64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" gorm:"primary_key"`
 	CreatedAt             *time.Time           `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at,omitempty"`
 	UpdatedAt             *time.Time           `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3,stdtime" json:"updated_at,omitempty"`
-	Type                  Achievement_Type     `protobuf:"varint,100,opt,name=type,proto3,enum=pathwar.db.Achievement_Type" json:"type,omitempty"`
+	Type                  Achievement_Type     `protobuf:"varint,100,opt,name=type,proto3,enum=pathwar.db.Achievement_Type" json:"type,omitempty" gorm:"unique_index:idx_achievement_team_type"`
 	IsGlobal              bool                 `protobuf:"varint,101,opt,name=is_global,json=isGlobal,proto3" json:"is_global,omitempty"`
 	Comment               string               `protobuf:"bytes,102,opt,name=comment,proto3" json:"comment,omitempty"`
 	Argument              string               `protobuf:"bytes,103,opt,name=argument,proto3" json:"argument,omitempty"`
@@ -3367,9 +3664,9 @@ type Achievement struct {
 	Author                *User                `protobuf:"bytes,200,opt,name=author,proto3" json:"author,omitempty" gorm:"foreignkey:AuthorID"`
 	AuthorID              int64                `protobuf:"varint,201,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty" sql:"not null" gorm:"index"`
 	Team                  *Team                `protobuf:"bytes,202,opt,name=team,proto3" json:"team,omitempty" gorm:"foreignkey:TeamID"`
-	TeamID                int64                `protobuf:"varint,203,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty" sql:"not null" gorm:"index"`
+	TeamID                int64                `protobuf:"varint,203,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty" sql:"not null" gorm:"index;unique_index:idx_achievement_team_type"`
 	ChallengeValidation   *ChallengeValidation `protobuf:"bytes,204,opt,name=challenge_validation,json=challengeValidation,proto3" json:"challenge_validation,omitempty" gorm:"foreignkey:ChallengeValidationID"`
-	ChallengeValidationID int64                `protobuf:"varint,205,opt,name=challenge_validation_id,json=challengeValidationId,proto3" json:"challenge_validation_id,omitempty" sql:"not null" gorm:"index"`
+	ChallengeValidationID int64                `protobuf:"varint,205,opt,name=challenge_validation_id,json=challengeValidationId,proto3" json:"challenge_validation_id,omitempty" sql:"null" gorm:"index"`
 }
 
 func (m *Achievement) Reset()         { *m = Achievement{} }
@@ -3508,6 +3805,8 @@ type Activity struct {
 	CreatedAt               *time.Time             `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at,omitempty"`
 	UpdatedAt               *time.Time             `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3,stdtime" json:"updated_at,omitempty"`
 	Kind                    Activity_Kind          `protobuf:"varint,100,opt,name=kind,proto3,enum=pathwar.db.Activity_Kind" json:"kind,omitempty"`
+	Count                   int64                  `protobuf:"varint,101,opt,name=count,proto3" json:"count,omitempty"`
+	ScoreDelta              int64                  `protobuf:"varint,102,opt,name=score_delta,json=scoreDelta,proto3" json:"score_delta,omitempty"`
 	Author                  *User                  `protobuf:"bytes,200,opt,name=author,proto3" json:"author,omitempty" gorm:"foreignkey:AuthorID"`
 	AuthorID                int64                  `protobuf:"varint,201,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty" sql:"not null" gorm:"index"`
 	Team                    *Team                  `protobuf:"bytes,202,opt,name=team,proto3" json:"team,omitempty" gorm:"foreignkey:TeamID"`
@@ -3599,6 +3898,20 @@ func (m *Activity) GetKind() Activity_Kind {
 	return Activity_Unknown
 }
 
+func (m *Activity) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *Activity) GetScoreDelta() int64 {
+	if m != nil {
+		return m.ScoreDelta
+	}
+	return 0
+}
+
 func (m *Activity) GetAuthor() *User {
 	if m != nil {
 		return m.Author
@@ -4390,6 +4703,7 @@ func init() {
 	proto.RegisterEnum("pathwar.db.Season_Visibility", Season_Visibility_name, Season_Visibility_value)
 	proto.RegisterEnum("pathwar.db.Season_Subscription", Season_Subscription_name, Season_Subscription_value)
 	proto.RegisterEnum("pathwar.db.ChallengeValidation_Status", ChallengeValidation_Status_name, ChallengeValidation_Status_value)
+	proto.RegisterEnum("pathwar.db.ChallengeValidation_Medal", ChallengeValidation_Medal_name, ChallengeValidation_Medal_value)
 	proto.RegisterEnum("pathwar.db.ChallengeSubscription_Status", ChallengeSubscription_Status_name, ChallengeSubscription_Status_value)
 	proto.RegisterEnum("pathwar.db.InventoryItem_Item", InventoryItem_Item_name, InventoryItem_Item_value)
 	proto.RegisterEnum("pathwar.db.Achievement_Type", Achievement_Type_name, Achievement_Type_value)
@@ -4397,6 +4711,8 @@ func init() {
 	proto.RegisterType((*Challenge)(nil), "pathwar.db.Challenge")
 	proto.RegisterType((*ChallengeFlavor)(nil), "pathwar.db.ChallengeFlavor")
 	proto.RegisterType((*ChallengeFlavor_RedumpPolicy)(nil), "pathwar.db.ChallengeFlavor.RedumpPolicy")
+	proto.RegisterType((*ChallengeFlavor_ProxyPolicy)(nil), "pathwar.db.ChallengeFlavor.ProxyPolicy")
+	proto.RegisterMapType((map[string]string)(nil), "pathwar.db.ChallengeFlavor.ProxyPolicy.HeadersEntry")
 	proto.RegisterType((*SeasonChallenge)(nil), "pathwar.db.SeasonChallenge")
 	proto.RegisterType((*ChallengeInstance)(nil), "pathwar.db.ChallengeInstance")
 	proto.RegisterType((*Agent)(nil), "pathwar.db.Agent")
@@ -4432,353 +4748,397 @@ func init() {
 func init() { proto.RegisterFile("pwdb.proto", fileDescriptor_debbe06253822cef) }
 
 var fileDescriptor_debbe06253822cef = []byte{
-	// 5524 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7c, 0xdd, 0x73, 0x1b, 0xd7,
-	0x75, 0xb8, 0x41, 0x82, 0x20, 0x70, 0x08, 0x90, 0xcb, 0x4b, 0x52, 0x5a, 0xc9, 0x96, 0x96, 0x5e,
-	0x27, 0x96, 0x64, 0x59, 0x14, 0xc5, 0x44, 0xfe, 0x25, 0xb2, 0xe2, 0x09, 0x41, 0xc9, 0x12, 0x2c,
-	0xd9, 0xd2, 0x6f, 0x29, 0xca, 0x8d, 0xe3, 0x0c, 0x66, 0x81, 0xbd, 0x00, 0xd7, 0x5c, 0xec, 0x42,
-	0xbb, 0x0b, 0x52, 0xf4, 0x4c, 0x67, 0x3a, 0x99, 0xa6, 0xed, 0x5b, 0x33, 0xd3, 0xce, 0x74, 0xda,
-	0xe9, 0x1f, 0xd0, 0xe7, 0x3e, 0x76, 0xa6, 0x8f, 0x9d, 0x91, 0x13, 0xc9, 0x76, 0xda, 0xb4, 0x75,
-	0x3f, 0x82, 0xa4, 0xf4, 0x43, 0xfb, 0x8c, 0xe9, 0x53, 0xfb, 0xd2, 0xb9, 0x1f, 0xbb, 0x7b, 0x77,
-	0xb1, 0x00, 0xc8, 0x90, 0x49, 0xc3, 0xda, 0x7a, 0x10, 0x71, 0xcf, 0x9e, 0x73, 0xee, 0xb9, 0x77,
-	0xcf, 0x3d, 0xf7, 0x9c, 0x73, 0xcf, 0x5d, 0x80, 0xf6, 0x8e, 0x51, 0x5b, 0x6a, 0xbb, 0x8e, 0xef,
-	0x20, 0x68, 0xeb, 0xfe, 0xe6, 0x8e, 0xee, 0x2e, 0x19, 0xb5, 0xd3, 0x97, 0x9a, 0xa6, 0xbf, 0xd9,
-	0xa9, 0x2d, 0xd5, 0x9d, 0xd6, 0xe5, 0xa6, 0xd3, 0x74, 0x2e, 0x53, 0x94, 0x5a, 0xa7, 0x41, 0x5b,
-	0xb4, 0x41, 0x7f, 0x31, 0xd2, 0xd3, 0x4a, 0xd3, 0x71, 0x9a, 0x16, 0x8e, 0xb0, 0x7c, 0xb3, 0x85,
-	0x3d, 0x5f, 0x6f, 0xb5, 0x19, 0x82, 0xfa, 0xdf, 0x59, 0x28, 0xac, 0x6d, 0xea, 0x96, 0x85, 0xed,
-	0x26, 0x46, 0xdf, 0x86, 0x31, 0xd3, 0x90, 0x33, 0x8b, 0x99, 0xf3, 0xe3, 0xe5, 0xe5, 0xbd, 0xae,
-	0x32, 0x56, 0xb9, 0xd1, 0xeb, 0x2a, 0x2f, 0x37, 0x1d, 0xb7, 0x75, 0x4d, 0x6d, 0xbb, 0x66, 0x4b,
-	0x77, 0x77, 0xab, 0x5b, 0x78, 0x57, 0x5d, 0xdc, 0xd5, 0x5b, 0xd6, 0x35, 0xd5, 0x34, 0x5e, 0x75,
-	0x5a, 0xa6, 0x8f, 0x5b, 0x6d, 0x7f, 0x57, 0xd5, 0xc6, 0x4c, 0x03, 0xd5, 0x00, 0xea, 0x2e, 0xd6,
-	0x7d, 0x6c, 0x54, 0x75, 0x5f, 0x1e, 0x5b, 0xcc, 0x9c, 0x9f, 0x5a, 0x39, 0xbd, 0xc4, 0xa4, 0x58,
-	0x0a, 0xa4, 0x58, 0x7a, 0x10, 0x48, 0x51, 0x3e, 0xf7, 0xa4, 0xab, 0x64, 0x7a, 0x5d, 0xe5, 0x79,
-	0xc6, 0x30, 0xa2, 0x15, 0x18, 0xff, 0xf0, 0xe7, 0x4a, 0x46, 0x2b, 0xf0, 0x47, 0xab, 0x3e, 0xe9,
-	0xa3, 0xd3, 0x36, 0x82, 0x3e, 0xc6, 0x0f, 0xda, 0x47, 0x44, 0xdb, 0xd7, 0x07, 0x7f, 0xb4, 0xea,
-	0x23, 0x04, 0x59, 0x5b, 0x6f, 0x61, 0xd9, 0x58, 0xcc, 0x9c, 0x2f, 0x68, 0xf4, 0x37, 0x5a, 0x84,
-	0x29, 0x03, 0x7b, 0x75, 0xd7, 0x6c, 0xfb, 0xa6, 0x63, 0xcb, 0x98, 0x3e, 0x12, 0x41, 0xe8, 0x04,
-	0xe4, 0xf4, 0x8e, 0xbf, 0xe9, 0xb8, 0x72, 0x83, 0x3e, 0xe4, 0x2d, 0x02, 0xb7, 0x9c, 0xba, 0x6e,
-	0x61, 0xb9, 0xc9, 0xe0, 0xac, 0x85, 0x4e, 0x41, 0xde, 0xf4, 0xaa, 0x86, 0xab, 0x37, 0x7c, 0x79,
-	0x73, 0x31, 0x73, 0x3e, 0xaf, 0x4d, 0x9a, 0xde, 0x0d, 0xd2, 0x44, 0x97, 0x61, 0xaa, 0xed, 0xe2,
-	0x6d, 0x13, 0xef, 0x54, 0x3b, 0xae, 0x25, 0x9b, 0x84, 0xae, 0x3c, 0xbd, 0xd7, 0x55, 0xe0, 0x3e,
-	0x03, 0x6f, 0x68, 0x77, 0x35, 0xe0, 0x28, 0x1b, 0xae, 0x85, 0x4e, 0x43, 0x7e, 0xd3, 0x69, 0xe1,
-	0xb6, 0xde, 0xc4, 0xf2, 0x07, 0xb4, 0x97, 0xb0, 0x8d, 0x2e, 0x42, 0xd6, 0xb3, 0x3a, 0x4d, 0x79,
-	0x8b, 0x72, 0x39, 0xd9, 0xeb, 0x2a, 0x73, 0xec, 0x9d, 0x76, 0x6c, 0xf3, 0x51, 0x07, 0x57, 0x4d,
-	0xdb, 0xc0, 0x8f, 0x55, 0x8d, 0x22, 0x21, 0x13, 0x26, 0x1b, 0x96, 0xbe, 0xed, 0xb8, 0x9e, 0xfc,
-	0x24, 0xb3, 0x38, 0x7e, 0x7e, 0x6a, 0xe5, 0xf9, 0xa5, 0x48, 0x03, 0x97, 0x42, 0x6d, 0x79, 0x93,
-	0x22, 0x95, 0xaf, 0xf4, 0xba, 0xca, 0x25, 0xc6, 0xed, 0xbe, 0x76, 0xf3, 0xee, 0xbd, 0xd5, 0x1b,
-	0xd7, 0x1a, 0xba, 0xe5, 0xe1, 0x40, 0x47, 0x38, 0x2f, 0x51, 0x51, 0x02, 0xfe, 0xea, 0x1f, 0x97,
-	0x60, 0x26, 0xc1, 0xef, 0x4b, 0x1d, 0x0c, 0x75, 0x50, 0x86, 0xc9, 0x6d, 0xec, 0x7a, 0x44, 0xd7,
-	0x98, 0x1a, 0x06, 0x4d, 0xf4, 0x2a, 0x80, 0xe7, 0x74, 0xdc, 0x3a, 0xa6, 0xba, 0xb1, 0x49, 0xdf,
-	0x6a, 0x69, 0xaf, 0xab, 0x14, 0xd6, 0x29, 0x94, 0xa8, 0x46, 0x81, 0x21, 0x10, 0xcd, 0xf8, 0x16,
-	0x4c, 0xd7, 0x9d, 0x56, 0xdb, 0xf1, 0x70, 0xb5, 0xd6, 0xb1, 0x0d, 0x0b, 0x73, 0x6d, 0x3a, 0xd1,
-	0xeb, 0x2a, 0x88, 0xcd, 0xab, 0x67, 0x7e, 0x88, 0xaf, 0x5d, 0x59, 0x26, 0xff, 0x54, 0xad, 0xc4,
-	0xb1, 0xcb, 0x14, 0x19, 0xdd, 0x86, 0x9c, 0xe1, 0x9a, 0xdb, 0xd8, 0xa5, 0x6a, 0x35, 0xbd, 0xa2,
-	0x0e, 0xd1, 0x86, 0xa5, 0x1b, 0x14, 0xb3, 0x5c, 0xec, 0x75, 0x95, 0x3c, 0x1b, 0xea, 0x25, 0x55,
-	0xe3, 0xf4, 0xe8, 0xdb, 0x30, 0xdd, 0xee, 0xb8, 0xf5, 0x4d, 0xdd, 0xc3, 0xd5, 0xb6, 0x6b, 0xd6,
-	0x31, 0x55, 0xc8, 0xf1, 0xf2, 0xa9, 0x5e, 0x57, 0x59, 0x60, 0xd8, 0xf1, 0xe7, 0xaa, 0x56, 0x0a,
-	0x00, 0xf7, 0x49, 0x1b, 0x55, 0x60, 0x76, 0x5b, 0xb7, 0x4c, 0x43, 0x27, 0xcb, 0xad, 0xea, 0xe2,
-	0x1d, 0xdd, 0x35, 0x64, 0x8b, 0x32, 0x79, 0xa1, 0xd7, 0x55, 0x64, 0xc6, 0xa4, 0x0f, 0x45, 0xd5,
-	0xa4, 0x08, 0xa6, 0x51, 0x50, 0xb8, 0x26, 0x5a, 0xfb, 0x59, 0x13, 0x08, 0xb2, 0x35, 0xc7, 0xd8,
-	0x95, 0x6d, 0x66, 0x0e, 0xc8, 0x6f, 0x62, 0x0e, 0xda, 0xba, 0xe7, 0xb5, 0x37, 0x5d, 0xdd, 0xc3,
-	0x9e, 0xec, 0x10, 0x29, 0x34, 0x11, 0x44, 0x96, 0x64, 0x5d, 0xf7, 0x71, 0xd3, 0x71, 0x77, 0xe5,
-	0x36, 0x5b, 0x92, 0x41, 0x1b, 0x2d, 0x42, 0xd6, 0xd7, 0x9b, 0x9e, 0xfc, 0x68, 0x71, 0xfc, 0x7c,
-	0x81, 0xcd, 0x17, 0xeb, 0xfe, 0x92, 0xaa, 0xd1, 0x27, 0xe8, 0x1c, 0xe4, 0x7d, 0xbd, 0x59, 0xb5,
-	0x4c, 0xcf, 0x97, 0x5d, 0x2a, 0x64, 0x7c, 0x56, 0x27, 0x7d, 0xbd, 0x79, 0xd7, 0xf4, 0x7c, 0xd4,
-	0x86, 0x92, 0x8b, 0x8d, 0x4e, 0xab, 0x5d, 0x6d, 0x3b, 0x96, 0x59, 0xdf, 0x95, 0x3d, 0xba, 0x6a,
-	0xcf, 0x0f, 0x7b, 0x4f, 0x1a, 0x25, 0xb8, 0x4f, 0xf1, 0xcb, 0x2f, 0xf6, 0xba, 0xca, 0x99, 0xa0,
-	0x77, 0xbe, 0xac, 0x18, 0xc7, 0x4b, 0x8c, 0xa3, 0xaa, 0x15, 0x5d, 0x81, 0x00, 0xbd, 0x01, 0xf3,
-	0xb1, 0x1e, 0xab, 0x75, 0xc7, 0x6e, 0x98, 0x4d, 0xd9, 0x4f, 0x11, 0x13, 0x89, 0x94, 0x6b, 0x14,
-	0x0f, 0xb9, 0x50, 0xa8, 0x07, 0x02, 0x11, 0x23, 0x43, 0x56, 0xcf, 0x42, 0xaa, 0xb8, 0xe5, 0xeb,
-	0xbd, 0xae, 0xf2, 0x0d, 0x26, 0x5b, 0xc3, 0x71, 0xb1, 0xd9, 0xb4, 0xb7, 0xf0, 0xee, 0xb5, 0xf0,
-	0x79, 0xe5, 0x46, 0x20, 0x70, 0xc8, 0x50, 0x34, 0x07, 0x51, 0x37, 0xa8, 0x0d, 0xc5, 0xb0, 0x51,
-	0x35, 0x0d, 0xf9, 0x23, 0x66, 0x62, 0xee, 0xee, 0x75, 0x95, 0x29, 0x81, 0x5d, 0xaf, 0xab, 0x7c,
-	0xd3, 0x7b, 0x64, 0x5d, 0x53, 0x6d, 0xc7, 0x5f, 0xb4, 0x3b, 0x96, 0xa5, 0x2e, 0xb2, 0xde, 0x99,
-	0x3e, 0x24, 0x3b, 0xab, 0xc6, 0xcd, 0xcf, 0x54, 0xf8, 0xa0, 0x62, 0xa0, 0x3f, 0xcb, 0xc0, 0xac,
-	0x87, 0x75, 0xcf, 0xb1, 0xab, 0x21, 0xd8, 0x93, 0x7f, 0x94, 0x62, 0x53, 0xd7, 0x29, 0x56, 0x34,
-	0xe8, 0x7b, 0xbd, 0xae, 0x72, 0x27, 0xc5, 0xa6, 0xbe, 0x2e, 0x4c, 0x01, 0x7b, 0x91, 0xd1, 0xf8,
-	0xfb, 0x7a, 0x12, 0xe5, 0x92, 0xbc, 0x78, 0x0f, 0x1e, 0xfa, 0x41, 0x06, 0x0a, 0xa6, 0xed, 0xf9,
-	0xba, 0x5d, 0xc7, 0x9e, 0xfc, 0x63, 0x26, 0xd4, 0x99, 0xd4, 0x77, 0x50, 0xe1, 0x68, 0xe5, 0x5b,
-	0xbd, 0xae, 0xb2, 0x76, 0x40, 0xb1, 0xc2, 0x3e, 0x62, 0xaf, 0x25, 0x84, 0x9e, 0x7e, 0x1f, 0x8a,
-	0xa2, 0x2e, 0x92, 0x35, 0xe3, 0xf9, 0x2e, 0x59, 0x25, 0xbb, 0x74, 0x13, 0x28, 0x68, 0x61, 0x1b,
-	0x2d, 0xc3, 0x84, 0x81, 0x2d, 0x7d, 0x97, 0xda, 0xf4, 0x42, 0xf9, 0x74, 0xaf, 0xab, 0x9c, 0x60,
-	0xbd, 0x50, 0xb0, 0xd8, 0x03, 0x43, 0x54, 0xbf, 0x0e, 0x39, 0x66, 0x91, 0xd0, 0x14, 0x4c, 0x6e,
-	0xd8, 0x5b, 0xb6, 0xb3, 0x63, 0x4b, 0xcf, 0x21, 0x80, 0xdc, 0x0d, 0xa7, 0xbe, 0x85, 0x5d, 0x29,
-	0x83, 0x66, 0xa1, 0xc4, 0x7e, 0xaf, 0x31, 0xab, 0x27, 0x8d, 0xa9, 0x9f, 0x4d, 0xc0, 0x4c, 0xe2,
-	0x95, 0xa0, 0x57, 0x85, 0x6d, 0xe9, 0x85, 0x70, 0x5b, 0x42, 0xfd, 0xdb, 0x12, 0xdd, 0x82, 0xd6,
-	0x0e, 0xb8, 0x05, 0xe5, 0xc9, 0xf6, 0x90, 0xdc, 0x63, 0xd6, 0x0e, 0xb8, 0xc7, 0x08, 0x4c, 0x62,
-	0x8e, 0x0c, 0x35, 0x73, 0xdc, 0x91, 0xa1, 0xd6, 0xec, 0x01, 0xe4, 0xd8, 0x0e, 0x1c, 0xac, 0xbd,
-	0xa1, 0x1b, 0xfc, 0xd9, 0x5e, 0x57, 0x39, 0xdd, 0xb7, 0x02, 0xc3, 0xf7, 0xac, 0x71, 0x5e, 0xe8,
-	0x31, 0x14, 0xd8, 0x2f, 0x61, 0x75, 0xbd, 0xb7, 0xd7, 0x55, 0xf2, 0x01, 0x6a, 0xaf, 0xab, 0xbc,
-	0x35, 0x78, 0x69, 0xbd, 0x2e, 0xda, 0xdd, 0x6b, 0xa6, 0xf1, 0xb8, 0xca, 0x74, 0x36, 0x5a, 0x6a,
-	0x9c, 0x3b, 0x03, 0xab, 0x5a, 0x9e, 0xb5, 0x2b, 0x06, 0xba, 0x03, 0x39, 0x06, 0x24, 0x8b, 0x8b,
-	0x8c, 0x07, 0xf5, 0x2f, 0xae, 0x01, 0xc3, 0x60, 0x0f, 0xe9, 0x30, 0x18, 0x0b, 0x32, 0x0c, 0xbe,
-	0x94, 0x4c, 0x83, 0xac, 0x8b, 0x70, 0x18, 0x01, 0xea, 0x51, 0x0f, 0x83, 0xfd, 0xa8, 0x10, 0xbf,
-	0xa5, 0xe4, 0x75, 0x6a, 0xa1, 0x37, 0xe9, 0xc9, 0x4f, 0xd9, 0xaa, 0x7c, 0x31, 0xf5, 0xed, 0xac,
-	0x0b, 0xa8, 0x65, 0xb9, 0xd7, 0x55, 0xe6, 0xd3, 0x9c, 0x30, 0x2d, 0xce, 0x52, 0xfd, 0x7e, 0x01,
-	0x66, 0xfb, 0x16, 0xf6, 0xb1, 0x55, 0xee, 0xeb, 0x90, 0xf3, 0x7c, 0xdd, 0xef, 0x78, 0x54, 0xbd,
-	0xa7, 0x57, 0xbe, 0x32, 0xd4, 0x7e, 0x2d, 0xad, 0x53, 0x5c, 0x8d, 0xd3, 0xa0, 0xbb, 0x30, 0x63,
-	0xe9, 0x9e, 0x5f, 0xf5, 0x7c, 0xdd, 0xe5, 0x72, 0xe0, 0x03, 0xc8, 0x51, 0x22, 0xc4, 0xeb, 0x8c,
-	0x76, 0xd5, 0x17, 0xb8, 0x39, 0xed, 0x36, 0xe3, 0xd6, 0x38, 0x38, 0x37, 0x4a, 0xbb, 0xea, 0xa3,
-	0xef, 0x81, 0x4c, 0xb9, 0xf1, 0x6d, 0xd6, 0xc5, 0x8f, 0x3a, 0xd8, 0xe3, 0x42, 0x36, 0x0f, 0xc0,
-	0x76, 0x81, 0x70, 0x61, 0x06, 0x56, 0x0b, 0x78, 0xac, 0xfa, 0xe8, 0x25, 0x28, 0xd1, 0x51, 0x77,
-	0xda, 0x55, 0xec, 0xba, 0x8e, 0xcb, 0x7c, 0x48, 0xad, 0xc8, 0x81, 0x37, 0x09, 0x0c, 0xbd, 0x08,
-	0x7c, 0xd7, 0xaf, 0xd6, 0x9d, 0x8e, 0xed, 0x53, 0xaf, 0x71, 0x5c, 0x9b, 0x62, 0xb0, 0x35, 0x02,
-	0x42, 0x17, 0x40, 0x70, 0xac, 0x38, 0xda, 0x07, 0x14, 0x6d, 0x26, 0x82, 0x33, 0xd4, 0x73, 0x30,
-	0x13, 0x58, 0xfd, 0xc0, 0x5d, 0x20, 0xde, 0x5f, 0x51, 0x9b, 0x0e, 0xc0, 0xdc, 0x39, 0x08, 0x2c,
-	0x96, 0x25, 0x58, 0xac, 0x5b, 0x30, 0xa1, 0x37, 0xb1, 0xed, 0x07, 0x06, 0x6b, 0x56, 0x7c, 0xd1,
-	0xab, 0xe4, 0x49, 0xf9, 0x4c, 0xaf, 0xab, 0x9c, 0xea, 0x5b, 0xdf, 0xf4, 0x19, 0x59, 0xde, 0x8c,
-	0x1e, 0xbd, 0x09, 0x79, 0xfa, 0x43, 0xb0, 0x51, 0xaf, 0xec, 0x75, 0x95, 0x49, 0x8e, 0x47, 0x3c,
-	0xf4, 0x21, 0xbb, 0xbf, 0x36, 0x49, 0x89, 0x2b, 0x86, 0x60, 0x42, 0x7f, 0x74, 0x84, 0x26, 0xb4,
-	0x22, 0x9a, 0x50, 0x6e, 0x7b, 0x2e, 0x26, 0x4c, 0xe8, 0x50, 0xf9, 0x22, 0x9b, 0xf8, 0x1a, 0x14,
-	0xec, 0xa6, 0x69, 0x3f, 0xa6, 0x11, 0xc2, 0x7f, 0xd1, 0x9d, 0xb4, 0x2c, 0x13, 0x56, 0xef, 0x10,
-	0xe8, 0x86, 0x76, 0x37, 0xe6, 0x71, 0xe6, 0x29, 0xee, 0x86, 0x6b, 0xa9, 0x35, 0xc8, 0xb1, 0x65,
-	0x12, 0xdf, 0x31, 0x0b, 0x30, 0x51, 0xf1, 0xde, 0xc1, 0x3b, 0x52, 0x06, 0xcd, 0xc1, 0xcc, 0x6a,
-	0xbd, 0x8e, 0xdb, 0x3e, 0x36, 0xca, 0xbb, 0x74, 0xde, 0xa4, 0x31, 0x54, 0x82, 0xc2, 0xea, 0xb6,
-	0x6e, 0x5a, 0x7a, 0xcd, 0xc2, 0xd2, 0x38, 0x9a, 0x06, 0x78, 0x07, 0x63, 0x83, 0x29, 0x9e, 0x94,
-	0x45, 0x45, 0xc8, 0xdf, 0x30, 0x3d, 0xf2, 0xd0, 0x90, 0x26, 0xd4, 0xff, 0x98, 0x84, 0x09, 0x4a,
-	0x78, 0x9c, 0x77, 0xd5, 0xbe, 0xf4, 0x00, 0x0d, 0xc0, 0x3d, 0x9f, 0xc2, 0x71, 0x10, 0x80, 0xb3,
-	0x36, 0x3a, 0x01, 0x63, 0x8e, 0xc7, 0x92, 0x02, 0xe5, 0x1c, 0x19, 0xe7, 0xbd, 0x75, 0x6d, 0xcc,
-	0xf1, 0xd0, 0x72, 0x68, 0xc0, 0x9a, 0xd4, 0x80, 0xc9, 0x7d, 0x7a, 0x9d, 0x34, 0x5a, 0x27, 0x61,
-	0x12, 0xbb, 0x6e, 0xb5, 0xe5, 0x35, 0xf9, 0x9a, 0xcd, 0x61, 0xd7, 0x7d, 0xdb, 0xa3, 0xcb, 0x46,
-	0x77, 0xeb, 0x9b, 0x2c, 0xb6, 0xd3, 0xe8, 0x6f, 0x31, 0x82, 0xfc, 0x20, 0x1e, 0x41, 0x22, 0x1e,
-	0x7e, 0x6c, 0x31, 0x6c, 0x1a, 0x70, 0xbc, 0x04, 0x25, 0xc3, 0x69, 0xe9, 0xa6, 0x5d, 0xf5, 0x3a,
-	0x8d, 0x86, 0xf9, 0x98, 0xaf, 0xc0, 0x22, 0x03, 0xae, 0x53, 0x18, 0x3a, 0x03, 0xc0, 0xf4, 0xaa,
-	0xed, 0xb8, 0x3e, 0x0d, 0x9e, 0xc6, 0x35, 0xa6, 0x69, 0xf7, 0x1d, 0xd7, 0x27, 0x93, 0xd0, 0xc2,
-	0xbe, 0x6e, 0xe8, 0xbe, 0xce, 0x83, 0xa5, 0xb0, 0x4d, 0x48, 0x69, 0xfa, 0xa9, 0xea, 0x61, 0x6c,
-	0xf3, 0x78, 0xa9, 0x40, 0x21, 0xeb, 0x18, 0xdb, 0xc4, 0x96, 0xb0, 0xc7, 0x2e, 0x6e, 0x9a, 0x9e,
-	0x8f, 0x5d, 0x6c, 0xd0, 0xa8, 0x69, 0x5c, 0x9b, 0xa1, 0x70, 0x2d, 0x04, 0xa3, 0x87, 0x30, 0xcf,
-	0xad, 0x23, 0x01, 0xb9, 0xcc, 0xfa, 0xe8, 0xbe, 0xfc, 0xe8, 0x00, 0x6f, 0x13, 0x31, 0xcb, 0x18,
-	0x31, 0x58, 0x25, 0xd6, 0xa1, 0xc8, 0x6c, 0x38, 0xc6, 0x94, 0x9f, 0x7b, 0x00, 0x7e, 0x40, 0x0d,
-	0x38, 0xc6, 0x84, 0xcf, 0xf3, 0x50, 0xd0, 0x3b, 0xfe, 0x66, 0xd5, 0xd3, 0x2d, 0x5f, 0xf6, 0xd8,
-	0x34, 0x10, 0xc0, 0xba, 0x6e, 0x51, 0xdb, 0x6b, 0xe0, 0x86, 0xde, 0xb1, 0xfc, 0x2a, 0xb3, 0x69,
-	0x3e, 0xcd, 0xfc, 0x14, 0x39, 0x90, 0x2d, 0x8c, 0xc0, 0x08, 0x76, 0x04, 0x23, 0x68, 0xc2, 0x9c,
-	0x10, 0x77, 0x84, 0xbe, 0xfb, 0x93, 0x7d, 0xf9, 0xee, 0x83, 0x3d, 0x04, 0x54, 0x4f, 0x22, 0x7b,
-	0xea, 0xad, 0x74, 0x2b, 0x00, 0x90, 0x5b, 0xad, 0xfb, 0xe6, 0x36, 0x96, 0x32, 0x64, 0x49, 0x57,
-	0x6c, 0x9d, 0xb5, 0xc6, 0x08, 0x1a, 0x99, 0x14, 0xa7, 0xe3, 0x4b, 0xe3, 0xc4, 0x58, 0xd0, 0x1d,
-	0x44, 0xca, 0xaa, 0x7f, 0x34, 0x01, 0xe8, 0x9e, 0xdb, 0xd4, 0x6d, 0xf3, 0x43, 0x3a, 0xc9, 0x6f,
-	0xe3, 0x56, 0x0d, 0xbb, 0xc7, 0x76, 0xdd, 0xff, 0x3f, 0xc8, 0xba, 0x8e, 0x85, 0xb9, 0xbb, 0xf1,
-	0x92, 0x38, 0xe5, 0xfd, 0xa3, 0x5c, 0xd2, 0x1c, 0x0b, 0x6b, 0x94, 0x20, 0x7c, 0x9f, 0x58, 0x78,
-	0x9f, 0x6b, 0x90, 0xed, 0x78, 0x38, 0x74, 0xc2, 0x25, 0x91, 0xdb, 0x86, 0x87, 0x5d, 0x96, 0xd2,
-	0xe8, 0xdb, 0x36, 0xc8, 0x23, 0xb2, 0x69, 0x50, 0x62, 0xb4, 0x06, 0x93, 0xe4, 0xaf, 0xb0, 0x9f,
-	0x5d, 0xd8, 0xeb, 0x2a, 0x39, 0x86, 0x34, 0x6a, 0xbb, 0xc8, 0x11, 0xd2, 0x8a, 0x81, 0xea, 0x50,
-	0x74, 0x04, 0xf1, 0x83, 0x3d, 0x4d, 0x1e, 0x34, 0xbe, 0xf2, 0x57, 0x7a, 0x5d, 0x65, 0xb1, 0x4f,
-	0x32, 0x11, 0x85, 0x48, 0x18, 0x63, 0x8a, 0xbe, 0x0b, 0x33, 0x62, 0x5b, 0xd8, 0xe2, 0xae, 0xec,
-	0x75, 0x95, 0xe9, 0x38, 0xf1, 0x28, 0xc9, 0xa7, 0x45, 0x56, 0x15, 0x43, 0x7d, 0x15, 0xb2, 0x64,
-	0xb6, 0xc9, 0x3e, 0xb4, 0x61, 0x1b, 0xb8, 0x61, 0xda, 0xd8, 0x60, 0xdb, 0xd6, 0xbd, 0x1d, 0x9b,
-	0xc6, 0x79, 0x00, 0x39, 0xf6, 0x5a, 0xa4, 0x31, 0xf5, 0xf7, 0x0b, 0x00, 0x0f, 0xb0, 0xde, 0x3a,
-	0xe6, 0xda, 0x78, 0x39, 0xa6, 0x8d, 0x31, 0x0f, 0x24, 0x1a, 0x5d, 0x9a, 0x16, 0x36, 0x7e, 0x23,
-	0xb5, 0x70, 0x0d, 0xb2, 0x3e, 0xd6, 0x5b, 0x81, 0xf6, 0x49, 0xc9, 0xf1, 0x0c, 0x90, 0x84, 0x3c,
-	0xa2, 0x92, 0x10, 0x62, 0x22, 0x09, 0xf9, 0x2b, 0x68, 0x17, 0x95, 0x84, 0x21, 0x8d, 0x94, 0x84,
-	0x90, 0x56, 0x0c, 0x74, 0x0b, 0x26, 0xeb, 0x4e, 0xa7, 0x2d, 0xc4, 0x60, 0xb1, 0x88, 0x72, 0x8d,
-	0x3e, 0x1b, 0x62, 0x52, 0x03, 0x6a, 0xf4, 0x10, 0x8a, 0x7a, 0x7d, 0xd3, 0xc4, 0xdb, 0xb8, 0x85,
-	0x6d, 0xdf, 0x93, 0x9f, 0x31, 0x6e, 0x27, 0x63, 0xdb, 0x7c, 0x84, 0x30, 0x84, 0x65, 0x8c, 0x0f,
-	0x32, 0x61, 0xc1, 0x23, 0x5e, 0xec, 0xce, 0xa6, 0xe3, 0xed, 0x6c, 0x3a, 0x55, 0xdd, 0xa7, 0x89,
-	0x0f, 0x4f, 0xfe, 0x98, 0x75, 0x70, 0x5a, 0xec, 0xe0, 0x5d, 0x86, 0xb4, 0xca, 0x70, 0x86, 0xf4,
-	0x31, 0x47, 0x78, 0xc6, 0xb1, 0x3d, 0xf4, 0x08, 0x4e, 0xb9, 0xb8, 0x8e, 0xcd, 0x6d, 0x6c, 0xf4,
-	0x77, 0xf7, 0xc9, 0x61, 0xba, 0x3b, 0x19, 0xf0, 0x4d, 0x76, 0xf9, 0x16, 0x4c, 0x98, 0x3e, 0x6e,
-	0x79, 0xf2, 0xa7, 0x8c, 0xfd, 0x29, 0x91, 0x7d, 0xc5, 0xde, 0xc6, 0xb6, 0xef, 0xb8, 0xbb, 0x15,
-	0x1f, 0xb7, 0x86, 0x70, 0x67, 0x2c, 0x90, 0x03, 0x0b, 0xd1, 0xa6, 0x19, 0xc5, 0x24, 0x9e, 0xfc,
-	0x13, 0xc6, 0x5b, 0x49, 0xdd, 0x36, 0x1f, 0x86, 0x88, 0x43, 0x7a, 0x98, 0xaf, 0xf7, 0xa3, 0x7b,
-	0x07, 0xb4, 0x44, 0x7f, 0x99, 0x65, 0x96, 0xa8, 0x62, 0x6f, 0x9b, 0xfe, 0xf1, 0x0d, 0xc4, 0xd7,
-	0x00, 0x0c, 0x6c, 0x61, 0xce, 0x24, 0x7b, 0x10, 0x26, 0x9c, 0x8e, 0x32, 0xf9, 0xd2, 0x12, 0x25,
-	0x2d, 0xd1, 0x1c, 0xb7, 0xd8, 0x4f, 0x33, 0x91, 0xc9, 0x56, 0xff, 0x1d, 0x20, 0x4b, 0x06, 0xf4,
-	0xc5, 0x56, 0x97, 0xd3, 0x90, 0x27, 0xaf, 0x4b, 0x88, 0xc3, 0xc2, 0x36, 0x9a, 0x87, 0x09, 0xdc,
-	0xd2, 0x4d, 0x8b, 0xfb, 0x5b, 0xac, 0x81, 0x56, 0xa0, 0xd8, 0x74, 0xf5, 0x6d, 0xdd, 0xd7, 0x5d,
-	0x1a, 0x16, 0xb3, 0x78, 0x6c, 0x66, 0xaf, 0xab, 0x4c, 0xdd, 0xe2, 0xf0, 0x0d, 0xed, 0xae, 0x36,
-	0x15, 0x20, 0x6d, 0xb8, 0x16, 0xba, 0x0c, 0x53, 0x3b, 0xb8, 0xe6, 0x99, 0x3e, 0x3b, 0x6b, 0x6b,
-	0x46, 0xe7, 0xb0, 0xef, 0x32, 0x30, 0x3d, 0x87, 0xe5, 0x28, 0x84, 0x20, 0x3a, 0xeb, 0xdd, 0x8c,
-	0x9d, 0xf5, 0xde, 0x85, 0x92, 0xc3, 0x82, 0x82, 0x4e, 0xed, 0x03, 0x5c, 0xf7, 0xf9, 0x21, 0xdc,
-	0xb9, 0xbd, 0xae, 0x52, 0xbc, 0xb7, 0x4a, 0x82, 0x03, 0x06, 0x1f, 0x74, 0x10, 0x55, 0xa4, 0xd4,
-	0x1c, 0x09, 0x9d, 0x83, 0x19, 0x3a, 0x13, 0xec, 0x8c, 0x8b, 0xe6, 0x3e, 0x59, 0x84, 0x37, 0x1d,
-	0x80, 0x35, 0x96, 0xce, 0x5c, 0x13, 0x10, 0x79, 0xa8, 0xb9, 0x45, 0xdd, 0x85, 0x98, 0xcd, 0xbe,
-	0xc1, 0x51, 0x78, 0xb0, 0x19, 0x32, 0xe1, 0x41, 0x40, 0x5a, 0x4a, 0xe6, 0x7d, 0x90, 0xa8, 0x7a,
-	0xb7, 0xa8, 0x29, 0xf3, 0x36, 0xcd, 0x76, 0x18, 0x8a, 0x9c, 0x48, 0xf7, 0x44, 0x86, 0x98, 0xd2,
-	0x19, 0x3f, 0xc4, 0xa2, 0x9c, 0xd0, 0x77, 0xa0, 0x64, 0x3b, 0xbe, 0xd9, 0x30, 0xeb, 0xdc, 0x5c,
-	0x7f, 0xc4, 0x58, 0xc7, 0x5c, 0xd2, 0x77, 0x04, 0x8c, 0x61, 0x29, 0xd0, 0x18, 0x27, 0xe4, 0x83,
-	0x1c, 0xf3, 0x43, 0xc5, 0x01, 0xf0, 0xc3, 0x99, 0xb3, 0xc3, 0x1d, 0xfb, 0x61, 0x7b, 0x9a, 0xd3,
-	0x87, 0xcd, 0x06, 0xf4, 0xdb, 0x80, 0x58, 0xb0, 0x54, 0x15, 0x66, 0x8d, 0x19, 0x86, 0xc1, 0x13,
-	0xf6, 0x5a, 0xaf, 0xab, 0xac, 0xf4, 0xe7, 0xb4, 0x28, 0x9f, 0x08, 0xad, 0x72, 0xe3, 0xf5, 0x84,
-	0x14, 0x92, 0x9e, 0x40, 0x21, 0x0e, 0x43, 0x7f, 0xf7, 0xc4, 0x34, 0x3d, 0x65, 0xd6, 0xe3, 0xea,
-	0x5e, 0x57, 0x41, 0xfd, 0x8c, 0x47, 0x99, 0x29, 0x94, 0xec, 0xa8, 0x62, 0x20, 0x0b, 0x4a, 0xbc,
-	0x2b, 0x9e, 0x94, 0x7f, 0x36, 0x38, 0x29, 0xbf, 0xd2, 0xeb, 0x2a, 0x4b, 0x03, 0x06, 0x18, 0xe4,
-	0xdb, 0x5f, 0xef, 0xf7, 0x84, 0xa2, 0xc7, 0x44, 0x0d, 0x63, 0xbd, 0x91, 0x31, 0x7d, 0x2c, 0x84,
-	0x15, 0x71, 0x5e, 0x23, 0xc3, 0x0a, 0x91, 0x77, 0xc5, 0x50, 0xff, 0x73, 0x02, 0x8a, 0xe2, 0xfb,
-	0xff, 0x62, 0x5b, 0xdc, 0xb4, 0xac, 0x57, 0xd2, 0xa6, 0xe2, 0x7d, 0xd8, 0xd4, 0xc8, 0x44, 0x36,
-	0x62, 0x26, 0x32, 0xc5, 0x56, 0x35, 0x0f, 0x6c, 0xab, 0x5e, 0x82, 0x52, 0xd3, 0x72, 0x6a, 0xba,
-	0x15, 0xa8, 0x1f, 0x2b, 0xac, 0x29, 0x32, 0x20, 0xd7, 0x9a, 0xc0, 0xa0, 0x99, 0x82, 0x41, 0x5b,
-	0x85, 0x09, 0xb2, 0x36, 0x42, 0x2b, 0xd6, 0xbf, 0xeb, 0x0f, 0x71, 0x36, 0x29, 0xe5, 0x70, 0x5f,
-	0xf9, 0xa3, 0x5f, 0x89, 0xaf, 0xbc, 0x0e, 0x93, 0xdc, 0x80, 0x1d, 0xde, 0x78, 0x05, 0x9c, 0xd4,
-	0xbf, 0xc8, 0x41, 0x8e, 0xcf, 0xd4, 0xff, 0xa5, 0x0c, 0xed, 0x95, 0x30, 0xdb, 0x8a, 0xa9, 0x5a,
-	0x9d, 0xea, 0xb7, 0x48, 0xc9, 0x74, 0xeb, 0xb7, 0x00, 0xb6, 0x4d, 0xcf, 0xac, 0x99, 0x96, 0xe9,
-	0xef, 0x52, 0x75, 0x9d, 0x8e, 0x67, 0xda, 0x38, 0xd9, 0xc3, 0x10, 0x49, 0x13, 0x08, 0xd0, 0x1a,
-	0x14, 0xc5, 0xf3, 0x37, 0xae, 0xce, 0x4a, 0x5a, 0xbf, 0x02, 0x9a, 0x16, 0x23, 0x42, 0xcf, 0x43,
-	0xc1, 0xf4, 0xaa, 0x4c, 0x7f, 0xb9, 0x36, 0xe7, 0x4d, 0xef, 0x16, 0x6d, 0xa7, 0x6a, 0xf2, 0x19,
-	0x00, 0xd3, 0xab, 0xfa, 0xd8, 0xf3, 0x4d, 0xbb, 0x49, 0xfd, 0x82, 0xbc, 0x56, 0x30, 0xbd, 0x07,
-	0x0c, 0x70, 0x14, 0x8a, 0x2e, 0x04, 0xc8, 0x1f, 0x1d, 0x26, 0x40, 0x56, 0xaf, 0x86, 0x89, 0xc6,
-	0x59, 0x28, 0xf1, 0x44, 0x23, 0x03, 0x48, 0xcf, 0xa1, 0x29, 0x98, 0xe4, 0xe7, 0x6b, 0x52, 0x86,
-	0x35, 0xe8, 0xf1, 0x98, 0x34, 0xa6, 0xbe, 0x05, 0x10, 0xcd, 0x38, 0x5a, 0x80, 0x59, 0x4e, 0x1a,
-	0x01, 0x19, 0xf9, 0x7d, 0xd7, 0xdc, 0xd6, 0x7d, 0x9e, 0xae, 0xdc, 0xb0, 0x2d, 0xd3, 0x23, 0xcc,
-	0xc6, 0x48, 0x08, 0x76, 0xbf, 0x53, 0xb3, 0xcc, 0xba, 0x34, 0xae, 0x5e, 0x87, 0xa2, 0x38, 0xf9,
-	0xe8, 0x24, 0xcc, 0x05, 0x82, 0x08, 0x60, 0xe9, 0x39, 0x94, 0x87, 0xec, 0xbd, 0x36, 0xb6, 0xa5,
-	0x0c, 0x09, 0xe6, 0xd6, 0x2c, 0x56, 0x2b, 0xf0, 0x7d, 0x80, 0x2c, 0x99, 0xb3, 0x2f, 0xf6, 0xce,
-	0x10, 0x53, 0x51, 0x23, 0xa1, 0xa2, 0x29, 0x66, 0x1d, 0xff, 0x32, 0x2e, 0x68, 0x5d, 0xf7, 0x36,
-	0xe9, 0x12, 0x1c, 0xd7, 0xe8, 0x6f, 0xe2, 0xe5, 0x7b, 0x75, 0xc7, 0x65, 0x55, 0x95, 0xe3, 0x1a,
-	0x6b, 0x20, 0x05, 0xa6, 0x9a, 0x8e, 0x65, 0x54, 0x5b, 0xd8, 0xd0, 0x2d, 0x8f, 0x2e, 0x98, 0x71,
-	0x0d, 0x08, 0xe8, 0x6d, 0x0a, 0xa1, 0x87, 0x9f, 0xa6, 0xb5, 0x8d, 0xdd, 0x00, 0x85, 0x1d, 0x6c,
-	0x16, 0x19, 0x30, 0x42, 0xaa, 0xb9, 0x8e, 0xfd, 0x21, 0x0e, 0x90, 0xd8, 0xb1, 0x66, 0x91, 0x01,
-	0x39, 0xd2, 0x39, 0x98, 0xb1, 0x6b, 0xd5, 0x58, 0x86, 0x87, 0x56, 0xb4, 0x69, 0xd3, 0x76, 0x6d,
-	0x55, 0xcc, 0xd7, 0xa4, 0x39, 0xd0, 0x51, 0xd5, 0xc2, 0x93, 0xc3, 0x57, 0x2d, 0x78, 0x62, 0xd5,
-	0x02, 0x0f, 0x7c, 0x37, 0x12, 0x55, 0x0b, 0x37, 0x0f, 0x52, 0xb5, 0x40, 0xdd, 0x44, 0xce, 0x52,
-	0xf4, 0x69, 0xc5, 0x82, 0x85, 0x5f, 0x4b, 0xda, 0xf8, 0x07, 0x99, 0x81, 0x79, 0xe3, 0xef, 0xa6,
-	0xe6, 0x8d, 0x8f, 0x68, 0x98, 0x89, 0x0c, 0x33, 0xea, 0xc0, 0xc9, 0x28, 0x91, 0x14, 0xaf, 0xd3,
-	0x78, 0x76, 0x04, 0x75, 0x1a, 0x27, 0xea, 0x69, 0x04, 0x1e, 0xba, 0x13, 0xed, 0xef, 0x1f, 0xff,
-	0xb2, 0xd1, 0x55, 0xc0, 0xa1, 0x2f, 0x1d, 0xf9, 0xc9, 0xd1, 0xa4, 0x23, 0xd5, 0xcf, 0x26, 0x61,
-	0x3a, 0xee, 0x98, 0x1c, 0x5b, 0x73, 0x28, 0xc3, 0xa4, 0xd7, 0xa9, 0xd7, 0xb1, 0xe7, 0x71, 0x3b,
-	0x16, 0x34, 0x53, 0x8f, 0x70, 0x7e, 0x2b, 0x2c, 0xf8, 0x1e, 0x98, 0xb4, 0xba, 0xd4, 0xeb, 0x2a,
-	0x17, 0x52, 0x55, 0x52, 0x8c, 0x78, 0x28, 0x13, 0xba, 0xa0, 0x79, 0xc9, 0x78, 0x85, 0x1d, 0x21,
-	0xc6, 0xaa, 0xa9, 0x68, 0x29, 0x40, 0x80, 0x3a, 0xb2, 0x14, 0x80, 0x91, 0x57, 0x0c, 0x84, 0x61,
-	0x8a, 0xb3, 0x1a, 0x9e, 0xd4, 0xa2, 0x95, 0xdc, 0xfb, 0x93, 0x34, 0xc8, 0x74, 0x81, 0x1e, 0x36,
-	0xd1, 0x43, 0x98, 0x16, 0xba, 0x11, 0x96, 0xe9, 0xe5, 0xbd, 0xae, 0x52, 0x14, 0xe9, 0x46, 0x89,
-	0x5e, 0x8c, 0xb8, 0x32, 0xf1, 0x7d, 0xdd, 0x6d, 0x62, 0xbf, 0x4a, 0xb3, 0x83, 0x4f, 0x07, 0x4d,
-	0xf4, 0xbe, 0xc4, 0x7f, 0x40, 0x39, 0x05, 0x29, 0x43, 0xf0, 0xc3, 0x26, 0x11, 0x5f, 0xe8, 0x86,
-	0x88, 0xff, 0x4c, 0x10, 0x5f, 0xa4, 0x1b, 0x29, 0x7e, 0xc4, 0x35, 0x26, 0x3e, 0x9d, 0xfd, 0x8f,
-	0x0f, 0x35, 0xfb, 0x4c, 0x8c, 0x70, 0xf6, 0xfd, 0xb0, 0x29, 0x88, 0x1f, 0xcc, 0xfe, 0x27, 0x7d,
-	0xe2, 0xef, 0x73, 0xf6, 0x23, 0xae, 0x15, 0x43, 0xfd, 0xc3, 0x3c, 0xcc, 0xa5, 0xa4, 0xc5, 0x8f,
-	0xed, 0xfa, 0x7e, 0x23, 0x51, 0x32, 0xf6, 0xf2, 0x88, 0xfc, 0x7f, 0x32, 0x20, 0xf8, 0x6a, 0xa8,
-	0xe5, 0x75, 0xa7, 0x45, 0xac, 0x1f, 0xb7, 0x07, 0x25, 0x06, 0x5d, 0x63, 0x40, 0x74, 0x11, 0x66,
-	0xeb, 0x8e, 0xeb, 0xe2, 0xba, 0x2f, 0x60, 0xb2, 0x68, 0x57, 0x0a, 0x1f, 0x04, 0xc8, 0x89, 0x4a,
-	0x72, 0xe6, 0xca, 0xc7, 0x2a, 0xc9, 0x03, 0xdb, 0xf3, 0x81, 0x60, 0x7b, 0xfe, 0x20, 0x03, 0x27,
-	0xd2, 0x77, 0xa4, 0xc0, 0x18, 0xed, 0x63, 0x43, 0xa2, 0xd6, 0x69, 0x70, 0x79, 0xb5, 0x88, 0x4b,
-	0x34, 0x6e, 0x21, 0x75, 0x97, 0x42, 0x3b, 0x70, 0x2a, 0x5d, 0x12, 0xc1, 0x78, 0x5d, 0xdb, 0xeb,
-	0x2a, 0x27, 0x07, 0x30, 0x1e, 0xa5, 0x92, 0x27, 0x53, 0xbb, 0xad, 0x18, 0xa8, 0x12, 0xda, 0xdf,
-	0x1f, 0x0d, 0x32, 0x0b, 0xe9, 0x1e, 0xd4, 0x08, 0x83, 0xfb, 0xe3, 0x43, 0x19, 0xdc, 0xe0, 0xf8,
-	0xe0, 0xe9, 0x11, 0x1d, 0x1f, 0x3c, 0xfb, 0x65, 0x8f, 0x0f, 0x54, 0x2d, 0xbd, 0x8e, 0x23, 0x2c,
-	0xcf, 0xda, 0x36, 0x69, 0x49, 0x57, 0x11, 0xf2, 0x41, 0x49, 0x17, 0xab, 0xe5, 0xd0, 0x70, 0xa3,
-	0xe3, 0x61, 0x43, 0x1a, 0x47, 0x12, 0x10, 0xd3, 0xed, 0x84, 0x8f, 0xb3, 0xea, 0x5f, 0x17, 0x60,
-	0x21, 0xf5, 0x3d, 0x1e, 0x5b, 0x9b, 0xf0, 0xed, 0x84, 0x4d, 0x38, 0x3f, 0x72, 0xdd, 0x24, 0xad,
-	0xc2, 0x2a, 0x14, 0xea, 0x24, 0x20, 0x3c, 0x70, 0x11, 0x69, 0x9e, 0x91, 0x09, 0x85, 0xda, 0x89,
-	0xb3, 0x79, 0xaa, 0x48, 0x4f, 0x0e, 0xa3, 0x48, 0x46, 0xa4, 0x48, 0x7c, 0x29, 0xbe, 0x15, 0x53,
-	0xa4, 0xeb, 0xa9, 0x8a, 0x34, 0xd4, 0x53, 0x0e, 0x97, 0x63, 0x74, 0x50, 0xd5, 0x06, 0x29, 0xf9,
-	0x30, 0xb5, 0x34, 0x32, 0x79, 0xd5, 0xe1, 0x5c, 0xaf, 0xab, 0xbc, 0x34, 0x20, 0xc0, 0x11, 0x6f,
-	0x79, 0x68, 0x33, 0x89, 0x2b, 0x0c, 0xe8, 0xf7, 0x32, 0x30, 0x97, 0xec, 0x52, 0x58, 0xbb, 0x24,
-	0xfa, 0x99, 0xed, 0xe3, 0x73, 0xe8, 0xf1, 0xce, 0x26, 0xc4, 0xa8, 0x18, 0xe8, 0x4d, 0x98, 0xa8,
-	0x75, 0x76, 0x87, 0xb9, 0x26, 0xe9, 0xb5, 0xa9, 0x65, 0x42, 0x44, 0x6b, 0x53, 0x29, 0x39, 0x7a,
-	0x13, 0xf2, 0xf4, 0x87, 0xb0, 0xe4, 0x69, 0x6d, 0x2a, 0xc7, 0x1b, 0x59, 0x9b, 0x4a, 0x89, 0x99,
-	0x51, 0xa4, 0x5a, 0xe5, 0xa6, 0x3a, 0x1b, 0x43, 0x8c, 0x22, 0xcd, 0x69, 0x30, 0xa3, 0xc8, 0x18,
-	0xa0, 0x9b, 0x5c, 0xaf, 0x5d, 0xc1, 0xa1, 0x38, 0x47, 0x8c, 0x62, 0x80, 0xda, 0xeb, 0x2a, 0x27,
-	0x99, 0x50, 0x29, 0x06, 0x91, 0x91, 0x56, 0x0c, 0xf4, 0x3e, 0x4c, 0x89, 0x47, 0xef, 0x9f, 0x1e,
-	0xfa, 0xe8, 0x5d, 0x64, 0xa7, 0x5e, 0x1a, 0x5d, 0xac, 0x06, 0x90, 0xa3, 0x12, 0x1b, 0xd2, 0x98,
-	0xfa, 0x57, 0xe3, 0x50, 0x8a, 0x15, 0x11, 0x1c, 0x5b, 0xbb, 0xb5, 0x02, 0x59, 0xd3, 0xc7, 0x2d,
-	0x6e, 0xb5, 0xce, 0x0e, 0xac, 0x92, 0x58, 0x22, 0xff, 0x69, 0x14, 0x37, 0x35, 0x8a, 0xb9, 0x0b,
-	0x13, 0xce, 0x8e, 0x1d, 0x9d, 0xbc, 0x0f, 0x8a, 0x30, 0xd3, 0xd5, 0x98, 0x96, 0x35, 0x50, 0x35,
-	0xa6, 0x4c, 0x88, 0x1a, 0xd3, 0x1f, 0xc9, 0x12, 0x6b, 0x8e, 0x37, 0x52, 0x8d, 0x29, 0x71, 0xc5,
-	0x50, 0xe7, 0x20, 0x4b, 0xdf, 0x8e, 0xf8, 0x52, 0xd5, 0x5f, 0x8c, 0x43, 0x51, 0x3c, 0xf6, 0x3b,
-	0xb6, 0xef, 0xee, 0x5b, 0x30, 0xe9, 0x62, 0x9d, 0x72, 0x30, 0x0e, 0xc0, 0x21, 0x47, 0x88, 0x56,
-	0x7d, 0x74, 0x81, 0x2c, 0x4c, 0xb3, 0xbe, 0x25, 0x9c, 0xb9, 0x14, 0xd9, 0xba, 0x34, 0xeb, 0x5b,
-	0x1b, 0xda, 0x5d, 0xb2, 0xf8, 0xc8, 0x2f, 0xd7, 0x42, 0x12, 0x8c, 0xb7, 0xbc, 0x60, 0x5f, 0x21,
-	0x3f, 0x59, 0xa9, 0x70, 0xd3, 0xe3, 0x97, 0x91, 0xe9, 0xef, 0xdf, 0x9c, 0xe2, 0x0b, 0xf5, 0x4f,
-	0xb3, 0x90, 0x63, 0x09, 0xe4, 0x63, 0xfb, 0x72, 0x2f, 0x42, 0x76, 0x53, 0xf7, 0x36, 0xd9, 0xe1,
-	0xc3, 0x90, 0xbb, 0xa5, 0x9b, 0x3c, 0x8b, 0xb9, 0xad, 0x5b, 0x1d, 0x56, 0x34, 0x3e, 0xae, 0xb1,
-	0x06, 0x5a, 0x86, 0xf9, 0x96, 0xfe, 0xb8, 0xda, 0x77, 0xbb, 0x82, 0xe5, 0x3f, 0x51, 0x4b, 0x7f,
-	0xfc, 0x30, 0x71, 0xc1, 0xe2, 0x48, 0xf3, 0x89, 0xd7, 0x52, 0xf2, 0x89, 0x2f, 0x24, 0xf2, 0x89,
-	0xc5, 0xb8, 0xb5, 0x0f, 0xd3, 0x82, 0xdf, 0x89, 0x5b, 0x7b, 0x7e, 0x2c, 0xf5, 0x42, 0xff, 0x01,
-	0xc1, 0xc1, 0x4d, 0xfd, 0x9f, 0x4f, 0x80, 0x94, 0xa4, 0x3d, 0xce, 0xa9, 0xa6, 0x20, 0x32, 0xe4,
-	0xf7, 0xbb, 0x79, 0x53, 0x08, 0x6b, 0x9e, 0x1c, 0x69, 0x58, 0xf3, 0xd1, 0x91, 0x84, 0x35, 0xff,
-	0xfb, 0x55, 0x51, 0x77, 0x20, 0xc7, 0x0e, 0x90, 0x02, 0x97, 0x2b, 0xed, 0xf4, 0x69, 0x80, 0x8f,
-	0x43, 0x1f, 0x32, 0x1f, 0x87, 0x19, 0x99, 0x0a, 0x14, 0xd8, 0x2f, 0xc1, 0xef, 0xa2, 0x33, 0x14,
-	0xa0, 0x8e, 0x9c, 0x21, 0x46, 0x5e, 0x31, 0xd4, 0x9f, 0x16, 0x61, 0x4a, 0xc8, 0x9f, 0x1e, 0x5b,
-	0xcd, 0x5c, 0x86, 0xac, 0xbf, 0xdb, 0x0e, 0x0a, 0x8b, 0x5f, 0x18, 0x90, 0x1e, 0x5e, 0x7a, 0xb0,
-	0xdb, 0xc6, 0x1a, 0xc5, 0x8c, 0x1f, 0x00, 0xe1, 0xc4, 0x01, 0x90, 0xa0, 0xe8, 0x8d, 0xb8, 0xa2,
-	0x9f, 0x86, 0xbc, 0xee, 0x36, 0x3b, 0xf4, 0x51, 0x93, 0xdf, 0x93, 0xe0, 0xed, 0xd0, 0x53, 0xd9,
-	0x14, 0x3c, 0x95, 0x2f, 0x17, 0xc6, 0xf0, 0x85, 0xf1, 0x3b, 0x19, 0x98, 0x4f, 0x2b, 0x77, 0x0d,
-	0xd6, 0xc9, 0x48, 0x97, 0xfb, 0x62, 0xaf, 0xab, 0x9c, 0x1b, 0x9c, 0x0f, 0x8a, 0x30, 0x89, 0xe0,
-	0x73, 0x29, 0x05, 0xb0, 0xe8, 0x91, 0x78, 0x4e, 0x22, 0x6c, 0x5f, 0xd1, 0xe2, 0xfa, 0xc6, 0x5e,
-	0x57, 0x59, 0x48, 0x65, 0x39, 0x6a, 0x98, 0x0b, 0x29, 0x1d, 0x56, 0x0c, 0xf5, 0x67, 0x13, 0x90,
-	0x25, 0xba, 0x98, 0xac, 0xb9, 0x9d, 0x85, 0x52, 0xb9, 0xb3, 0x7b, 0x25, 0xec, 0x4a, 0xca, 0x20,
-	0x04, 0xd3, 0xe5, 0xce, 0xee, 0xd5, 0xe8, 0x22, 0xbc, 0x34, 0x86, 0xe6, 0x60, 0x86, 0xa0, 0x2d,
-	0x0b, 0xc0, 0x71, 0x0e, 0x5c, 0x11, 0x81, 0x59, 0x0e, 0xbc, 0x2a, 0x02, 0x27, 0xd0, 0x09, 0x40,
-	0x5c, 0x1a, 0x2c, 0x74, 0x05, 0xe8, 0x24, 0xcc, 0x05, 0x70, 0xb1, 0xbf, 0x29, 0x24, 0xc3, 0x7c,
-	0x48, 0x20, 0xb2, 0x2a, 0x8a, 0x4f, 0x62, 0x3d, 0x97, 0xc4, 0x27, 0xb1, 0xee, 0xa7, 0x89, 0x4c,
-	0x51, 0xf7, 0xd4, 0x10, 0x49, 0xf3, 0x68, 0x1e, 0xa4, 0xa8, 0x6f, 0x76, 0xd4, 0x2e, 0x2d, 0xa0,
-	0x05, 0x98, 0x15, 0x3a, 0xe6, 0xe0, 0x13, 0x22, 0x78, 0x25, 0x04, 0x9f, 0x14, 0xc1, 0x57, 0x43,
-	0xb0, 0x1c, 0x1b, 0xee, 0x72, 0x08, 0x3f, 0x45, 0xba, 0x64, 0x2b, 0x47, 0x98, 0x84, 0xb3, 0x84,
-	0x09, 0x83, 0xae, 0x08, 0x42, 0x2b, 0x11, 0x58, 0x9c, 0x99, 0x45, 0xc2, 0x9b, 0xf3, 0x10, 0xc7,
-	0xf8, 0x22, 0x81, 0xdf, 0xd4, 0x5d, 0x6b, 0x77, 0xd5, 0x70, 0xda, 0x3e, 0x76, 0x1f, 0x38, 0xed,
-	0x2b, 0xcb, 0xcb, 0xd2, 0x79, 0x32, 0xc5, 0xfd, 0xf0, 0x65, 0xe9, 0x02, 0x2a, 0x42, 0xfe, 0x9e,
-	0x65, 0x5c, 0xf9, 0x0e, 0xd6, 0x5d, 0x69, 0x85, 0xa8, 0xc5, 0x3d, 0xcb, 0x58, 0x21, 0x2d, 0x4f,
-	0xfa, 0x1a, 0x91, 0x74, 0x1d, 0xdb, 0xc6, 0x95, 0xfb, 0x1d, 0xcb, 0xe2, 0x97, 0x62, 0xa5, 0xf7,
-	0x88, 0x48, 0x04, 0xba, 0x22, 0x40, 0x3d, 0xe9, 0xbb, 0x01, 0xf8, 0x6a, 0x0c, 0xfc, 0x3e, 0x91,
-	0x88, 0xf2, 0x58, 0x26, 0x70, 0x37, 0x80, 0x7f, 0x0f, 0x15, 0x60, 0x62, 0xdd, 0xd7, 0x1b, 0x0d,
-	0xc9, 0x40, 0x33, 0x30, 0xb5, 0xe6, 0xd8, 0xbe, 0x6b, 0xd6, 0x3a, 0xbe, 0xe3, 0x4a, 0x54, 0x3b,
-	0xcb, 0x9d, 0xe6, 0xed, 0x8e, 0xed, 0x63, 0x57, 0x6a, 0x90, 0xe6, 0xdb, 0x8e, 0x81, 0x5d, 0x9d,
-	0x3c, 0x6d, 0x92, 0xf7, 0x78, 0x5b, 0xaf, 0x6f, 0x3d, 0xd8, 0xc4, 0xf7, 0x2d, 0xdd, 0x6f, 0x38,
-	0x6e, 0x4b, 0xda, 0x54, 0xb3, 0xf9, 0x57, 0xa4, 0x57, 0xd4, 0x3f, 0x91, 0x21, 0x4f, 0x43, 0x59,
-	0xd3, 0xdf, 0x3d, 0xb6, 0x7b, 0xca, 0x25, 0xc8, 0x6e, 0x99, 0xb6, 0xc1, 0xf7, 0x94, 0x53, 0xf1,
-	0x3d, 0x85, 0x8d, 0x6d, 0xe9, 0x8e, 0x69, 0x1b, 0x1a, 0x45, 0xfb, 0xd2, 0xd2, 0x8f, 0xb0, 0xf4,
-	0x41, 0xbc, 0xf6, 0xf4, 0x88, 0xe2, 0xb5, 0x67, 0x47, 0x76, 0x79, 0xec, 0xe3, 0x5f, 0xd3, 0xe5,
-	0xb1, 0x4f, 0x8e, 0xea, 0xf2, 0x98, 0x10, 0x39, 0x7d, 0x7a, 0xf8, 0xc8, 0xa9, 0x22, 0x46, 0x4e,
-	0x3f, 0x11, 0xb4, 0x6d, 0xbf, 0x35, 0xa8, 0x51, 0x20, 0xf5, 0xae, 0xf8, 0x99, 0x9c, 0xbf, 0x1d,
-	0xfa, 0x99, 0x1c, 0xe1, 0x13, 0x3e, 0x03, 0x3e, 0x93, 0x23, 0x7e, 0x0b, 0x47, 0x4b, 0x7c, 0x0b,
-	0xe7, 0xef, 0x98, 0x98, 0x4b, 0xfd, 0xdf, 0xc2, 0x19, 0x2a, 0x69, 0xec, 0x6b, 0x37, 0x6d, 0x90,
-	0x92, 0xdf, 0xb8, 0x90, 0x7f, 0xba, 0x8f, 0xbb, 0xf1, 0xe9, 0x09, 0xe0, 0x04, 0x16, 0x4d, 0x00,
-	0xd7, 0x13, 0x5f, 0x0a, 0xc3, 0xe2, 0x7d, 0xd8, 0xe8, 0xde, 0xfc, 0xdf, 0xb3, 0xc1, 0x7c, 0x7d,
-	0xaf, 0xab, 0xcc, 0xf6, 0xb1, 0x19, 0x35, 0xa4, 0xd9, 0x44, 0x27, 0xb1, 0x60, 0xe3, 0x1f, 0x8e,
-	0x38, 0xd8, 0xf8, 0xc7, 0xc3, 0x04, 0x1b, 0xa9, 0x19, 0xf7, 0xcf, 0x7e, 0xa5, 0x19, 0x77, 0x9c,
-	0x9e, 0x70, 0xff, 0x27, 0x61, 0xc2, 0xd3, 0x12, 0xee, 0xc3, 0x27, 0xbc, 0x3f, 0x9f, 0xfe, 0x3e,
-	0x4c, 0x89, 0x35, 0xf2, 0xff, 0x3c, 0x3c, 0x29, 0xa9, 0xf6, 0xba, 0xca, 0xd9, 0x54, 0x8b, 0x1b,
-	0x14, 0xb1, 0xab, 0x1a, 0x44, 0xd7, 0x0b, 0xe8, 0x41, 0x79, 0xbc, 0x04, 0xfe, 0x5f, 0xc4, 0x83,
-	0xf2, 0x03, 0x14, 0xbf, 0x17, 0x7d, 0xb1, 0xec, 0x7d, 0xc8, 0x71, 0xec, 0xbf, 0xfe, 0x26, 0x1d,
-	0xc7, 0xfe, 0xec, 0x57, 0x78, 0x1c, 0xfb, 0x18, 0x50, 0xff, 0x0d, 0x75, 0xb9, 0xcb, 0x86, 0x3f,
-	0xe2, 0x82, 0xfa, 0x85, 0x5e, 0x57, 0xf9, 0xea, 0x10, 0x0b, 0xc6, 0xf1, 0xc8, 0xb0, 0x67, 0xfb,
-	0x6e, 0xac, 0xa3, 0x2d, 0xf1, 0x9a, 0x5f, 0xf8, 0x9d, 0x11, 0xd3, 0x90, 0x7f, 0xce, 0x86, 0xfb,
-	0xda, 0x5e, 0x57, 0x99, 0x4b, 0x61, 0x36, 0x6a, 0xa8, 0x73, 0x7d, 0x5d, 0xd1, 0xeb, 0xa1, 0xfc,
-	0x6b, 0x24, 0xbf, 0x38, 0xc2, 0xaf, 0x91, 0xfc, 0xdb, 0x21, 0xbe, 0x46, 0xf2, 0x2e, 0x5f, 0x31,
-	0x26, 0xbd, 0x45, 0x28, 0xef, 0x0d, 0x14, 0x6b, 0xf0, 0x62, 0x61, 0x17, 0x10, 0xc3, 0xc5, 0xc2,
-	0xef, 0x23, 0x06, 0x8b, 0x85, 0x31, 0x26, 0x62, 0x7e, 0x9e, 0x58, 0x2c, 0x01, 0xdd, 0xbe, 0x16,
-	0x0b, 0x47, 0x36, 0xd4, 0xbf, 0x19, 0x83, 0x2c, 0x71, 0xf6, 0xe2, 0x27, 0x36, 0x12, 0x14, 0x89,
-	0xeb, 0x11, 0x7c, 0xe8, 0x41, 0xca, 0xd0, 0x80, 0xce, 0xc3, 0xee, 0x5d, 0xa7, 0x69, 0xda, 0xd2,
-	0x18, 0xf1, 0xba, 0x49, 0x73, 0x1d, 0xfb, 0xf7, 0x5d, 0xdc, 0xc0, 0x2e, 0xb6, 0xeb, 0x34, 0x58,
-	0x5b, 0x80, 0x59, 0x02, 0xa7, 0x25, 0xa4, 0x78, 0xb5, 0x4e, 0x33, 0xa5, 0x52, 0x96, 0x39, 0xe9,
-	0x71, 0xe3, 0xd7, 0xd9, 0x95, 0x26, 0xd0, 0x8b, 0x70, 0x26, 0x55, 0xf3, 0x83, 0xb8, 0x46, 0xca,
-	0x91, 0x38, 0x31, 0x96, 0x67, 0xc4, 0xd2, 0x24, 0x09, 0x27, 0xe9, 0x2c, 0x86, 0xf2, 0xe5, 0xd1,
-	0x22, 0xbc, 0x40, 0x41, 0x7d, 0x9a, 0xb5, 0x46, 0x9d, 0x67, 0xa9, 0x30, 0x18, 0x63, 0x83, 0x7a,
-	0xc6, 0x12, 0x90, 0x51, 0x93, 0x89, 0xa4, 0x14, 0xa6, 0x63, 0x4b, 0x53, 0xa4, 0xf3, 0x68, 0x6a,
-	0x49, 0x98, 0x21, 0x15, 0x49, 0xd0, 0x12, 0xc1, 0xd8, 0x69, 0xbc, 0x54, 0x52, 0x9f, 0x15, 0x20,
-	0x7b, 0xa3, 0xd3, 0x6a, 0xa3, 0xd7, 0x13, 0x95, 0x7d, 0xc3, 0x0b, 0xfb, 0x12, 0xb7, 0x89, 0xaf,
-	0x02, 0x08, 0x1f, 0xa8, 0x1b, 0xa3, 0xa4, 0xe9, 0x7e, 0x86, 0x26, 0x20, 0xa2, 0xdb, 0x30, 0x9b,
-	0xdc, 0x7f, 0x3d, 0x79, 0x7c, 0xe4, 0x17, 0x43, 0x35, 0x29, 0xb1, 0xc7, 0x7a, 0xe8, 0x9d, 0xf4,
-	0x2f, 0x5b, 0x64, 0xf7, 0xf1, 0x61, 0x8b, 0xb4, 0xcf, 0x57, 0xa0, 0xf7, 0x06, 0xd7, 0x6a, 0x4e,
-	0xec, 0xb3, 0x54, 0x73, 0x60, 0x41, 0xe6, 0x83, 0x41, 0x17, 0x8a, 0x73, 0xfb, 0x3a, 0xd4, 0x4c,
-	0xbf, 0x35, 0x8c, 0x5e, 0x8d, 0x0a, 0xea, 0x27, 0x07, 0xd5, 0xd3, 0x47, 0xd7, 0xca, 0xef, 0x00,
-	0xe2, 0x5e, 0x84, 0x28, 0x40, 0x7e, 0x74, 0x9e, 0x5d, 0x9b, 0xad, 0x27, 0x20, 0x1e, 0xba, 0x00,
-	0x39, 0x6a, 0x47, 0x3c, 0xb9, 0x40, 0x19, 0xf4, 0x9b, 0x0d, 0x8d, 0x23, 0xa0, 0x32, 0xcc, 0x98,
-	0xc1, 0xc1, 0x62, 0x95, 0x5d, 0xd1, 0x86, 0x11, 0x37, 0xb4, 0xb5, 0x69, 0x53, 0x6c, 0x7a, 0xe8,
-	0x8d, 0xe4, 0xcd, 0xbe, 0xa9, 0xe1, 0x17, 0xfb, 0x92, 0xd7, 0xf7, 0xde, 0x80, 0x92, 0xe8, 0xbe,
-	0x7b, 0x72, 0xb1, 0x9f, 0x5e, 0x0c, 0x07, 0xb4, 0x38, 0x3a, 0xfa, 0xff, 0x30, 0x9f, 0x76, 0xfd,
-	0x4f, 0x2e, 0xed, 0xe7, 0xf2, 0x8c, 0x36, 0x97, 0x72, 0xbf, 0x8f, 0xbc, 0x3c, 0xe6, 0xc5, 0x78,
-	0xf2, 0x74, 0xff, 0xcb, 0x63, 0x26, 0x48, 0x0b, 0x50, 0xc8, 0xb2, 0xe9, 0xff, 0x2a, 0xe4, 0xcc,
-	0xc8, 0x8f, 0x42, 0xa6, 0x7c, 0xc3, 0xf1, 0xe5, 0xe0, 0x22, 0x87, 0x94, 0x7e, 0x8f, 0x23, 0xb8,
-	0xad, 0xf1, 0x4d, 0x28, 0x8a, 0x57, 0x35, 0xe5, 0xd9, 0x61, 0x75, 0xc4, 0xda, 0x94, 0x70, 0x17,
-	0x93, 0x74, 0x41, 0xc2, 0x3c, 0x4f, 0x46, 0xfd, 0x5d, 0x50, 0x5b, 0xcd, 0x1e, 0xa3, 0x9b, 0x20,
-	0xf5, 0x5d, 0x78, 0x9a, 0x1b, 0x75, 0xdf, 0x49, 0x9b, 0xd9, 0x89, 0xdf, 0x66, 0x52, 0x7f, 0x37,
-	0x03, 0xd9, 0x8a, 0xdd, 0x70, 0xd0, 0x1b, 0x00, 0xbe, 0x5e, 0xb3, 0x70, 0xd5, 0x75, 0x76, 0x02,
-	0x6b, 0xa6, 0xc4, 0x95, 0xac, 0xe1, 0x2c, 0x3d, 0x20, 0x28, 0x9a, 0xb3, 0xe3, 0xdd, 0xb4, 0x7d,
-	0x77, 0x57, 0x2b, 0xf8, 0x41, 0xfb, 0xf4, 0x75, 0x98, 0x8e, 0x3f, 0x44, 0x12, 0x8c, 0x6f, 0xe1,
-	0xe0, 0x9b, 0x92, 0xe4, 0x67, 0x74, 0xf0, 0x36, 0xb6, 0x98, 0x39, 0x5f, 0xe2, 0x07, 0x6f, 0xd7,
-	0xc6, 0xbe, 0x91, 0x51, 0xbf, 0x0e, 0x05, 0xaa, 0xf8, 0xf4, 0xf3, 0xaa, 0xe7, 0x82, 0xaf, 0x11,
-	0x64, 0x06, 0x2d, 0x0f, 0xf6, 0x5c, 0xbd, 0x0e, 0xa5, 0xf0, 0xe5, 0x50, 0xca, 0x8b, 0x71, 0xca,
-	0x01, 0x26, 0x95, 0x53, 0xdf, 0x86, 0xb9, 0xc4, 0x1b, 0xa7, 0x3c, 0xae, 0xc4, 0x79, 0x0c, 0xd5,
-	0x10, 0xce, 0x69, 0x05, 0xf2, 0x74, 0xd3, 0x24, 0xe4, 0x2f, 0xc7, 0xc9, 0x53, 0xde, 0x1f, 0xa3,
-	0x29, 0x83, 0x24, 0x6a, 0x3b, 0xa5, 0x5d, 0x8a, 0xd3, 0x0e, 0x5e, 0x61, 0x9c, 0xc7, 0x6b, 0x00,
-	0x4c, 0x22, 0x4a, 0x7d, 0x3e, 0x4e, 0x9d, 0xb6, 0x24, 0x22, 0x79, 0x89, 0xfa, 0x8d, 0x94, 0x97,
-	0xa9, 0x34, 0xa3, 0xb9, 0x06, 0xc5, 0x20, 0x6b, 0x44, 0xe9, 0x5e, 0x89, 0xd3, 0xcd, 0xa7, 0xa5,
-	0x97, 0x38, 0xed, 0x2b, 0xb7, 0x61, 0x3a, 0x7e, 0xd9, 0x64, 0x70, 0xdd, 0x48, 0x09, 0x0a, 0xe1,
-	0x67, 0xf3, 0xa4, 0x31, 0x34, 0x0d, 0xb0, 0x6a, 0x3b, 0xf6, 0x6e, 0xcb, 0xfc, 0x10, 0x1b, 0xd2,
-	0x78, 0x79, 0xe5, 0xc9, 0xde, 0xd9, 0xcc, 0xa7, 0x7b, 0x67, 0x33, 0xbf, 0xd8, 0x3b, 0x9b, 0xf9,
-	0xe1, 0xe7, 0x67, 0x9f, 0xfb, 0xf4, 0xf3, 0xb3, 0xcf, 0x7d, 0xf6, 0xf9, 0xd9, 0xe7, 0xde, 0x93,
-	0x83, 0xfe, 0x2d, 0xdd, 0x36, 0x2e, 0x37, 0x9d, 0xcb, 0xed, 0xad, 0xe6, 0xe5, 0xf6, 0x8e, 0x51,
-	0xab, 0xe5, 0x68, 0xc2, 0xec, 0x6b, 0xff, 0x13, 0x00, 0x00, 0xff, 0xff, 0x9d, 0x30, 0xe3, 0x32,
-	0xe2, 0x5d, 0x00, 0x00,
+	// 6230 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0xdd, 0x73, 0x1c, 0xc7,
+	0x71, 0x38, 0x0f, 0x38, 0x1c, 0x70, 0x8d, 0x03, 0xb0, 0x18, 0x80, 0xe4, 0x92, 0x12, 0x79, 0xd0,
+	0xc9, 0x12, 0x49, 0x49, 0x04, 0x49, 0xd8, 0xd4, 0xcf, 0xa6, 0x68, 0xfd, 0x8c, 0x03, 0x28, 0xf1,
+	0x44, 0x52, 0x44, 0x16, 0xa4, 0x14, 0x4b, 0x72, 0x5d, 0x2d, 0x6e, 0x07, 0x87, 0x15, 0xf6, 0x76,
+	0x4f, 0xbb, 0x7b, 0x00, 0x4f, 0x55, 0xa9, 0xca, 0x43, 0x9c, 0xe4, 0x29, 0xe5, 0x2a, 0xa7, 0x2a,
+	0x95, 0x54, 0xfe, 0x82, 0x3c, 0xe6, 0x31, 0x95, 0x3c, 0xe5, 0x85, 0x92, 0x49, 0x4a, 0xce, 0xa7,
+	0xf3, 0xe1, 0xb3, 0x03, 0x3d, 0xe4, 0xfd, 0x2a, 0xc9, 0x43, 0xf2, 0x92, 0xea, 0x99, 0xd9, 0xdd,
+	0xd9, 0xbd, 0xbd, 0x3b, 0xc0, 0x84, 0x1d, 0xc3, 0xd6, 0x0b, 0x79, 0xd3, 0xd3, 0xd3, 0xd3, 0x33,
+	0xdb, 0xd3, 0xd3, 0xdd, 0xd3, 0x33, 0x00, 0x68, 0xee, 0x1a, 0x1b, 0x8b, 0x4d, 0xd7, 0xf1, 0x1d,
+	0x02, 0x4d, 0xdd, 0xdf, 0xda, 0xd5, 0xdd, 0x45, 0x63, 0xe3, 0xf4, 0xc5, 0xba, 0xe9, 0x6f, 0xb5,
+	0x36, 0x16, 0x6b, 0x4e, 0xe3, 0x52, 0xdd, 0xa9, 0x3b, 0x97, 0x18, 0xca, 0x46, 0x6b, 0x93, 0x95,
+	0x58, 0x81, 0xfd, 0xe2, 0x4d, 0x4f, 0x17, 0xeb, 0x8e, 0x53, 0xb7, 0x68, 0x84, 0xe5, 0x9b, 0x0d,
+	0xea, 0xf9, 0x7a, 0xa3, 0xc9, 0x11, 0x4a, 0xff, 0x93, 0x85, 0xfc, 0xca, 0x96, 0x6e, 0x59, 0xd4,
+	0xae, 0x53, 0xf2, 0x2d, 0x18, 0x31, 0x0d, 0x35, 0xb3, 0x90, 0x39, 0x3f, 0x5a, 0xbe, 0xbc, 0xd7,
+	0x29, 0x8e, 0x54, 0x56, 0xbb, 0x9d, 0xe2, 0x8b, 0x75, 0xc7, 0x6d, 0x5c, 0x2b, 0x35, 0x5d, 0xb3,
+	0xa1, 0xbb, 0xed, 0xea, 0x36, 0x6d, 0x97, 0x16, 0xda, 0x7a, 0xc3, 0xba, 0x56, 0x32, 0x8d, 0x57,
+	0x9c, 0x86, 0xe9, 0xd3, 0x46, 0xd3, 0x6f, 0x97, 0xb4, 0x11, 0xd3, 0x20, 0x1b, 0x00, 0x35, 0x97,
+	0xea, 0x3e, 0x35, 0xaa, 0xba, 0xaf, 0x8e, 0x2c, 0x64, 0xce, 0x4f, 0x2e, 0x9d, 0x5e, 0xe4, 0x5c,
+	0x2c, 0x06, 0x5c, 0x2c, 0xde, 0x0b, 0xb8, 0x28, 0x9f, 0x7b, 0xd8, 0x29, 0x66, 0xba, 0x9d, 0xe2,
+	0x33, 0x9c, 0x60, 0xd4, 0x56, 0x22, 0xfc, 0xbd, 0x9f, 0x14, 0x33, 0x5a, 0x5e, 0x54, 0x2d, 0xfb,
+	0xd8, 0x47, 0xab, 0x69, 0x04, 0x7d, 0x8c, 0x1e, 0xb4, 0x8f, 0xa8, 0x6d, 0x4f, 0x1f, 0xa2, 0x6a,
+	0xd9, 0x27, 0x04, 0xb2, 0xb6, 0xde, 0xa0, 0xaa, 0xb1, 0x90, 0x39, 0x9f, 0xd7, 0xd8, 0x6f, 0xb2,
+	0x00, 0x93, 0x06, 0xf5, 0x6a, 0xae, 0xd9, 0xf4, 0x4d, 0xc7, 0x56, 0x29, 0xab, 0x92, 0x41, 0xe4,
+	0x04, 0xe4, 0xf4, 0x96, 0xbf, 0xe5, 0xb8, 0xea, 0x26, 0xab, 0x14, 0x25, 0x84, 0x5b, 0x4e, 0x4d,
+	0xb7, 0xa8, 0x5a, 0xe7, 0x70, 0x5e, 0x22, 0xa7, 0x60, 0xc2, 0xf4, 0xaa, 0x86, 0xab, 0x6f, 0xfa,
+	0xea, 0xd6, 0x42, 0xe6, 0xfc, 0x84, 0x36, 0x6e, 0x7a, 0xab, 0x58, 0x24, 0x97, 0x60, 0xb2, 0xe9,
+	0xd2, 0x1d, 0x93, 0xee, 0x56, 0x5b, 0xae, 0xa5, 0x9a, 0xd8, 0xae, 0x3c, 0xbd, 0xd7, 0x29, 0xc2,
+	0x1a, 0x07, 0xdf, 0xd7, 0x6e, 0x6b, 0x20, 0x50, 0xee, 0xbb, 0x16, 0x39, 0x0d, 0x13, 0x5b, 0x4e,
+	0x83, 0x36, 0xf5, 0x3a, 0x55, 0x3f, 0x64, 0xbd, 0x84, 0x65, 0xf2, 0x32, 0x64, 0x3d, 0xab, 0x55,
+	0x57, 0xb7, 0x19, 0x95, 0x93, 0xdd, 0x4e, 0x71, 0x8e, 0x7f, 0xd3, 0x96, 0x6d, 0x7e, 0xd4, 0xa2,
+	0x55, 0xd3, 0x36, 0xe8, 0x83, 0x92, 0xc6, 0x90, 0x88, 0x09, 0xe3, 0x9b, 0x96, 0xbe, 0xe3, 0xb8,
+	0x9e, 0xfa, 0x30, 0xb3, 0x30, 0x7a, 0x7e, 0x72, 0xe9, 0x99, 0xc5, 0x48, 0x02, 0x17, 0x43, 0x69,
+	0x79, 0x83, 0x21, 0x95, 0xaf, 0x74, 0x3b, 0xc5, 0x8b, 0x9c, 0xda, 0x9a, 0x76, 0xe3, 0xf6, 0xdd,
+	0xe5, 0xd5, 0x6b, 0x9b, 0xba, 0xe5, 0xd1, 0x40, 0x46, 0x04, 0x2d, 0x59, 0x50, 0x02, 0xfa, 0xa5,
+	0xff, 0x3c, 0x0e, 0x33, 0x09, 0x7a, 0x5f, 0xca, 0x60, 0x28, 0x83, 0x2a, 0x8c, 0xef, 0x50, 0xd7,
+	0x43, 0x59, 0xe3, 0x62, 0x18, 0x14, 0xc9, 0x2b, 0x00, 0x9e, 0xd3, 0x72, 0x6b, 0x94, 0xc9, 0xc6,
+	0x16, 0xfb, 0xaa, 0x53, 0x7b, 0x9d, 0x62, 0x7e, 0x9d, 0x41, 0x51, 0x34, 0xf2, 0x1c, 0x01, 0x25,
+	0xe3, 0xff, 0xc3, 0x74, 0xcd, 0x69, 0x34, 0x1d, 0x8f, 0x56, 0x37, 0x5a, 0xb6, 0x61, 0x51, 0x21,
+	0x4d, 0x6a, 0xb7, 0x53, 0x9c, 0xe7, 0xf3, 0xea, 0xb7, 0x9b, 0xf4, 0x9a, 0xe5, 0xd8, 0x75, 0x9f,
+	0x3e, 0xf0, 0x4b, 0xda, 0x94, 0xc0, 0x2f, 0x33, 0x74, 0x72, 0x13, 0x72, 0x86, 0x6b, 0xee, 0x50,
+	0x97, 0x09, 0xd6, 0xf4, 0x52, 0x69, 0x80, 0x3c, 0x2c, 0xae, 0x32, 0xcc, 0x72, 0xa1, 0xdb, 0x29,
+	0x4e, 0xf0, 0xc1, 0x5e, 0x2c, 0x69, 0xa2, 0x3d, 0xf9, 0x16, 0x4c, 0x37, 0x5b, 0x6e, 0x6d, 0x4b,
+	0xf7, 0x68, 0xb5, 0xe9, 0x9a, 0x35, 0xca, 0x44, 0x72, 0xb4, 0x7c, 0xaa, 0xdb, 0x29, 0x1e, 0xe7,
+	0xd8, 0xf1, 0xfa, 0x92, 0x36, 0x15, 0x00, 0xd6, 0xb0, 0x4c, 0x2a, 0x30, 0xbb, 0xa3, 0x5b, 0xa6,
+	0xa1, 0xe3, 0x82, 0xab, 0xba, 0x74, 0x57, 0x77, 0x0d, 0xd5, 0x62, 0x44, 0x9e, 0xed, 0x76, 0x8a,
+	0x2a, 0x27, 0xd2, 0x83, 0x52, 0xd2, 0x94, 0x08, 0xa6, 0x31, 0x50, 0xb8, 0x2a, 0x1a, 0xfb, 0x59,
+	0x15, 0x04, 0xb2, 0x1b, 0x8e, 0xd1, 0x56, 0x6d, 0xae, 0x10, 0xf0, 0x37, 0x2a, 0x84, 0xa6, 0xee,
+	0x79, 0xcd, 0x2d, 0x57, 0xf7, 0xa8, 0xa7, 0x3a, 0xc8, 0x85, 0x26, 0x83, 0x70, 0x51, 0xd6, 0x74,
+	0x9f, 0xd6, 0x1d, 0xb7, 0xad, 0x36, 0xf9, 0xa2, 0x0c, 0xca, 0x64, 0x01, 0xb2, 0xbe, 0x5e, 0xf7,
+	0xd4, 0x8f, 0x16, 0x46, 0xcf, 0xe7, 0xf9, 0x7c, 0xf1, 0xee, 0x2f, 0x96, 0x34, 0x56, 0x43, 0xce,
+	0xc1, 0x84, 0xaf, 0xd7, 0xab, 0x96, 0xe9, 0xf9, 0xaa, 0xbb, 0x90, 0x09, 0xb0, 0xc2, 0x59, 0x1d,
+	0xf7, 0xf5, 0xfa, 0x6d, 0xd3, 0xf3, 0x49, 0x13, 0xa6, 0x5c, 0x6a, 0xb4, 0x1a, 0xcd, 0x6a, 0xd3,
+	0xb1, 0xcc, 0x5a, 0x5b, 0xf5, 0xd8, 0xba, 0x3d, 0x3f, 0xe8, 0x3b, 0x69, 0xac, 0xc1, 0x1a, 0xc3,
+	0x2f, 0x3f, 0xd7, 0xed, 0x14, 0xcf, 0x04, 0xbd, 0x8b, 0x85, 0xc5, 0x29, 0x5e, 0xe4, 0x14, 0x4b,
+	0x5a, 0xc1, 0x95, 0x1a, 0x90, 0xd7, 0x61, 0x3e, 0xd6, 0x63, 0xb5, 0xe6, 0xd8, 0x9b, 0x66, 0x5d,
+	0xf5, 0x53, 0xd8, 0x24, 0x72, 0xcb, 0x15, 0x86, 0x47, 0x76, 0xa0, 0xd0, 0x74, 0x9d, 0x07, 0xed,
+	0x80, 0xe1, 0x16, 0x5b, 0x41, 0xe7, 0x06, 0x31, 0xbc, 0x86, 0xf8, 0x82, 0xdf, 0x97, 0x22, 0x95,
+	0x10, 0xf2, 0xcb, 0xe8, 0x09, 0x76, 0x65, 0x95, 0x30, 0xd9, 0x8c, 0x1a, 0x92, 0xeb, 0x30, 0x27,
+	0xf7, 0x1b, 0xb0, 0xbd, 0x93, 0xc2, 0xf6, 0xac, 0xd4, 0x4e, 0x70, 0xfd, 0x3e, 0xcc, 0x37, 0xa9,
+	0x5b, 0x6d, 0x79, 0xd4, 0xad, 0xca, 0x5f, 0x7e, 0x17, 0x75, 0x77, 0xf9, 0x42, 0xb7, 0x53, 0x7c,
+	0x41, 0xf0, 0x42, 0xdd, 0x8b, 0x88, 0x75, 0x51, 0xc2, 0x92, 0x79, 0x22, 0x4d, 0xea, 0xde, 0xf7,
+	0xa8, 0xbb, 0x16, 0x55, 0x93, 0xaf, 0x42, 0xae, 0xe9, 0x98, 0xb6, 0xef, 0xa9, 0x0f, 0x98, 0x38,
+	0x3f, 0xd3, 0xed, 0x14, 0x4f, 0x0a, 0x72, 0x0c, 0x2e, 0x13, 0x10, 0xa8, 0xc4, 0x85, 0x7c, 0x2d,
+	0x98, 0x27, 0x54, 0xd7, 0x38, 0x8b, 0xc7, 0x53, 0x67, 0xb1, 0x7c, 0xbd, 0xdb, 0x29, 0x7e, 0x9d,
+	0xcf, 0xd9, 0xa6, 0xe3, 0x52, 0xb3, 0x6e, 0x6f, 0xd3, 0xf6, 0xb5, 0xb0, 0xbe, 0xb2, 0x1a, 0x4c,
+	0x64, 0x48, 0x50, 0xee, 0x30, 0xea, 0x86, 0x34, 0xa1, 0x10, 0x16, 0xaa, 0xa6, 0xa1, 0x7e, 0xc2,
+	0x95, 0xf5, 0xed, 0xbd, 0x4e, 0x71, 0x52, 0x22, 0xd7, 0xed, 0x14, 0xbf, 0xe1, 0x7d, 0x64, 0x5d,
+	0x2b, 0xd9, 0x8e, 0xbf, 0x60, 0xb7, 0x2c, 0xab, 0xb4, 0xc0, 0x7b, 0xe7, 0xeb, 0x2a, 0xd9, 0x59,
+	0x35, 0xae, 0xc8, 0x27, 0xc3, 0x8a, 0x8a, 0x41, 0xfe, 0x24, 0x03, 0xb3, 0x1e, 0xd5, 0x3d, 0xc7,
+	0xae, 0x86, 0x60, 0x4f, 0xfd, 0x34, 0x65, 0x77, 0x5a, 0x67, 0x58, 0xd1, 0xa0, 0xef, 0x76, 0x3b,
+	0xc5, 0x5b, 0x29, 0xbb, 0xd3, 0x6b, 0xd2, 0x14, 0x70, 0xf9, 0x8a, 0xc6, 0xdf, 0xd3, 0x93, 0xcc,
+	0x97, 0xe2, 0xc5, 0x7b, 0xf0, 0xc8, 0x77, 0x33, 0x90, 0x37, 0x6d, 0xcf, 0xd7, 0xed, 0x1a, 0xf5,
+	0xd4, 0x1f, 0x70, 0xa6, 0xce, 0xa4, 0x7e, 0x83, 0x8a, 0x40, 0x2b, 0xbf, 0xd9, 0xed, 0x14, 0x57,
+	0x0e, 0xc8, 0x56, 0xd8, 0x47, 0xec, 0xb3, 0x84, 0xd0, 0xd3, 0x1f, 0x40, 0x41, 0x5e, 0xd3, 0xa8,
+	0x7b, 0x3c, 0xdf, 0x45, 0x6d, 0xd3, 0x66, 0xdb, 0x69, 0x5e, 0x0b, 0xcb, 0xe4, 0x32, 0x8c, 0x19,
+	0xd4, 0xd2, 0xdb, 0x6c, 0x77, 0xcc, 0x97, 0x4f, 0x77, 0x3b, 0xc5, 0x13, 0xbc, 0x17, 0x06, 0x96,
+	0x7b, 0xe0, 0x88, 0xa7, 0xff, 0x30, 0x07, 0x93, 0xd2, 0x0a, 0x24, 0xef, 0xc1, 0x7c, 0xcd, 0x32,
+	0xa9, 0xed, 0x57, 0x1b, 0xfa, 0x83, 0x2a, 0xaa, 0xc3, 0xaa, 0x67, 0x7e, 0x4c, 0x79, 0x4f, 0xf2,
+	0x52, 0x48, 0xc3, 0x92, 0xe9, 0xcf, 0x72, 0x84, 0x3b, 0xfa, 0x83, 0xb2, 0x63, 0xb4, 0xd7, 0xcd,
+	0x8f, 0x29, 0xb9, 0x03, 0x33, 0x35, 0xc7, 0xb6, 0x69, 0xcd, 0xaf, 0xa2, 0xbd, 0xea, 0xb4, 0x7c,
+	0xc1, 0xe7, 0x57, 0xba, 0x9d, 0x62, 0x20, 0x37, 0x71, 0x04, 0x99, 0xe2, 0xb4, 0xa8, 0xbb, 0xc7,
+	0xab, 0xc8, 0x2a, 0x14, 0x3c, 0x6a, 0x1b, 0x21, 0xad, 0x51, 0x46, 0x8b, 0xa9, 0xbc, 0xe0, 0x83,
+	0xdb, 0x46, 0x1a, 0xa1, 0x49, 0xac, 0x90, 0xa8, 0xb8, 0x54, 0x8f, 0xa8, 0x64, 0x93, 0x54, 0xe4,
+	0xda, 0x18, 0x15, 0xac, 0x08, 0xa8, 0x58, 0x30, 0xbe, 0x45, 0x75, 0x83, 0xba, 0x9e, 0x3a, 0xc6,
+	0x04, 0xe5, 0x6b, 0xfb, 0x54, 0x79, 0x8b, 0x37, 0x79, 0xb3, 0x1b, 0xb6, 0xef, 0xb6, 0xe5, 0xad,
+	0x4e, 0x90, 0x8b, 0xd9, 0x57, 0x02, 0x46, 0xd6, 0x61, 0xd6, 0x30, 0x3d, 0x7d, 0xc3, 0xa2, 0xd5,
+	0x5d, 0xba, 0xe1, 0x39, 0xb5, 0x6d, 0xea, 0xab, 0x39, 0xa6, 0xac, 0x5e, 0xec, 0x76, 0x8a, 0x25,
+	0xf1, 0xc9, 0x93, 0x28, 0x31, 0x79, 0x17, 0xb5, 0xef, 0x06, 0x95, 0xe4, 0x75, 0x00, 0x94, 0xa2,
+	0xaa, 0x65, 0x36, 0x4c, 0x5f, 0x1d, 0x67, 0x6b, 0xbf, 0x18, 0x99, 0x36, 0x51, 0x5d, 0x4c, 0x4e,
+	0x11, 0x7c, 0x1b, 0xa1, 0xe4, 0x2e, 0x28, 0x11, 0x4e, 0x75, 0xa3, 0xe5, 0x7a, 0xbe, 0x3a, 0xc1,
+	0xa8, 0xbc, 0xd0, 0xed, 0x14, 0x9f, 0x4b, 0x52, 0xe1, 0x18, 0xb1, 0xef, 0x1b, 0xd2, 0x2a, 0x63,
+	0x15, 0x32, 0x84, 0x5f, 0x5c, 0x30, 0x94, 0x4f, 0x32, 0x14, 0xd5, 0xc5, 0xf5, 0x99, 0x63, 0xdb,
+	0x8c, 0xc8, 0xe9, 0x6b, 0x50, 0x90, 0x27, 0x97, 0x28, 0x30, 0xba, 0x4d, 0x83, 0x35, 0x83, 0x3f,
+	0xc9, 0x3c, 0x8c, 0xed, 0xe8, 0x56, 0x8b, 0x72, 0x31, 0xd4, 0x78, 0xe1, 0xda, 0xc8, 0xd7, 0x33,
+	0xa5, 0xaf, 0x41, 0x8e, 0x1b, 0x3c, 0x64, 0x12, 0xc6, 0xef, 0xdb, 0xdb, 0xb6, 0xb3, 0x6b, 0x2b,
+	0xc7, 0x08, 0x40, 0x6e, 0x15, 0x67, 0xcb, 0x55, 0x32, 0x64, 0x16, 0xa6, 0xf8, 0xef, 0x15, 0x6e,
+	0x54, 0x29, 0x23, 0xa5, 0xff, 0xca, 0xc1, 0x4c, 0x42, 0x53, 0x91, 0x57, 0x24, 0xbb, 0xf7, 0xd9,
+	0xd0, 0xee, 0x25, 0xbd, 0x76, 0x2f, 0xb3, 0x71, 0x57, 0x0e, 0x68, 0xe3, 0x4e, 0xa0, 0xfd, 0x99,
+	0x34, 0x62, 0x57, 0x0e, 0x68, 0xc4, 0x4a, 0x44, 0x62, 0x9e, 0x12, 0xb3, 0xa2, 0x84, 0xa7, 0x84,
+	0xbf, 0xc9, 0x57, 0xc2, 0xad, 0x8c, 0xb2, 0xf1, 0xc4, 0x8d, 0x1b, 0x51, 0x87, 0x58, 0x9e, 0x63,
+	0xed, 0x50, 0x4f, 0xdd, 0x4c, 0xc3, 0xe2, 0x75, 0x64, 0x0d, 0x0a, 0x9b, 0xa6, 0xeb, 0xf9, 0xd5,
+	0x0d, 0xcb, 0x71, 0x0c, 0x4f, 0xad, 0xb3, 0x65, 0x53, 0x4c, 0x5d, 0x36, 0xef, 0x84, 0x26, 0x5e,
+	0x82, 0xd8, 0x24, 0x23, 0x51, 0x66, 0x14, 0xc8, 0x3d, 0xc8, 0x71, 0x07, 0x24, 0xd8, 0x30, 0x07,
+	0xfa, 0x37, 0x67, 0xbb, 0x9d, 0xe2, 0xe9, 0x9e, 0x6d, 0x33, 0x54, 0xce, 0x9a, 0xa0, 0x45, 0x1e,
+	0x40, 0x9e, 0xff, 0x92, 0xb6, 0xc4, 0xf7, 0xf6, 0x3a, 0xc5, 0x89, 0x00, 0xb5, 0xdb, 0x29, 0xbe,
+	0xd5, 0x7f, 0x3f, 0x7c, 0x4d, 0x36, 0x3a, 0xaf, 0x99, 0xc6, 0x83, 0x2a, 0xdf, 0x68, 0xa2, 0xfd,
+	0x51, 0x50, 0xe7, 0xe0, 0x92, 0x36, 0xc1, 0xcb, 0x15, 0x83, 0xdc, 0x82, 0x1c, 0x07, 0xaa, 0x9f,
+	0xf2, 0xf1, 0x90, 0xde, 0x1d, 0xb1, 0xcf, 0x30, 0x78, 0x25, 0x1b, 0x06, 0x27, 0x81, 0xc3, 0xe0,
+	0xbf, 0x70, 0x18, 0x3f, 0x90, 0x86, 0x11, 0xa0, 0x1e, 0xf6, 0x30, 0xf8, 0x8f, 0x0a, 0xba, 0x6d,
+	0x53, 0x5e, 0x6b, 0x23, 0x74, 0xa6, 0x3d, 0xf5, 0x11, 0xdf, 0x4a, 0x9f, 0x4b, 0xfd, 0x3a, 0xeb,
+	0x12, 0xaa, 0xec, 0xc9, 0xc4, 0x7d, 0x50, 0x2d, 0x4e, 0xb2, 0xf4, 0x97, 0x00, 0xb3, 0x3d, 0xbb,
+	0xf1, 0x91, 0x5d, 0x7a, 0xd7, 0x21, 0xe7, 0xf9, 0xba, 0xdf, 0xf2, 0xd8, 0xe2, 0x9b, 0x5e, 0xfa,
+	0xca, 0x40, 0xa3, 0x63, 0x71, 0x9d, 0xe1, 0x6a, 0xa2, 0x0d, 0xb9, 0x0d, 0x33, 0x96, 0xee, 0xf9,
+	0x55, 0xcf, 0xd7, 0x5d, 0xc1, 0x07, 0x3d, 0x00, 0x1f, 0x53, 0xd8, 0x78, 0x9d, 0xb7, 0x5d, 0xf6,
+	0x25, 0x6a, 0x4e, 0xb3, 0xc9, 0xa9, 0x6d, 0x1e, 0x9c, 0x1a, 0x6b, 0xbb, 0xec, 0x93, 0xef, 0x80,
+	0xca, 0xa8, 0x09, 0x1f, 0xc3, 0xa5, 0x1f, 0xb5, 0xa8, 0x27, 0x98, 0xac, 0x1f, 0x80, 0xec, 0x71,
+	0xa4, 0xc2, 0xad, 0x22, 0x2d, 0xa0, 0xb1, 0xec, 0x93, 0xe7, 0x61, 0x8a, 0x8d, 0xba, 0xd5, 0xac,
+	0x52, 0xd7, 0x75, 0x5c, 0xee, 0x42, 0x6b, 0x05, 0x01, 0xbc, 0x81, 0x30, 0xf2, 0x1c, 0x08, 0x97,
+	0xa7, 0x5a, 0x73, 0x5a, 0xb6, 0xcf, 0x9c, 0xe6, 0x51, 0x6d, 0x92, 0xc3, 0x56, 0x10, 0x44, 0x2e,
+	0x80, 0xe4, 0x55, 0x0a, 0xb4, 0x0f, 0x19, 0xda, 0x4c, 0x04, 0xe7, 0xa8, 0xe7, 0x60, 0x26, 0x30,
+	0xd5, 0x02, 0xa7, 0x03, 0x5d, 0xdf, 0x82, 0x36, 0x1d, 0x80, 0x85, 0x8f, 0x11, 0xe8, 0x53, 0x4b,
+	0xd2, 0xa7, 0x17, 0x40, 0x09, 0xf8, 0xd5, 0x7d, 0xb6, 0x83, 0x79, 0xcc, 0x6b, 0x1d, 0xd5, 0x66,
+	0x04, 0x7c, 0x59, 0x80, 0xc9, 0xfb, 0x70, 0x32, 0xfa, 0xaa, 0x11, 0x3e, 0x4e, 0x9c, 0x7d, 0x80,
+	0x89, 0x9b, 0x0f, 0xbf, 0x6e, 0x48, 0x7b, 0xd9, 0x27, 0x6f, 0xc2, 0x98, 0x5e, 0xa7, 0xb6, 0x1f,
+	0x28, 0xce, 0x59, 0x59, 0xe0, 0x96, 0xb1, 0xa6, 0x7c, 0xa6, 0xdb, 0x29, 0x9e, 0xea, 0xd1, 0x33,
+	0xac, 0x0e, 0xd5, 0x0c, 0x6f, 0x4f, 0xde, 0x80, 0x09, 0xf6, 0x43, 0xd2, 0x95, 0x2f, 0xed, 0x75,
+	0x8a, 0xe3, 0x02, 0x0f, 0x37, 0xef, 0x01, 0xae, 0x83, 0x36, 0xce, 0x1a, 0x57, 0x0c, 0x49, 0x95,
+	0x7f, 0x7a, 0x88, 0xaa, 0xbc, 0x22, 0xab, 0x72, 0xa1, 0x03, 0x5f, 0x4e, 0xa8, 0xf2, 0x81, 0xfc,
+	0x45, 0xba, 0xf9, 0x55, 0xc8, 0xdb, 0x75, 0xd3, 0x7e, 0xc0, 0x02, 0x35, 0xff, 0xcd, 0x8d, 0x63,
+	0x15, 0x49, 0xbd, 0x8d, 0xd0, 0xfb, 0xda, 0xed, 0xd8, 0x36, 0x35, 0xc1, 0x70, 0xef, 0xbb, 0x56,
+	0xe9, 0xfb, 0x19, 0xc8, 0xf1, 0xf5, 0x1a, 0x37, 0x2c, 0xf2, 0x30, 0x56, 0xf1, 0xde, 0xa6, 0xbb,
+	0x4a, 0x86, 0xcc, 0xc1, 0xcc, 0x72, 0xad, 0x46, 0x9b, 0x3e, 0x35, 0xca, 0x6d, 0x36, 0x71, 0xca,
+	0x08, 0x99, 0x82, 0xfc, 0xf2, 0x8e, 0x6e, 0x5a, 0x68, 0xb2, 0x29, 0xa3, 0x64, 0x1a, 0xe0, 0x6d,
+	0x4a, 0x0d, 0xbe, 0x02, 0x94, 0x2c, 0x29, 0xc0, 0xc4, 0x2a, 0xb7, 0xe7, 0x0c, 0x65, 0x0c, 0x2d,
+	0x13, 0xf1, 0x89, 0xdf, 0xd0, 0x4d, 0x04, 0xe5, 0xb0, 0x3d, 0x6f, 0xe0, 0x51, 0x5f, 0x19, 0xc7,
+	0xa2, 0xd8, 0x53, 0xa9, 0xa1, 0x4c, 0x94, 0xfe, 0x7a, 0x02, 0xc6, 0x58, 0x4f, 0x47, 0xd9, 0x5a,
+	0xe9, 0x89, 0xeb, 0xb2, 0xc8, 0xa9, 0xe7, 0x33, 0x38, 0x0d, 0x22, 0xa7, 0xbc, 0x4c, 0x4e, 0xc0,
+	0x88, 0xc3, 0xed, 0x93, 0x7c, 0x39, 0x87, 0xe3, 0xbc, 0xbb, 0xae, 0x8d, 0x38, 0x1e, 0xb9, 0x1c,
+	0xaa, 0xde, 0x3a, 0x53, 0xbd, 0x6a, 0xcf, 0x4a, 0x48, 0xaa, 0xdb, 0x93, 0x30, 0x4e, 0x5d, 0xb7,
+	0xda, 0xf0, 0xea, 0x42, 0xdb, 0xe4, 0xa8, 0xeb, 0xde, 0xf1, 0xd8, 0x82, 0xd7, 0xdd, 0xda, 0x16,
+	0x0f, 0xca, 0x69, 0xec, 0xb7, 0x1c, 0xfa, 0xfb, 0x30, 0x1e, 0xfa, 0x23, 0x22, 0x6a, 0xb4, 0xcd,
+	0xb1, 0xf1, 0x37, 0xaa, 0x33, 0xc3, 0x69, 0xe8, 0xa6, 0x5d, 0xf5, 0x5a, 0x9b, 0x9b, 0xe6, 0x03,
+	0xa1, 0x3b, 0x0a, 0x1c, 0xb8, 0xce, 0x60, 0xe4, 0x0c, 0x00, 0x97, 0xc4, 0xa6, 0xe3, 0xfa, 0x42,
+	0x7b, 0x70, 0xd9, 0x5c, 0x73, 0x5c, 0x1f, 0x27, 0xa1, 0x41, 0x7d, 0xdd, 0xd0, 0x7d, 0x5d, 0xc4,
+	0xb8, 0xc2, 0x32, 0x36, 0x65, 0xe7, 0x06, 0x55, 0x8f, 0x52, 0x5b, 0x84, 0xb9, 0xf2, 0x0c, 0xb2,
+	0x4e, 0xa9, 0x8d, 0xda, 0x89, 0x57, 0xbb, 0xb4, 0x6e, 0x7a, 0x3e, 0x75, 0xa9, 0xc1, 0x82, 0x5d,
+	0xa3, 0xda, 0x0c, 0x83, 0x6b, 0x21, 0x98, 0xbc, 0x03, 0xf3, 0x42, 0xaf, 0x23, 0xc8, 0xe5, 0x7a,
+	0x53, 0xf7, 0xd5, 0x8f, 0x0e, 0xf0, 0x35, 0x09, 0xd7, 0xe9, 0x11, 0x81, 0x65, 0xd4, 0x27, 0x05,
+	0xae, 0xf5, 0x28, 0x65, 0xf4, 0xdc, 0x03, 0xd0, 0x03, 0xa6, 0xea, 0x28, 0x45, 0x3a, 0xcf, 0x40,
+	0x1e, 0x43, 0xf6, 0x55, 0x4f, 0xb7, 0x7c, 0xd5, 0xe3, 0xd3, 0x80, 0x80, 0x75, 0xdd, 0x62, 0xbb,
+	0x86, 0x41, 0x37, 0xf5, 0x96, 0xe5, 0x57, 0xb9, 0x16, 0xf4, 0x59, 0xc8, 0xbe, 0x20, 0x80, 0x7c,
+	0x61, 0x04, 0xea, 0xbb, 0x25, 0xa9, 0xef, 0x5b, 0x30, 0x6d, 0xb8, 0xf8, 0x79, 0x0c, 0xaa, 0x1b,
+	0x96, 0x69, 0x53, 0x75, 0xe7, 0x00, 0xfc, 0x4d, 0xb1, 0xb6, 0xab, 0xa2, 0x29, 0x31, 0x61, 0x4e,
+	0x8a, 0x99, 0x84, 0x71, 0x87, 0x87, 0xfb, 0x8a, 0x3b, 0xf4, 0x37, 0x94, 0x48, 0x2d, 0x89, 0xec,
+	0x95, 0xee, 0xa7, 0xeb, 0x20, 0x80, 0xdc, 0x72, 0xcd, 0x37, 0x77, 0xa8, 0x92, 0x41, 0x85, 0x52,
+	0xb1, 0x75, 0x5e, 0x1a, 0x41, 0x34, 0xe1, 0xe8, 0x2a, 0xa3, 0xa8, 0xaa, 0xd8, 0x46, 0x2a, 0xd4,
+	0x0e, 0x0e, 0xc2, 0xb4, 0xeb, 0xca, 0x58, 0xe9, 0xfb, 0x63, 0x40, 0xee, 0xba, 0x75, 0xdd, 0x36,
+	0x3f, 0x66, 0xdf, 0xef, 0x0e, 0x6d, 0x6c, 0x50, 0xf7, 0xc8, 0xaa, 0x94, 0xff, 0x07, 0x59, 0xd7,
+	0xb1, 0xa8, 0xb0, 0xc1, 0x9e, 0x97, 0x3f, 0x40, 0xef, 0x28, 0x17, 0x35, 0xc7, 0xa2, 0x1a, 0x6b,
+	0x10, 0x8a, 0x0a, 0x95, 0x44, 0x65, 0x05, 0xb2, 0x18, 0x37, 0x0c, 0x36, 0x58, 0x45, 0xa6, 0x86,
+	0x01, 0x43, 0xee, 0xf9, 0xf7, 0xec, 0x61, 0x58, 0x85, 0x3b, 0x18, 0x6b, 0x4c, 0x56, 0x60, 0x1c,
+	0xff, 0x97, 0x36, 0xd7, 0x0b, 0x7b, 0x9d, 0x62, 0x8e, 0x23, 0x0d, 0xdb, 0xbb, 0x72, 0xd8, 0xb4,
+	0x62, 0x90, 0x1a, 0x14, 0x1c, 0x89, 0xfd, 0x60, 0x83, 0x55, 0xfb, 0x8d, 0x8f, 0x07, 0x67, 0x7a,
+	0x38, 0x93, 0x51, 0x90, 0xc3, 0x18, 0x51, 0xf2, 0x3e, 0xcc, 0xc8, 0x65, 0x69, 0xbf, 0xbd, 0xb2,
+	0xd7, 0x29, 0x4e, 0xc7, 0x1b, 0x0f, 0xe3, 0x7c, 0x5a, 0x26, 0x55, 0x31, 0x4a, 0xaf, 0x40, 0x16,
+	0x67, 0x1b, 0x37, 0xb1, 0xfb, 0xb6, 0x41, 0x37, 0x4d, 0x9b, 0x1a, 0x7c, 0x0b, 0xbd, 0xbb, 0x6b,
+	0x33, 0xd7, 0x1c, 0x20, 0xc7, 0x3f, 0x8b, 0x32, 0x52, 0xfa, 0xbd, 0x3c, 0xc0, 0x3d, 0xaa, 0x37,
+	0x8e, 0xb8, 0x34, 0x5e, 0x8a, 0x49, 0x63, 0xcc, 0x1c, 0x8a, 0x46, 0x97, 0x26, 0x85, 0x9b, 0xbf,
+	0x94, 0x52, 0xb8, 0x02, 0x59, 0x9f, 0xea, 0x0d, 0xf5, 0xd3, 0x14, 0x4e, 0x70, 0x3c, 0x7d, 0x38,
+	0xc1, 0x2a, 0xc6, 0x09, 0x36, 0x46, 0x4e, 0xf0, 0x7f, 0x49, 0xba, 0x18, 0x27, 0x1c, 0x69, 0x28,
+	0x27, 0xd8, 0xb4, 0x62, 0x90, 0x37, 0x61, 0xbc, 0xe6, 0xb4, 0x9a, 0x92, 0x63, 0x1a, 0x73, 0xb3,
+	0x57, 0x58, 0xdd, 0x00, 0x05, 0x1b, 0xb4, 0x26, 0xef, 0x40, 0x41, 0xaf, 0x6d, 0x99, 0x74, 0x87,
+	0x36, 0x28, 0x86, 0x48, 0x1e, 0x73, 0x6a, 0x27, 0x63, 0x16, 0x44, 0x84, 0x30, 0x80, 0x64, 0x8c,
+	0x0e, 0x31, 0xe1, 0xb8, 0x87, 0x26, 0xf5, 0xee, 0x96, 0xe3, 0xed, 0x6e, 0x39, 0x91, 0xa7, 0xf0,
+	0x84, 0x77, 0x70, 0x5a, 0xee, 0xe0, 0x5d, 0x8e, 0x24, 0x4c, 0xfb, 0x01, 0x7d, 0xcc, 0x21, 0xcd,
+	0x38, 0xb6, 0x47, 0x3e, 0x82, 0x53, 0x2e, 0xad, 0x51, 0x73, 0x87, 0x1a, 0xbd, 0xdd, 0x7d, 0xf6,
+	0x34, 0xdd, 0x9d, 0x0c, 0xe8, 0x26, 0xbb, 0x7c, 0x0b, 0xc6, 0x4c, 0x9f, 0x36, 0x3c, 0xf5, 0x73,
+	0x4e, 0xfe, 0x94, 0x4c, 0xbe, 0x62, 0xef, 0x50, 0xdb, 0x77, 0xdc, 0x76, 0xc5, 0xa7, 0x8d, 0x01,
+	0xd4, 0x39, 0x09, 0xe2, 0xc0, 0xf1, 0x68, 0x0b, 0x8d, 0x1c, 0x35, 0x4f, 0xfd, 0x61, 0x66, 0x7f,
+	0xc1, 0xa5, 0xfe, 0x3d, 0xcc, 0xd7, 0x7a, 0xd1, 0xbd, 0x03, 0x6a, 0xa2, 0x3f, 0xcf, 0x72, 0x4d,
+	0x54, 0xb1, 0x77, 0x4c, 0xff, 0xe8, 0x46, 0x27, 0x56, 0x00, 0x0c, 0x6a, 0x51, 0x41, 0x24, 0x7b,
+	0x10, 0x22, 0xa2, 0x1d, 0x23, 0xf2, 0xa5, 0x26, 0x4a, 0x6a, 0xa2, 0x39, 0xa1, 0xb1, 0x1f, 0x65,
+	0x22, 0x95, 0x5d, 0xfa, 0x77, 0x80, 0x2c, 0x0e, 0xe8, 0xd7, 0x5b, 0x5c, 0x4e, 0xc3, 0x04, 0x7e,
+	0x2e, 0xc9, 0xc5, 0x0b, 0xcb, 0x18, 0xc4, 0xa7, 0x0d, 0xdd, 0xb4, 0x84, 0xbd, 0xc5, 0x0b, 0x64,
+	0x09, 0x0a, 0x75, 0x57, 0xdf, 0xd1, 0x7d, 0xdd, 0x65, 0x3e, 0x3a, 0x77, 0xf5, 0x66, 0xf0, 0x2c,
+	0xf3, 0x4d, 0x01, 0xc7, 0x74, 0x8a, 0xc9, 0x00, 0x09, 0x13, 0x2a, 0x2e, 0xc1, 0x24, 0x9e, 0x95,
+	0x98, 0x3e, 0xcf, 0xbf, 0xa8, 0x47, 0xb9, 0x39, 0xef, 0x72, 0x30, 0xb6, 0x00, 0x81, 0x82, 0x0d,
+	0xa2, 0xfc, 0x9f, 0xad, 0x58, 0xfe, 0xcf, 0x6d, 0x98, 0x72, 0xb8, 0xbf, 0xd1, 0xda, 0xf8, 0x90,
+	0xd6, 0x7c, 0x91, 0x98, 0x71, 0x6e, 0xaf, 0x53, 0x2c, 0xdc, 0x5d, 0x46, 0xbf, 0x83, 0xc3, 0xfb,
+	0xa5, 0x26, 0x14, 0x1c, 0x3d, 0x42, 0xc2, 0x10, 0x13, 0x9b, 0x09, 0x9e, 0xf5, 0xa0, 0x7b, 0xa1,
+	0xf3, 0x38, 0x1d, 0x80, 0x35, 0x06, 0x25, 0x2b, 0x12, 0xa2, 0xf0, 0x62, 0xb7, 0x99, 0xb9, 0x10,
+	0xd3, 0xd9, 0xab, 0x02, 0x45, 0xf8, 0xb1, 0xd3, 0x46, 0xac, 0x9c, 0x1a, 0xa7, 0xfa, 0x00, 0x14,
+	0x26, 0xde, 0x0d, 0xa6, 0xca, 0xbc, 0x2d, 0xb3, 0x19, 0x3a, 0x26, 0x27, 0xd2, 0x2d, 0x91, 0x01,
+	0xaa, 0x74, 0xc6, 0x0f, 0xb1, 0x18, 0x25, 0xf2, 0x6d, 0x98, 0xb2, 0x1d, 0xdf, 0xdc, 0x34, 0x6b,
+	0x42, 0x5d, 0x7f, 0xc2, 0x49, 0xc7, 0x4c, 0xd2, 0xb7, 0x25, 0x8c, 0x41, 0x71, 0xe1, 0x18, 0x25,
+	0xe2, 0x83, 0x1a, 0xb3, 0x43, 0xe5, 0x01, 0x88, 0x63, 0xe6, 0xb3, 0x83, 0x0d, 0xfb, 0x41, 0x7b,
+	0x9a, 0xd3, 0x83, 0xcd, 0x07, 0xf4, 0x5b, 0x40, 0xb8, 0xeb, 0x54, 0x95, 0x66, 0x8d, 0x2b, 0x86,
+	0xfe, 0x13, 0xf6, 0x6a, 0xb7, 0x53, 0x5c, 0xea, 0x0d, 0xb0, 0x31, 0x3a, 0x11, 0x5a, 0x65, 0xf5,
+	0xb5, 0x04, 0x17, 0x8a, 0x9e, 0x40, 0x41, 0x83, 0xa1, 0xb7, 0x7b, 0x54, 0x4d, 0x8f, 0xb8, 0xf6,
+	0xb8, 0xba, 0xd7, 0x29, 0x92, 0x5e, 0xc2, 0xc3, 0xd4, 0x14, 0x49, 0x76, 0x54, 0x31, 0x88, 0x05,
+	0x53, 0xa2, 0x2b, 0x71, 0x52, 0xf1, 0xb8, 0xff, 0x49, 0xc5, 0x52, 0xb7, 0x53, 0x5c, 0xec, 0x33,
+	0xc0, 0xe0, 0x10, 0xe2, 0xb5, 0x5e, 0x4b, 0x28, 0xaa, 0x46, 0x31, 0x8c, 0xf5, 0x86, 0x63, 0x7a,
+	0x22, 0xb9, 0x15, 0x71, 0x5a, 0x43, 0xdd, 0x0a, 0x99, 0x76, 0xc5, 0x28, 0xfd, 0xc7, 0x18, 0x14,
+	0xe4, 0xef, 0xff, 0xeb, 0xad, 0x71, 0xd3, 0x02, 0x6a, 0x49, 0x9d, 0x4a, 0xf7, 0xa1, 0x53, 0x23,
+	0x15, 0xb9, 0x19, 0x53, 0x91, 0x29, 0xba, 0xaa, 0x7e, 0x60, 0x5d, 0xf5, 0x3c, 0x4c, 0xd5, 0x2d,
+	0x67, 0x43, 0xb7, 0x02, 0xf1, 0xe3, 0xc9, 0x96, 0x05, 0x0e, 0x14, 0x52, 0x13, 0x28, 0x34, 0x53,
+	0x52, 0x68, 0xcb, 0x30, 0x86, 0x6b, 0x23, 0xd4, 0x62, 0xbd, 0xbb, 0xfe, 0x00, 0x63, 0x93, 0xb5,
+	0x1c, 0x6c, 0x2b, 0x7f, 0xf2, 0x73, 0xb1, 0x95, 0xd7, 0x61, 0x5c, 0x28, 0xb0, 0xa7, 0x57, 0x5e,
+	0x01, 0xa5, 0xd2, 0xc3, 0x71, 0xc8, 0x89, 0x99, 0xfa, 0x55, 0x0a, 0xfe, 0x5e, 0x09, 0x03, 0xb9,
+	0x94, 0x89, 0xd5, 0xa9, 0x5e, 0x8d, 0x94, 0x8c, 0xe4, 0x7e, 0x13, 0x60, 0xc7, 0xf4, 0xcc, 0x0d,
+	0xd3, 0x32, 0xfd, 0x36, 0x13, 0xd7, 0xe9, 0xa5, 0x33, 0x29, 0xcd, 0xde, 0x09, 0x91, 0x34, 0xa9,
+	0x01, 0x59, 0x81, 0x82, 0x7c, 0x28, 0x29, 0xc4, 0xb9, 0x98, 0xd6, 0xaf, 0x84, 0xa6, 0xc5, 0x1a,
+	0x61, 0xa0, 0xd2, 0xf4, 0xaa, 0x5c, 0x7e, 0x85, 0x34, 0x4f, 0x98, 0xde, 0x9b, 0xac, 0x9c, 0x2a,
+	0xc9, 0x67, 0x00, 0x4c, 0xaf, 0xea, 0x53, 0xcf, 0x37, 0xed, 0x3a, 0xb3, 0x0b, 0x26, 0xb4, 0xbc,
+	0xe9, 0xdd, 0xe3, 0x00, 0x66, 0x3b, 0xb4, 0x6d, 0xbd, 0x61, 0xd6, 0xaa, 0x5e, 0xcd, 0x71, 0x11,
+	0x67, 0x9b, 0xe1, 0x4c, 0x0b, 0xf0, 0x3a, 0x87, 0x22, 0xa2, 0x40, 0xa8, 0x9a, 0xb6, 0xe9, 0x9b,
+	0xba, 0xc5, 0xb3, 0x2f, 0xb5, 0x69, 0x01, 0xae, 0x70, 0xa8, 0x8c, 0xd8, 0x30, 0x6d, 0xb3, 0xd1,
+	0x6a, 0xa8, 0x8d, 0x18, 0xe2, 0x1d, 0x0e, 0x65, 0x87, 0x71, 0x02, 0xd1, 0xa0, 0x35, 0x9d, 0xa7,
+	0x58, 0x8e, 0x6a, 0x05, 0x01, 0x5c, 0x45, 0xd8, 0x61, 0x2c, 0x44, 0xc9, 0x81, 0xff, 0xe4, 0x69,
+	0x1c, 0xf8, 0xd2, 0xd5, 0x30, 0x2c, 0x3a, 0x0b, 0x53, 0x22, 0x2c, 0xca, 0x01, 0xca, 0x31, 0x0c,
+	0x81, 0x8a, 0x43, 0x51, 0x25, 0xc3, 0x0b, 0xec, 0x4c, 0x53, 0x19, 0x29, 0xbd, 0x05, 0x10, 0x49,
+	0x04, 0x39, 0x0e, 0xb3, 0xa2, 0x69, 0x04, 0xe4, 0xcd, 0xd7, 0x5c, 0x73, 0x47, 0xf7, 0x45, 0x70,
+	0xf5, 0xbe, 0x6d, 0x99, 0x1e, 0x12, 0x1b, 0x41, 0x17, 0x71, 0xad, 0xb5, 0x61, 0x99, 0x35, 0x65,
+	0xb4, 0x74, 0x1d, 0x0a, 0xb2, 0x70, 0x90, 0x93, 0x30, 0x17, 0x30, 0x22, 0x81, 0x95, 0x63, 0x64,
+	0x02, 0xb2, 0x77, 0x9b, 0xd4, 0x56, 0x32, 0xe8, 0x6c, 0xae, 0x58, 0x3c, 0xfd, 0xe4, 0x09, 0x40,
+	0x16, 0xe7, 0xec, 0xd7, 0x7b, 0xe7, 0x8a, 0x2d, 0x21, 0x23, 0xb1, 0x84, 0x52, 0xb6, 0x1d, 0xfa,
+	0xb3, 0x98, 0xc8, 0x35, 0xdd, 0xdb, 0xe2, 0xe9, 0x2d, 0x1a, 0xfb, 0x8d, 0x5e, 0x08, 0x0a, 0x36,
+	0xbf, 0x09, 0x30, 0xaa, 0xf1, 0x02, 0x29, 0xc2, 0x64, 0xdd, 0xb1, 0x8c, 0x6a, 0x83, 0x1a, 0xba,
+	0xe5, 0xb1, 0x05, 0x3d, 0xaa, 0x01, 0x82, 0xee, 0x30, 0x08, 0x5b, 0x24, 0xa6, 0xb5, 0x43, 0xdd,
+	0x00, 0xc5, 0x14, 0x8b, 0x84, 0x01, 0x23, 0xa4, 0x0d, 0xd7, 0xb1, 0x3f, 0xa6, 0x01, 0x12, 0x3f,
+	0x8b, 0x2e, 0x70, 0xa0, 0x40, 0x3a, 0x07, 0x33, 0xf6, 0x46, 0x35, 0x16, 0x81, 0xda, 0xe6, 0xeb,
+	0xd2, 0xde, 0x90, 0xc2, 0x4e, 0xe9, 0x06, 0xfe, 0x5b, 0x30, 0xcd, 0xcf, 0x59, 0x90, 0x6b, 0x36,
+	0xf9, 0x8d, 0x03, 0x4c, 0x3e, 0x3b, 0xa3, 0x59, 0x67, 0x4d, 0x97, 0x7d, 0x29, 0x6d, 0xe5, 0xe1,
+	0xd3, 0xa7, 0xad, 0x78, 0x72, 0xda, 0x8a, 0x70, 0xf2, 0xef, 0x27, 0xd2, 0x56, 0x6e, 0x1c, 0x24,
+	0x6d, 0x85, 0x99, 0xc4, 0x82, 0xa4, 0x6c, 0xbf, 0xcb, 0x19, 0x2b, 0xbf, 0x90, 0x10, 0xf9, 0x77,
+	0x33, 0x7d, 0x63, 0xe4, 0xef, 0xa7, 0xc6, 0xc8, 0x0f, 0x69, 0x98, 0x89, 0x68, 0x3a, 0x69, 0xc1,
+	0xc9, 0x28, 0x68, 0x16, 0x4f, 0xd4, 0x79, 0x7c, 0x08, 0x89, 0x3a, 0x27, 0x6a, 0x69, 0x0d, 0x3c,
+	0x72, 0x2b, 0xb2, 0x65, 0x9e, 0xfc, 0xac, 0x9e, 0x64, 0x40, 0xa1, 0x27, 0xf4, 0xfa, 0xd9, 0xe1,
+	0x84, 0x5e, 0x4b, 0x3f, 0x1a, 0x87, 0xe9, 0xb8, 0x11, 0x76, 0x64, 0x55, 0xab, 0x0a, 0xe3, 0x5e,
+	0xab, 0x56, 0xa3, 0x9e, 0x27, 0x74, 0x62, 0x50, 0x4c, 0x3d, 0xae, 0xfa, 0xcd, 0xf0, 0xc2, 0x53,
+	0xdf, 0x00, 0xdd, 0xc5, 0x6e, 0xa7, 0x78, 0x21, 0x55, 0x24, 0x65, 0xef, 0x8e, 0x11, 0x61, 0x0b,
+	0x9a, 0xd3, 0xc3, 0x1c, 0x0c, 0xfe, 0x4b, 0x5a, 0xd0, 0x2c, 0x07, 0x23, 0x40, 0x1d, 0x9a, 0x83,
+	0xc1, 0x9b, 0x57, 0x0c, 0x42, 0x61, 0x52, 0x90, 0x1a, 0x1c, 0xc0, 0x63, 0x37, 0x99, 0xf6, 0xc7,
+	0x69, 0x10, 0xd5, 0x03, 0x3d, 0x2c, 0x92, 0x77, 0x60, 0x5a, 0xea, 0x46, 0x5a, 0xa6, 0x97, 0x30,
+	0x9c, 0x23, 0xb7, 0x1b, 0xc6, 0x7a, 0x21, 0xa2, 0xca, 0xd9, 0xf7, 0x75, 0xb7, 0x4e, 0x7d, 0x76,
+	0xef, 0x40, 0x7d, 0x94, 0xc2, 0x3e, 0x9b, 0xe8, 0x7d, 0xb1, 0x7f, 0x8f, 0x51, 0x0a, 0xc2, 0xa3,
+	0xe0, 0x87, 0x45, 0x64, 0x5f, 0xea, 0x06, 0xd9, 0x7f, 0x2c, 0xb1, 0x2f, 0xb7, 0x1b, 0xca, 0x7e,
+	0x44, 0x35, 0xc6, 0x3e, 0x9b, 0xfd, 0x27, 0x4f, 0x35, 0xfb, 0x9c, 0x8d, 0x70, 0xf6, 0xfd, 0xb0,
+	0x28, 0xb1, 0x1f, 0xcc, 0xfe, 0x67, 0x3d, 0xec, 0xef, 0x73, 0xf6, 0x23, 0xaa, 0x15, 0xa3, 0xf4,
+	0xfd, 0x3c, 0xcc, 0xa5, 0x1c, 0x01, 0x1c, 0xd9, 0xf5, 0xfd, 0x7a, 0x22, 0x67, 0xf0, 0xc5, 0x21,
+	0x67, 0x1d, 0x49, 0xe7, 0xe7, 0x85, 0x50, 0xca, 0x6b, 0x4e, 0x03, 0xb5, 0x9f, 0xd0, 0x07, 0x53,
+	0x1c, 0xba, 0xc2, 0x81, 0xe4, 0x65, 0x98, 0xad, 0x39, 0xae, 0x4b, 0x6b, 0xbe, 0x84, 0xc9, 0x3d,
+	0x7b, 0x25, 0xac, 0x08, 0x90, 0x13, 0xf7, 0xa8, 0xb8, 0xdb, 0x22, 0x83, 0x42, 0xdd, 0xf3, 0xa1,
+	0xa4, 0x7b, 0x5e, 0x83, 0x31, 0x66, 0xe6, 0x88, 0xd8, 0xe5, 0x0b, 0xc3, 0x06, 0xc2, 0xec, 0x1f,
+	0x8d, 0xb7, 0x21, 0xbf, 0x9f, 0x81, 0x13, 0xe9, 0xdb, 0x59, 0xa0, 0xc9, 0xf6, 0xb1, 0x9b, 0x31,
+	0xd5, 0xd6, 0xff, 0x46, 0x8d, 0x8c, 0x8b, 0xe2, 0x7a, 0x3c, 0x75, 0x8b, 0x23, 0xbb, 0x70, 0x2a,
+	0x9d, 0x13, 0x49, 0xf3, 0x5d, 0xdb, 0xeb, 0x14, 0x4f, 0xf6, 0x21, 0x3c, 0x4c, 0x9e, 0x4f, 0xa6,
+	0x76, 0x5b, 0x31, 0x48, 0x25, 0x54, 0xde, 0x9f, 0xf6, 0xd3, 0x29, 0xe9, 0xe6, 0xd7, 0x10, 0x6d,
+	0xfd, 0x83, 0xa7, 0xd2, 0xd6, 0xc1, 0x39, 0xcb, 0xa3, 0x43, 0x3a, 0x67, 0x79, 0xfc, 0xb3, 0x9e,
+	0xb3, 0x94, 0xb4, 0xf4, 0xf4, 0x97, 0x30, 0xa7, 0x0e, 0x6f, 0xde, 0x72, 0x2f, 0x2d, 0xc8, 0xc3,
+	0xe3, 0x29, 0x30, 0x1a, 0xdd, 0x6c, 0x79, 0xd4, 0x50, 0x46, 0x89, 0x02, 0xa8, 0xf7, 0x9d, 0xb0,
+	0x3a, 0x5b, 0xba, 0x01, 0x63, 0x4c, 0x0e, 0x11, 0xef, 0x6d, 0x87, 0xfd, 0x54, 0x8e, 0xe1, 0xb9,
+	0xe0, 0x9b, 0x81, 0xad, 0xaf, 0x64, 0xc8, 0x0c, 0x4c, 0xae, 0x47, 0x76, 0xbd, 0x32, 0x82, 0x80,
+	0x72, 0x64, 0xc3, 0x2b, 0xa3, 0xa5, 0xbf, 0xca, 0xc3, 0xf1, 0x54, 0x71, 0x38, 0xb2, 0x7a, 0xe9,
+	0x5b, 0x09, 0xbd, 0x74, 0x7e, 0xe8, 0xf2, 0x4b, 0x6a, 0xa6, 0x65, 0xc8, 0xd7, 0xd0, 0xc1, 0x3d,
+	0x70, 0x26, 0xf3, 0x04, 0x6f, 0x26, 0xdd, 0x65, 0x48, 0xe4, 0x42, 0x30, 0x79, 0x7c, 0xf8, 0x34,
+	0xf2, 0x68, 0x44, 0xf2, 0x28, 0x56, 0xf4, 0x5b, 0x31, 0x79, 0xbc, 0x9e, 0x2a, 0x8f, 0x03, 0xad,
+	0xf5, 0x70, 0x55, 0x47, 0x07, 0x83, 0x4d, 0x50, 0x92, 0x95, 0xa9, 0x79, 0xb1, 0xc9, 0x4b, 0x72,
+	0xe7, 0xba, 0x9d, 0xe2, 0xf3, 0x7d, 0x9c, 0x2c, 0xf9, 0x7e, 0xa0, 0x36, 0x93, 0xb8, 0xfc, 0x46,
+	0x7e, 0x37, 0x03, 0x73, 0xc9, 0x2e, 0x25, 0x15, 0x80, 0x1e, 0xd8, 0x6c, 0x0f, 0x9d, 0xa7, 0x1e,
+	0xef, 0x6c, 0x82, 0x8d, 0x8a, 0x41, 0xde, 0x80, 0xb1, 0x8d, 0x56, 0x7b, 0x90, 0x79, 0x94, 0x9e,
+	0x98, 0x5c, 0xc6, 0x46, 0x2c, 0x31, 0x99, 0x35, 0xc7, 0xc4, 0x64, 0xf6, 0x43, 0xd2, 0x1c, 0x2c,
+	0x31, 0x59, 0xe0, 0x0d, 0x4d, 0x4c, 0x66, 0x8d, 0xb9, 0x6e, 0x65, 0x52, 0xe5, 0xaa, 0x4f, 0xfa,
+	0x31, 0x94, 0xae, 0x5b, 0x59, 0x8c, 0x86, 0xeb, 0x56, 0x4e, 0x80, 0xdc, 0x10, 0x72, 0xed, 0x4a,
+	0x46, 0x0d, 0x9e, 0x10, 0x4e, 0x04, 0xa8, 0x78, 0x4f, 0x94, 0x33, 0x95, 0xa2, 0x57, 0x79, 0xd3,
+	0x8a, 0x41, 0x3e, 0x80, 0x49, 0x39, 0xd5, 0xe1, 0xf3, 0xa7, 0x4e, 0x75, 0x90, 0xc9, 0x95, 0x2e,
+	0x0e, 0x4f, 0x15, 0x04, 0xc8, 0x31, 0x8e, 0x31, 0x16, 0xf6, 0x17, 0xa3, 0x30, 0x15, 0x4b, 0xda,
+	0x38, 0xb2, 0x7a, 0x6b, 0x09, 0xb2, 0xa6, 0x4f, 0x1b, 0x42, 0x6b, 0x9d, 0xed, 0x9b, 0x95, 0xb2,
+	0x88, 0xff, 0x68, 0x0c, 0x37, 0xd5, 0x93, 0xba, 0x0d, 0x63, 0x0e, 0xe6, 0x82, 0x04, 0x7a, 0xa6,
+	0x9f, 0x97, 0x9b, 0x2e, 0xc6, 0x2c, 0x8d, 0x84, 0x89, 0x31, 0x23, 0x82, 0x62, 0xcc, 0x7e, 0x24,
+	0xf3, 0xeb, 0x05, 0xde, 0x50, 0x31, 0x66, 0x8d, 0x2b, 0x46, 0x69, 0x0e, 0xb2, 0xec, 0xeb, 0xc8,
+	0x1f, 0xb5, 0xf4, 0xd3, 0x51, 0x28, 0xc8, 0xc7, 0xac, 0x47, 0xf6, 0xdb, 0x7d, 0x13, 0xc6, 0xd9,
+	0xa5, 0x4d, 0xdd, 0x57, 0x8d, 0x03, 0x50, 0xc8, 0x61, 0xa3, 0x65, 0xbc, 0xfd, 0x91, 0xaf, 0x59,
+	0x66, 0x6d, 0x5b, 0x3a, 0xe3, 0x2a, 0xf0, 0x75, 0x69, 0xd6, 0xb6, 0xf1, 0x80, 0x6b, 0x82, 0x55,
+	0xe3, 0xe9, 0x96, 0x02, 0xa3, 0x0d, 0x2f, 0xd8, 0x57, 0xf0, 0x27, 0xcf, 0xfa, 0xae, 0x7b, 0xe2,
+	0x41, 0x10, 0xf6, 0xfb, 0x97, 0x27, 0xd9, 0xa5, 0xf4, 0xc7, 0x59, 0xc8, 0xf1, 0x80, 0xf8, 0x91,
+	0xfd, 0xb8, 0x2f, 0x43, 0x76, 0x0b, 0x83, 0xaf, 0xc6, 0x90, 0xd7, 0x1d, 0xb6, 0x44, 0x54, 0x96,
+	0x5f, 0xf0, 0xa4, 0x3c, 0x2a, 0xcb, 0x0a, 0xe4, 0x32, 0xcc, 0xe3, 0xb5, 0xe5, 0x9e, 0x2b, 0x3e,
+	0x3c, 0x9e, 0x4b, 0x1a, 0xfa, 0x83, 0x77, 0x12, 0xb7, 0x7c, 0x0e, 0x35, 0xa6, 0x79, 0x2d, 0x25,
+	0xa6, 0xf9, 0x6c, 0x22, 0xa6, 0x59, 0x88, 0x6b, 0xfb, 0x30, 0x34, 0xf9, 0xed, 0xb8, 0xb6, 0x17,
+	0xc7, 0x80, 0xcf, 0xf6, 0x1e, 0x78, 0x1c, 0x5c, 0xd5, 0xff, 0xe9, 0x18, 0x28, 0xc9, 0xb6, 0x47,
+	0x39, 0xdc, 0x15, 0x78, 0xa7, 0xe2, 0x8d, 0x15, 0x51, 0x94, 0xbc, 0xa3, 0x87, 0x87, 0xea, 0x1d,
+	0x7d, 0x72, 0x28, 0xde, 0xd1, 0xff, 0x7d, 0x16, 0xda, 0x2d, 0xc8, 0xf1, 0x03, 0x31, 0xf5, 0x51,
+	0x8a, 0xa8, 0x8b, 0xd3, 0xb4, 0x3e, 0x36, 0x0e, 0xab, 0xe4, 0x36, 0x0e, 0xfb, 0x89, 0x33, 0xc4,
+	0x7f, 0x49, 0x76, 0x17, 0x9b, 0xa1, 0x00, 0x75, 0xe8, 0x0c, 0xf1, 0xe6, 0x15, 0xa3, 0xf4, 0x67,
+	0x53, 0x30, 0x29, 0xc5, 0x70, 0x8f, 0xac, 0x64, 0xd6, 0x20, 0x8b, 0xaf, 0xf2, 0x08, 0xc3, 0xe2,
+	0xd9, 0x3e, 0x21, 0xea, 0xc5, 0x7b, 0xed, 0x26, 0x95, 0xdf, 0x60, 0xea, 0xb1, 0xa1, 0xa5, 0x40,
+	0x35, 0xb7, 0xa7, 0x91, 0x2a, 0x4a, 0x42, 0xbb, 0x49, 0xe3, 0x67, 0x60, 0x34, 0x71, 0x06, 0x26,
+	0xad, 0x8d, 0xcd, 0xf8, 0xda, 0x38, 0x0d, 0x13, 0xba, 0x5b, 0x6f, 0xb1, 0xaa, 0xba, 0xb8, 0x25,
+	0x23, 0xca, 0xa1, 0x71, 0xb3, 0x25, 0x19, 0x37, 0xbf, 0xc2, 0x6b, 0xe9, 0xc3, 0x9e, 0xb5, 0xb4,
+	0x16, 0x5b, 0x4b, 0xe5, 0x83, 0x9c, 0xc6, 0xf4, 0xf9, 0x56, 0xc1, 0x92, 0xfb, 0xed, 0x0c, 0xcc,
+	0xa7, 0x25, 0x2e, 0x07, 0x2b, 0x70, 0xa8, 0x31, 0xff, 0x72, 0xb7, 0x53, 0x3c, 0xd7, 0x3f, 0x60,
+	0x15, 0x61, 0xe2, 0xf8, 0xe6, 0x52, 0x52, 0x99, 0x49, 0x03, 0x4e, 0xa6, 0x71, 0x20, 0x2d, 0xdb,
+	0xaf, 0xed, 0x75, 0x8a, 0xc7, 0x53, 0x49, 0x0e, 0xf2, 0x53, 0x8e, 0xa7, 0x74, 0x56, 0x31, 0x4a,
+	0x3f, 0x1e, 0x83, 0x2c, 0x4a, 0x78, 0x32, 0x73, 0x7a, 0x16, 0xa6, 0xca, 0xad, 0xf6, 0x95, 0xb0,
+	0x1b, 0x25, 0x43, 0x08, 0x4c, 0x97, 0x5b, 0xed, 0xab, 0x21, 0xc8, 0x53, 0x46, 0xf0, 0x8a, 0x24,
+	0xa2, 0x5d, 0x96, 0x80, 0xa3, 0x02, 0xb8, 0x24, 0x03, 0xb3, 0x02, 0x78, 0x55, 0x06, 0x8e, 0x91,
+	0x13, 0x40, 0x04, 0x37, 0x54, 0xea, 0x0a, 0xf0, 0xb4, 0x3d, 0x80, 0xcb, 0xfd, 0x4d, 0x12, 0x15,
+	0xe6, 0xc3, 0x06, 0x32, 0xa9, 0x82, 0x5c, 0x13, 0xeb, 0x79, 0x4a, 0xae, 0x89, 0x75, 0x3f, 0x8d,
+	0x3c, 0x45, 0xdd, 0x33, 0xf5, 0xa6, 0xcc, 0x93, 0x79, 0x50, 0xa2, 0xbe, 0x19, 0xd0, 0x53, 0x8e,
+	0x63, 0x36, 0x81, 0xd4, 0xb1, 0x00, 0x9f, 0x90, 0xc1, 0x4b, 0x21, 0xf8, 0xa4, 0x0c, 0xbe, 0x1a,
+	0x82, 0xd5, 0xd8, 0x70, 0x2f, 0x87, 0xf0, 0x53, 0xd8, 0x25, 0x5f, 0x5c, 0xd2, 0x24, 0x9c, 0x45,
+	0x22, 0x1c, 0xba, 0x24, 0x31, 0x5d, 0x8c, 0xc0, 0xf2, 0xcc, 0x2c, 0x20, 0x6d, 0x41, 0x43, 0x1e,
+	0xe3, 0x73, 0x08, 0xbf, 0xa1, 0xbb, 0x56, 0x7b, 0xd9, 0x70, 0x9a, 0x3e, 0x75, 0xef, 0x39, 0xcd,
+	0x2b, 0x97, 0x2f, 0x2b, 0xe7, 0x71, 0x8a, 0x7b, 0xe1, 0x97, 0x95, 0x0b, 0x18, 0x6d, 0xbb, 0x6b,
+	0x19, 0x57, 0xbe, 0x4d, 0x75, 0x57, 0x59, 0x42, 0xb1, 0xb8, 0x6b, 0x19, 0x4b, 0x58, 0xf2, 0x94,
+	0xaf, 0x22, 0xa7, 0xeb, 0xd4, 0x36, 0xae, 0xac, 0xb5, 0x2c, 0x4b, 0xdc, 0xf7, 0x56, 0xde, 0x43,
+	0x96, 0x10, 0xba, 0x24, 0x41, 0x3d, 0xe5, 0xfd, 0x00, 0x7c, 0x35, 0x06, 0xfe, 0x00, 0x39, 0x62,
+	0x34, 0x2e, 0x23, 0xdc, 0x0d, 0xe0, 0xdf, 0xc1, 0xfc, 0x89, 0x75, 0x5f, 0xdf, 0xdc, 0x54, 0x0c,
+	0x0c, 0xc7, 0xad, 0x38, 0xb6, 0xef, 0x9a, 0x1b, 0x2d, 0xdf, 0x71, 0x15, 0x26, 0x9d, 0xe5, 0x56,
+	0xfd, 0x66, 0xcb, 0xf6, 0xa9, 0xab, 0x6c, 0x62, 0xf1, 0x8e, 0x63, 0x50, 0x57, 0xc7, 0xda, 0x3a,
+	0x7e, 0xc7, 0x9b, 0x7a, 0x6d, 0xfb, 0xde, 0x16, 0x5d, 0xb3, 0x74, 0x7f, 0xd3, 0x71, 0x1b, 0xca,
+	0x56, 0x29, 0x3b, 0xf1, 0x92, 0xf2, 0x52, 0xe9, 0x8f, 0x4e, 0x61, 0xf0, 0xd0, 0x37, 0x77, 0x30,
+	0x25, 0xe4, 0xa8, 0xee, 0x54, 0x17, 0x21, 0xbb, 0x6d, 0xda, 0x86, 0x6a, 0xf4, 0x26, 0x50, 0x05,
+	0x63, 0x5b, 0xbc, 0x65, 0xda, 0x86, 0xc6, 0xd0, 0xd0, 0xd6, 0xe6, 0x66, 0xb4, 0xb0, 0xb5, 0x59,
+	0x01, 0x33, 0x20, 0x58, 0x52, 0x41, 0xd5, 0xa0, 0x96, 0xaf, 0x0b, 0x13, 0x1b, 0x18, 0x68, 0x15,
+	0x21, 0x5f, 0xda, 0x63, 0x43, 0xec, 0xb1, 0xc0, 0x79, 0x7c, 0x74, 0x48, 0xce, 0xe3, 0xe3, 0x43,
+	0xbb, 0x39, 0xf8, 0xe4, 0x17, 0x74, 0x73, 0xf0, 0xb3, 0xc3, 0xba, 0x39, 0x28, 0xb9, 0x71, 0x9f,
+	0x3f, 0xbd, 0x1b, 0x57, 0x91, 0xdd, 0xb8, 0x1f, 0x4a, 0xd2, 0xb6, 0xdf, 0x04, 0xe4, 0xc8, 0xab,
+	0x7b, 0x57, 0x7e, 0xed, 0xed, 0x6f, 0x06, 0xbe, 0xf6, 0x26, 0xbd, 0xe8, 0xd7, 0xe7, 0xb5, 0x37,
+	0xf9, 0x49, 0x37, 0x2d, 0xf1, 0xa4, 0xdb, 0xdf, 0x72, 0x36, 0x17, 0x7b, 0x9f, 0x74, 0x1b, 0xc8,
+	0x69, 0xec, 0xd1, 0xb6, 0x26, 0x28, 0xc9, 0x57, 0x5f, 0xd4, 0xbf, 0xdb, 0xc7, 0x2b, 0x0d, 0xe9,
+	0xd1, 0xe8, 0x04, 0x16, 0x8b, 0x46, 0xd7, 0xe2, 0x30, 0x42, 0x61, 0x2e, 0xd9, 0x23, 0x0e, 0xe6,
+	0xef, 0x23, 0xc3, 0x64, 0xb6, 0x87, 0xcc, 0xb0, 0x21, 0xcd, 0x26, 0x3a, 0x89, 0x79, 0x3e, 0xff,
+	0x70, 0xc8, 0x9e, 0xcf, 0x3f, 0x3e, 0x8d, 0xe7, 0x93, 0x1a, 0xfe, 0xff, 0xd1, 0xcf, 0x35, 0xfc,
+	0x4f, 0xd3, 0xa3, 0xff, 0xff, 0x24, 0x4d, 0x78, 0x5a, 0xf4, 0x7f, 0xf0, 0x84, 0xf7, 0x06, 0xf7,
+	0x3f, 0x80, 0x49, 0xf9, 0x82, 0xc4, 0x3f, 0x0f, 0x8e, 0x90, 0x96, 0xba, 0x9d, 0xe2, 0xd9, 0x54,
+	0x8d, 0x1b, 0xdc, 0x60, 0xc0, 0xcc, 0x81, 0xb0, 0xc8, 0x32, 0x07, 0xe2, 0xf7, 0x1f, 0xfe, 0x45,
+	0xce, 0x1c, 0x38, 0xc0, 0xcd, 0x87, 0x82, 0x2f, 0xdf, 0x79, 0x18, 0x70, 0xc4, 0xfc, 0xaf, 0xbf,
+	0x4c, 0x47, 0xcc, 0x3f, 0xfe, 0x39, 0x1e, 0x31, 0x3f, 0x00, 0xd2, 0xfb, 0x58, 0x81, 0xda, 0xe1,
+	0xc3, 0x1f, 0xf2, 0x56, 0x01, 0x7b, 0x43, 0x70, 0x80, 0x06, 0x13, 0x78, 0x95, 0x55, 0x79, 0x91,
+	0x06, 0x50, 0xb2, 0x0d, 0xc7, 0x7b, 0x7b, 0xc6, 0xe1, 0xfe, 0x84, 0x0f, 0xf7, 0xd5, 0xbd, 0x4e,
+	0x71, 0x2e, 0x85, 0xd8, 0xb0, 0xa1, 0xce, 0xf5, 0x74, 0xc5, 0xee, 0x06, 0x8b, 0x77, 0x71, 0x7e,
+	0x7a, 0x88, 0xef, 0xe2, 0xfc, 0xdb, 0x53, 0xbc, 0x8b, 0xf3, 0xae, 0x58, 0x31, 0x26, 0xbb, 0x42,
+	0xaa, 0xee, 0xf5, 0x65, 0xab, 0xff, 0x62, 0xe1, 0xb7, 0x4f, 0xc3, 0xc5, 0xc2, 0x8b, 0xe1, 0x62,
+	0xe1, 0x84, 0x91, 0xcd, 0x2f, 0x12, 0x8b, 0x25, 0x68, 0xb7, 0xaf, 0xc5, 0x22, 0x90, 0x8d, 0xd2,
+	0x1f, 0x8c, 0x42, 0x16, 0x6d, 0xc4, 0xf8, 0xf1, 0x91, 0x02, 0x05, 0x34, 0x3d, 0x82, 0x07, 0x44,
+	0x94, 0x0c, 0xf3, 0x03, 0x3d, 0xea, 0xde, 0x76, 0xea, 0xa6, 0xad, 0x8c, 0xa0, 0xb1, 0x8e, 0xc5,
+	0x75, 0xea, 0xaf, 0xb9, 0x74, 0x93, 0xba, 0xd4, 0xae, 0x31, 0x1f, 0x0f, 0xb3, 0xab, 0x3d, 0xea,
+	0xb2, 0xfc, 0x5c, 0xba, 0x5c, 0x63, 0x26, 0xa6, 0x92, 0xe5, 0xb6, 0x7d, 0x5c, 0xf9, 0xb5, 0xda,
+	0xca, 0x18, 0x79, 0x0e, 0xce, 0xa4, 0x4a, 0x7e, 0xe0, 0x0e, 0x29, 0x39, 0x74, 0x2f, 0x63, 0x41,
+	0x4f, 0xaa, 0x8c, 0xa3, 0x17, 0xca, 0x66, 0x31, 0xe4, 0x6f, 0x82, 0x2c, 0xc0, 0xb3, 0x0c, 0xd4,
+	0x23, 0x59, 0x2b, 0xcc, 0xe6, 0x56, 0xf2, 0xfd, 0x31, 0xee, 0x33, 0x83, 0x5a, 0x01, 0x1c, 0x35,
+	0x4e, 0x24, 0x6b, 0x81, 0x59, 0xdc, 0x93, 0xd8, 0x79, 0x34, 0xb5, 0xe8, 0x9d, 0x28, 0x05, 0xf4,
+	0x75, 0x22, 0x18, 0xcf, 0x30, 0x50, 0xa6, 0x48, 0x09, 0xce, 0xa6, 0x53, 0xbf, 0xb7, 0xe5, 0x3a,
+	0xbe, 0x6f, 0x51, 0x65, 0x1a, 0x13, 0x18, 0x18, 0x0e, 0x7b, 0x92, 0x43, 0x99, 0x41, 0xff, 0x04,
+	0x29, 0xb1, 0xc4, 0xdc, 0x95, 0x2d, 0x1d, 0xdd, 0x3b, 0xa5, 0xf4, 0x38, 0x0f, 0xd9, 0xd5, 0x56,
+	0xa3, 0x49, 0x5e, 0x4b, 0xe4, 0x4c, 0x0e, 0x4e, 0x99, 0x4c, 0xdc, 0x49, 0xbf, 0x0a, 0x20, 0x3d,
+	0xd8, 0x3a, 0xb2, 0x30, 0xda, 0xd7, 0x60, 0xd1, 0x24, 0x44, 0x72, 0x13, 0x66, 0x93, 0x1b, 0xb9,
+	0xa7, 0x8e, 0x0e, 0x7d, 0x8b, 0x5c, 0x53, 0x12, 0x9b, 0xb5, 0x47, 0xde, 0x4e, 0x7f, 0x2d, 0x25,
+	0xbb, 0x8f, 0xc7, 0x52, 0xd2, 0x9e, 0x44, 0x21, 0xef, 0xf5, 0xcf, 0x82, 0x1d, 0xdb, 0x67, 0x12,
+	0x6c, 0xdf, 0x54, 0xd7, 0x7b, 0xfd, 0xae, 0xa5, 0xe7, 0xf6, 0x75, 0x54, 0x9b, 0x7e, 0xf7, 0x9c,
+	0xbc, 0x12, 0x5d, 0x7b, 0x18, 0xef, 0x77, 0xeb, 0x21, 0x7a, 0x9c, 0xe0, 0x16, 0x10, 0xfe, 0x33,
+	0xc6, 0xc0, 0xc4, 0xf0, 0xd3, 0x03, 0x6d, 0xb6, 0x96, 0x80, 0x78, 0xe4, 0x02, 0xe4, 0x98, 0x42,
+	0xf2, 0xd4, 0xfc, 0xc2, 0x68, 0xaa, 0xfe, 0xd1, 0x04, 0x02, 0x29, 0xe3, 0xf3, 0x68, 0xe2, 0xb8,
+	0xb4, 0xca, 0x2f, 0xfa, 0xc3, 0x90, 0x7b, 0xfe, 0xf8, 0x72, 0x9a, 0x54, 0xf4, 0xc8, 0xeb, 0xc9,
+	0xfb, 0xa1, 0x93, 0x83, 0xaf, 0x87, 0x26, 0x2f, 0x81, 0xbe, 0x0e, 0x53, 0xb2, 0x1f, 0xe0, 0xa9,
+	0x85, 0xde, 0xf6, 0xb2, 0x5f, 0xa1, 0xc5, 0xd1, 0xc9, 0x6f, 0xc0, 0x7c, 0xda, 0x25, 0x52, 0x75,
+	0x6a, 0x3f, 0x57, 0xb0, 0xb4, 0xb9, 0x94, 0x5b, 0xa2, 0xf8, 0xf1, 0xb8, 0x39, 0xe4, 0xa9, 0xd3,
+	0xbd, 0x1f, 0x8f, 0xeb, 0x32, 0x2d, 0x40, 0xc1, 0x65, 0xd3, 0xfb, 0x4a, 0xf2, 0xcc, 0xd0, 0x47,
+	0x92, 0x53, 0xde, 0x34, 0x7e, 0x31, 0xb8, 0x6e, 0xa3, 0xa4, 0xdf, 0xb6, 0x09, 0xee, 0xd4, 0x7c,
+	0x03, 0x0a, 0xf2, 0x85, 0x5f, 0x75, 0x76, 0x50, 0x86, 0xb6, 0x36, 0x29, 0xdd, 0xe8, 0xc5, 0x2e,
+	0xd0, 0x5f, 0xf4, 0x54, 0xd2, 0xdb, 0x05, 0x53, 0xfa, 0xbc, 0x9a, 0xdc, 0x00, 0xa5, 0xe7, 0xda,
+	0xdc, 0xdc, 0xb0, 0x5b, 0x73, 0xda, 0xcc, 0x6e, 0xac, 0xec, 0x95, 0x7e, 0x27, 0x03, 0xd9, 0x8a,
+	0xbd, 0xe9, 0xe0, 0x6b, 0xb1, 0x3e, 0x7b, 0xee, 0xd6, 0x75, 0x76, 0x03, 0x6d, 0x56, 0x8c, 0x0b,
+	0xd9, 0xa6, 0xb3, 0x78, 0x0f, 0x51, 0x34, 0x67, 0x97, 0x3f, 0x09, 0xab, 0xe5, 0xfd, 0xa0, 0x7c,
+	0xfa, 0x3a, 0x4c, 0xc7, 0x2b, 0x87, 0xbd, 0x17, 0x3b, 0x15, 0x7f, 0x2f, 0x36, 0xcf, 0x04, 0x9f,
+	0x3d, 0xdb, 0x7e, 0x2e, 0x78, 0xd3, 0x22, 0xd3, 0x6f, 0x79, 0xf0, 0xfa, 0xd2, 0x75, 0x98, 0x0a,
+	0x3f, 0x0e, 0x6b, 0xf9, 0x72, 0xbc, 0x65, 0x1f, 0x95, 0x2a, 0x5a, 0xdf, 0x84, 0xb9, 0xc4, 0x17,
+	0x67, 0x34, 0xae, 0xc4, 0x69, 0x0c, 0x94, 0x10, 0x41, 0x69, 0x09, 0x26, 0xd8, 0xee, 0x8b, 0xcd,
+	0x5f, 0x8c, 0x37, 0x4f, 0xf9, 0x7e, 0xbc, 0x4d, 0x19, 0x14, 0x59, 0xda, 0x59, 0xdb, 0xc5, 0x78,
+	0xdb, 0xfe, 0x2b, 0x4c, 0xd0, 0x78, 0x15, 0x80, 0x73, 0xc4, 0x5a, 0x9f, 0x8f, 0xb7, 0x4e, 0x5b,
+	0x12, 0x11, 0xbf, 0x28, 0x7e, 0x43, 0xf9, 0xe5, 0x22, 0xcd, 0xdb, 0x5c, 0x83, 0x42, 0x10, 0xb5,
+	0x62, 0xed, 0x5e, 0x8a, 0xb7, 0x9b, 0x4f, 0x0b, 0x6f, 0x89, 0xb6, 0x2f, 0xdd, 0x84, 0xe9, 0xf8,
+	0x95, 0xa0, 0xfe, 0xd9, 0x30, 0x53, 0x90, 0x0f, 0x5f, 0xa4, 0x54, 0x46, 0xd8, 0x9e, 0x6c, 0x3b,
+	0x76, 0xbb, 0x61, 0x7e, 0x8c, 0x99, 0x83, 0xe5, 0xa5, 0x87, 0x7b, 0x67, 0x33, 0x9f, 0xef, 0x9d,
+	0xcd, 0xfc, 0x74, 0xef, 0x6c, 0xe6, 0x7b, 0x5f, 0x9c, 0x3d, 0xf6, 0xf9, 0x17, 0x67, 0x8f, 0xfd,
+	0xe8, 0x8b, 0xb3, 0xc7, 0xde, 0x53, 0x83, 0xfe, 0x2d, 0xdd, 0x36, 0x2e, 0xe1, 0x5f, 0x80, 0xd9,
+	0xae, 0x5f, 0xc2, 0xbf, 0x16, 0xb3, 0x91, 0x63, 0x01, 0xbb, 0xaf, 0xfe, 0xef, 0x00, 0x40, 0x73,
+	0xbf, 0xb3, 0x3c, 0x66, 0x00, 0x00,
 }
 
 func (m *Challenge) Marshal() (dAtA []byte, err error) {
@@ -4993,6 +5353,48 @@ func (m *ChallengeFlavor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0xc2
 	}
+	if m.Points != 0 {
+		i = encodeVarintPwdb(dAtA, i, uint64(m.Points))
+		i--
+		dAtA[i] = 0x7
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.PerUserPassphrases {
+		i--
+		if m.PerUserPassphrases {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x7
+		i--
+		dAtA[i] = 0xb8
+	}
+	if len(m.ProxyPolicyConfig) > 0 {
+		i -= len(m.ProxyPolicyConfig)
+		copy(dAtA[i:], m.ProxyPolicyConfig)
+		i = encodeVarintPwdb(dAtA, i, uint64(len(m.ProxyPolicyConfig)))
+		i--
+		dAtA[i] = 0x7
+		i--
+		dAtA[i] = 0xb2
+	}
+	if m.ProxyPolicy != nil {
+		{
+			size, err := m.ProxyPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPwdb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7
+		i--
+		dAtA[i] = 0xaa
+	}
 	if len(m.RedumpPolicyConfig) > 0 {
 		i -= len(m.RedumpPolicyConfig)
 		copy(dAtA[i:], m.RedumpPolicyConfig)
@@ -5121,22 +5523,22 @@ func (m *ChallengeFlavor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa2
 	}
 	if m.UpdatedAt != nil {
-		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt):])
-		if err4 != nil {
-			return 0, err4
+		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt):])
+		if err5 != nil {
+			return 0, err5
 		}
-		i -= n4
-		i = encodeVarintPwdb(dAtA, i, uint64(n4))
+		i -= n5
+		i = encodeVarintPwdb(dAtA, i, uint64(n5))
 		i--
 		dAtA[i] = 0x1a
 	}
 	if m.CreatedAt != nil {
-		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt):])
-		if err5 != nil {
-			return 0, err5
+		n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt):])
+		if err6 != nil {
+			return 0, err6
 		}
-		i -= n5
-		i = encodeVarintPwdb(dAtA, i, uint64(n5))
+		i -= n6
+		i = encodeVarintPwdb(dAtA, i, uint64(n6))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -5185,7 +5587,7 @@ func (m *ChallengeFlavor_RedumpPolicy) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *SeasonChallenge) Marshal() (dAtA []byte, err error) {
+func (m *ChallengeFlavor_ProxyPolicy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5195,65 +5597,160 @@ func (m *SeasonChallenge) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SeasonChallenge) MarshalTo(dAtA []byte) (int, error) {
+func (m *ChallengeFlavor_ProxyPolicy) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SeasonChallenge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ChallengeFlavor_ProxyPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Subscriptions) > 0 {
-		for iNdEx := len(m.Subscriptions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Subscriptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPwdb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xc
-			i--
-			dAtA[i] = 0xe2
-		}
-	}
-	if m.SeasonID != 0 {
-		i = encodeVarintPwdb(dAtA, i, uint64(m.SeasonID))
+	if m.ConnLimit != 0 {
+		i = encodeVarintPwdb(dAtA, i, uint64(m.ConnLimit))
 		i--
-		dAtA[i] = 0xc
-		i--
-		dAtA[i] = 0xd8
+		dAtA[i] = 0x48
 	}
-	if m.Season != nil {
-		{
-			size, err := m.Season.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPwdb(dAtA, i, uint64(size))
-		}
+	if m.RateLimitBurst != 0 {
+		i = encodeVarintPwdb(dAtA, i, uint64(m.RateLimitBurst))
 		i--
-		dAtA[i] = 0xc
+		dAtA[i] = 0x40
+	}
+	if m.RateLimit != 0 {
+		i = encodeVarintPwdb(dAtA, i, uint64(m.RateLimit))
 		i--
-		dAtA[i] = 0xd2
+		dAtA[i] = 0x38
 	}
-	if m.FlavorID != 0 {
-		i = encodeVarintPwdb(dAtA, i, uint64(m.FlavorID))
+	if m.DisableWebsocket {
 		i--
-		dAtA[i] = 0xc
+		if m.DisableWebsocket {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
-		dAtA[i] = 0xc8
+		dAtA[i] = 0x30
 	}
-	if m.Flavor != nil {
-		{
-			size, err := m.Flavor.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Headers) > 0 {
+		for k := range m.Headers {
+			v := m.Headers[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintPwdb(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintPwdb(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintPwdb(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ReadTimeout) > 0 {
+		i -= len(m.ReadTimeout)
+		copy(dAtA[i:], m.ReadTimeout)
+		i = encodeVarintPwdb(dAtA, i, uint64(len(m.ReadTimeout)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SendTimeout) > 0 {
+		i -= len(m.SendTimeout)
+		copy(dAtA[i:], m.SendTimeout)
+		i = encodeVarintPwdb(dAtA, i, uint64(len(m.SendTimeout)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConnectTimeout) > 0 {
+		i -= len(m.ConnectTimeout)
+		copy(dAtA[i:], m.ConnectTimeout)
+		i = encodeVarintPwdb(dAtA, i, uint64(len(m.ConnectTimeout)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientMaxBodySize) > 0 {
+		i -= len(m.ClientMaxBodySize)
+		copy(dAtA[i:], m.ClientMaxBodySize)
+		i = encodeVarintPwdb(dAtA, i, uint64(len(m.ClientMaxBodySize)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SeasonChallenge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SeasonChallenge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SeasonChallenge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Subscriptions) > 0 {
+		for iNdEx := len(m.Subscriptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Subscriptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPwdb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xc
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
+	if m.SeasonID != 0 {
+		i = encodeVarintPwdb(dAtA, i, uint64(m.SeasonID))
+		i--
+		dAtA[i] = 0xc
+		i--
+		dAtA[i] = 0xd8
+	}
+	if m.Season != nil {
+		{
+			size, err := m.Season.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPwdb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xc
+		i--
+		dAtA[i] = 0xd2
+	}
+	if m.FlavorID != 0 {
+		i = encodeVarintPwdb(dAtA, i, uint64(m.FlavorID))
+		i--
+		dAtA[i] = 0xc
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.Flavor != nil {
+		{
+			size, err := m.Flavor.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
 			i -= size
 			i = encodeVarintPwdb(dAtA, i, uint64(size))
@@ -5263,6 +5760,36 @@ func (m *SeasonChallenge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0xc2
 	}
+	if len(m.FirstBloods) > 0 {
+		for iNdEx := len(m.FirstBloods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FirstBloods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPwdb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if m.Solves != 0 {
+		i = encodeVarintPwdb(dAtA, i, uint64(m.Solves))
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.Points != 0 {
+		i = encodeVarintPwdb(dAtA, i, uint64(m.Points))
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xa8
+	}
 	if len(m.Slug) > 0 {
 		i -= len(m.Slug)
 		copy(dAtA[i:], m.Slug)
@@ -5273,22 +5800,22 @@ func (m *SeasonChallenge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa2
 	}
 	if m.UpdatedAt != nil {
-		n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt):])
-		if err8 != nil {
-			return 0, err8
+		n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt):])
+		if err9 != nil {
+			return 0, err9
 		}
-		i -= n8
-		i = encodeVarintPwdb(dAtA, i, uint64(n8))
+		i -= n9
+		i = encodeVarintPwdb(dAtA, i, uint64(n9))
 		i--
 		dAtA[i] = 0x1a
 	}
 	if m.CreatedAt != nil {
-		n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt):])
-		if err9 != nil {
-			return 0, err9
+		n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt):])
+		if err10 != nil {
+			return 0, err10
 		}
-		i -= n9
-		i = encodeVarintPwdb(dAtA, i, uint64(n9))
+		i -= n10
+		i = encodeVarintPwdb(dAtA, i, uint64(n10))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -5371,6 +5898,25 @@ func (m *ChallengeInstance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0xc2
 	}
+	if m.LastStartupAttemptAt != nil {
+		n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastStartupAttemptAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastStartupAttemptAt):])
+		if err13 != nil {
+			return 0, err13
+		}
+		i -= n13
+		i = encodeVarintPwdb(dAtA, i, uint64(n13))
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xf2
+	}
+	if m.StartupAttempts != 0 {
+		i = encodeVarintPwdb(dAtA, i, uint64(m.StartupAttempts))
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xe8
+	}
 	if len(m.Slug) > 0 {
 		i -= len(m.Slug)
 		copy(dAtA[i:], m.Slug)
@@ -5413,36 +5959,36 @@ func (m *ChallengeInstance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xc2
 	}
 	if m.LastRedumpRequestedAt != nil {
-		n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastRedumpRequestedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastRedumpRequestedAt):])
-		if err12 != nil {
-			return 0, err12
+		n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastRedumpRequestedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastRedumpRequestedAt):])
+		if err14 != nil {
+			return 0, err14
 		}
-		i -= n12
-		i = encodeVarintPwdb(dAtA, i, uint64(n12))
+		i -= n14
+		i = encodeVarintPwdb(dAtA, i, uint64(n14))
 		i--
 		dAtA[i] = 0x6
 		i--
 		dAtA[i] = 0xba
 	}
 	if m.LastStoppedAt != nil {
-		n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastStoppedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastStoppedAt):])
-		if err13 != nil {
-			return 0, err13
+		n15, err15 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastStoppedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastStoppedAt):])
+		if err15 != nil {
+			return 0, err15
 		}
-		i -= n13
-		i = encodeVarintPwdb(dAtA, i, uint64(n13))
+		i -= n15
+		i = encodeVarintPwdb(dAtA, i, uint64(n15))
 		i--
 		dAtA[i] = 0x6
 		i--
 		dAtA[i] = 0xb2
 	}
 	if m.LastStartedAt != nil {
-		n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastStartedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastStartedAt):])
-		if err14 != nil {
-			return 0, err14
+		n16, err16 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastStartedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastStartedAt):])
+		if err16 != nil {
+			return 0, err16
 		}
-		i -= n14
-		i = encodeVarintPwdb(dAtA, i, uint64(n14))
+		i -= n16
+		i = encodeVarintPwdb(dAtA, i, uint64(n16))
 		i--
 		dAtA[i] = 0x6
 		i--
@@ -5456,22 +6002,22 @@ func (m *ChallengeInstance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa0
 	}
 	if m.UpdatedAt != nil {
-		n15, err15 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt):])
-		if err15 != nil {
-			return 0, err15
+		n17, err17 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt):])
+		if err17 != nil {
+			return 0, err17
 		}
-		i -= n15
-		i = encodeVarintPwdb(dAtA, i, uint64(n15))
+		i -= n17
+		i = encodeVarintPwdb(dAtA, i, uint64(n17))
 		i--
 		dAtA[i] = 0x1a
 	}
 	if m.CreatedAt != nil {
-		n16, err16 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt):])
-		if err16 != nil {
-			return 0, err16
+		n18, err18 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt):])
+		if err18 != nil {
+			return 0, err18
 		}
-		i -= n16
-		i = encodeVarintPwdb(dAtA, i, uint64(n16))
+		i -= n18
+		i = encodeVarintPwdb(dAtA, i, uint64(n18))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -5519,6 +6065,18 @@ func (m *Agent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0xc2
 		}
 	}
+	if m.DrainDeadline != nil {
+		n19, err19 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.DrainDeadline, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.DrainDeadline):])
+		if err19 != nil {
+			return 0, err19
+		}
+		i -= n19
+		i = encodeVarintPwdb(dAtA, i, uint64(n19))
+		i--
+		dAtA[i] = 0x7
+		i--
+		dAtA[i] = 0xb2
+	}
 	if len(m.Slug) > 0 {
 		i -= len(m.Slug)
 		copy(dAtA[i:], m.Slug)
@@ -5550,24 +6108,24 @@ func (m *Agent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x9a
 	}
 	if m.LastSeenAt != nil {
-		n17, err17 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastSeenAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastSeenAt):])
-		if err17 != nil {
-			return 0, err17
+		n20, err20 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastSeenAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastSeenAt):])
+		if err20 != nil {
+			return 0, err20
 		}
-		i -= n17
-		i = encodeVarintPwdb(dAtA, i, uint64(n17))
+		i -= n20
+		i = encodeVarintPwdb(dAtA, i, uint64(n20))
 		i--
 		dAtA[i] = 0x7
 		i--
 		dAtA[i] = 0x92
 	}
 	if m.LastRegistrationAt != nil {
-		n18, err18 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastRegistrationAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastRegistrationAt):])
-		if err18 != nil {
-			return 0, err18
+		n21, err21 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastRegistrationAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastRegistrationAt):])
+		if err21 != nil {
+			return 0, err21
 		}
-		i -= n18
-		i = encodeVarintPwdb(dAtA, i, uint64(n18))
+		i -= n21
+		i = encodeVarintPwdb(dAtA, i, uint64(n21))
 		i--
 		dAtA[i] = 0x7
 		i--
@@ -5683,22 +6241,22 @@ func (m *Agent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa2
 	}
 	if m.UpdatedAt != nil {
-		n19, err19 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt):])
-		if err19 != nil {
-			return 0, err19
+		n22, err22 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt):])
+		if err22 != nil {
+			return 0, err22
 		}
-		i -= n19
-		i = encodeVarintPwdb(dAtA, i, uint64(n19))
+		i -= n22
+		i = encodeVarintPwdb(dAtA, i, uint64(n22))
 		i--
 		dAtA[i] = 0x1a
 	}
 	if m.CreatedAt != nil {
-		n20, err20 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt):])
-		if err20 != nil {
-			return 0, err20
+		n23, err23 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt):])
+		if err23 != nil {
+			return 0, err23
 		}
-		i -= n20
-		i = encodeVarintPwdb(dAtA, i, uint64(n20))
+		i -= n23
+		i = encodeVarintPwdb(dAtA, i, uint64(n23))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -5789,22 +6347,22 @@ func (m *OrganizationMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa0
 	}
 	if m.UpdatedAt != nil {
-		n23, err23 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt):])
-		if err23 != nil {
-			return 0, err23
+		n26, err26 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt):])
+		if err26 != nil {
+			return 0, err26
 		}
-		i -= n23
-		i = encodeVarintPwdb(dAtA, i, uint64(n23))
+		i -= n26
+		i = encodeVarintPwdb(dAtA, i, uint64(n26))
 		i--
 		dAtA[i] = 0x1a
 	}
 	if m.CreatedAt != nil {
-		n24, err24 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt):])
-		if err24 != nil {
-			return 0, err24
+		n27, err27 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt):])
+		if err27 != nil {
+			return 0, err27
 		}
-		i -= n24
-		i = encodeVarintPwdb(dAtA, i, uint64(n24))
+		i -= n27
+		i = encodeVarintPwdb(dAtA, i, uint64(n27))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -5991,22 +6549,22 @@ func (m *TeamMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa0
 	}
 	if m.UpdatedAt != nil {
-		n27, err27 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt):])
-		if err27 != nil {
-			return 0, err27
+		n30, err30 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt):])
+		if err30 != nil {
+			return 0, err30
 		}
-		i -= n27
-		i = encodeVarintPwdb(dAtA, i, uint64(n27))
+		i -= n30
+		i = encodeVarintPwdb(dAtA, i, uint64(n30))
 		i--
 		dAtA[i] = 0x1a
 	}
 	if m.CreatedAt != nil {
-		n28, err28 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt):])
-		if err28 != nil {
-			return 0, err28
+		n31, err31 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt):])
+		if err31 != nil {
+			return 0, err31
 		}
-		i -= n28
-		i = encodeVarintPwdb(dAtA, i, uint64(n28))
+		i -= n31
+		i = encodeVarintPwdb(dAtA, i, uint64(n31))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -6090,32 +6648,32 @@ func (m *TeamInvite) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xc2
 	}
 	if m.DeletedAt != nil {
-		n31, err31 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.DeletedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.DeletedAt):])
-		if err31 != nil {
-			return 0, err31
+		n34, err34 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.DeletedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.DeletedAt):])
+		if err34 != nil {
+			return 0, err34
 		}
-		i -= n31
-		i = encodeVarintPwdb(dAtA, i, uint64(n31))
+		i -= n34
+		i = encodeVarintPwdb(dAtA, i, uint64(n34))
 		i--
 		dAtA[i] = 0x22
 	}
 	if m.UpdatedAt != nil {
-		n32, err32 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt):])
-		if err32 != nil {
-			return 0, err32
+		n35, err35 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt):])
+		if err35 != nil {
+			return 0, err35
 		}
-		i -= n32
-		i = encodeVarintPwdb(dAtA, i, uint64(n32))
+		i -= n35
+		i = encodeVarintPwdb(dAtA, i, uint64(n35))
 		i--
 		dAtA[i] = 0x1a
 	}
 	if m.CreatedAt != nil {
-		n33, err33 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt):])
-		if err33 != nil {
-			return 0, err33
+		n36, err36 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt):])
+		if err36 != nil {
+			return 0, err36
 		}
-		i -= n33
-		i = encodeVarintPwdb(dAtA, i, uint64(n33))
+		i -= n36
+		i = encodeVarintPwdb(dAtA, i, uint64(n36))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -6317,32 +6875,32 @@ func (m *User) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa2
 	}
 	if m.DeletedAt != nil {
-		n36, err36 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.DeletedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.DeletedAt):])
-		if err36 != nil {
-			return 0, err36
+		n39, err39 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.DeletedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.DeletedAt):])
+		if err39 != nil {
+			return 0, err39
 		}
-		i -= n36
-		i = encodeVarintPwdb(dAtA, i, uint64(n36))
+		i -= n39
+		i = encodeVarintPwdb(dAtA, i, uint64(n39))
 		i--
 		dAtA[i] = 0x22
 	}
 	if m.UpdatedAt != nil {
-		n37, err37 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt):])
-		if err37 != nil {
-			return 0, err37
+		n40, err40 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt):])
+		if err40 != nil {
+			return 0, err40
 		}
-		i -= n37
-		i = encodeVarintPwdb(dAtA, i, uint64(n37))
+		i -= n40
+		i = encodeVarintPwdb(dAtA, i, uint64(n40))
 		i--
 		dAtA[i] = 0x1a
 	}
 	if m.CreatedAt != nil {
-		n38, err38 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt):])
-		if err38 != nil {
-			return 0, err38
+		n41, err41 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt):])
+		if err41 != nil {
+			return 0, err41
 		}
-		i -= n38
-		i = encodeVarintPwdb(dAtA, i, uint64(n38))
+		i -= n41
+		i = encodeVarintPwdb(dAtA, i, uint64(n41))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -6478,32 +7036,32 @@ func (m *Organization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa2
 	}
 	if m.DeletedAt != nil {
-		n39, err39 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.DeletedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.DeletedAt):])
-		if err39 != nil {
-			return 0, err39
+		n42, err42 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.DeletedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.DeletedAt):])
+		if err42 != nil {
+			return 0, err42
 		}
-		i -= n39
-		i = encodeVarintPwdb(dAtA, i, uint64(n39))
+		i -= n42
+		i = encodeVarintPwdb(dAtA, i, uint64(n42))
 		i--
 		dAtA[i] = 0x22
 	}
 	if m.UpdatedAt != nil {
-		n40, err40 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt):])
-		if err40 != nil {
-			return 0, err40
+		n43, err43 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt):])
+		if err43 != nil {
+			return 0, err43
 		}
-		i -= n40
-		i = encodeVarintPwdb(dAtA, i, uint64(n40))
+		i -= n43
+		i = encodeVarintPwdb(dAtA, i, uint64(n43))
 		i--
 		dAtA[i] = 0x1a
 	}
 	if m.CreatedAt != nil {
-		n41, err41 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt):])
-		if err41 != nil {
-			return 0, err41
+		n44, err44 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt):])
+		if err44 != nil {
+			return 0, err44
 		}
-		i -= n41
-		i = encodeVarintPwdb(dAtA, i, uint64(n41))
+		i -= n44
+		i = encodeVarintPwdb(dAtA, i, uint64(n44))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -6567,6 +7125,39 @@ func (m *Season) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0xc2
 		}
 	}
+	if m.ScoringDecay != 0 {
+		i = encodeVarintPwdb(dAtA, i, uint64(m.ScoringDecay))
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xf0
+	}
+	if m.ScoringMinimum != 0 {
+		i = encodeVarintPwdb(dAtA, i, uint64(m.ScoringMinimum))
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xe8
+	}
+	if m.ScoringInitial != 0 {
+		i = encodeVarintPwdb(dAtA, i, uint64(m.ScoringInitial))
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xe0
+	}
+	if m.DynamicScoring {
+		i--
+		if m.DynamicScoring {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xd8
+	}
 	if m.IsTesting {
 		i--
 		if m.IsTesting {
@@ -6631,22 +7222,22 @@ func (m *Season) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa2
 	}
 	if m.UpdatedAt != nil {
-		n42, err42 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt):])
-		if err42 != nil {
-			return 0, err42
+		n45, err45 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt):])
+		if err45 != nil {
+			return 0, err45
 		}
-		i -= n42
-		i = encodeVarintPwdb(dAtA, i, uint64(n42))
+		i -= n45
+		i = encodeVarintPwdb(dAtA, i, uint64(n45))
 		i--
 		dAtA[i] = 0x1a
 	}
 	if m.CreatedAt != nil {
-		n43, err43 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt):])
-		if err43 != nil {
-			return 0, err43
+		n46, err46 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt):])
+		if err46 != nil {
+			return 0, err46
 		}
-		i -= n43
-		i = encodeVarintPwdb(dAtA, i, uint64(n43))
+		i -= n46
+		i = encodeVarintPwdb(dAtA, i, uint64(n46))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -6768,6 +7359,18 @@ func (m *Team) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0xc2
 	}
+	if m.LastScoredAt != nil {
+		n49, err49 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastScoredAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastScoredAt):])
+		if err49 != nil {
+			return 0, err49
+		}
+		i -= n49
+		i = encodeVarintPwdb(dAtA, i, uint64(n49))
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xea
+	}
 	if len(m.Slug) > 0 {
 		i -= len(m.Slug)
 		copy(dAtA[i:], m.Slug)
@@ -6839,32 +7442,32 @@ func (m *Team) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa0
 	}
 	if m.DeletedAt != nil {
-		n46, err46 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.DeletedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.DeletedAt):])
-		if err46 != nil {
-			return 0, err46
+		n50, err50 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.DeletedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.DeletedAt):])
+		if err50 != nil {
+			return 0, err50
 		}
-		i -= n46
-		i = encodeVarintPwdb(dAtA, i, uint64(n46))
+		i -= n50
+		i = encodeVarintPwdb(dAtA, i, uint64(n50))
 		i--
 		dAtA[i] = 0x22
 	}
 	if m.UpdatedAt != nil {
-		n47, err47 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt):])
-		if err47 != nil {
-			return 0, err47
+		n51, err51 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt):])
+		if err51 != nil {
+			return 0, err51
 		}
-		i -= n47
-		i = encodeVarintPwdb(dAtA, i, uint64(n47))
+		i -= n51
+		i = encodeVarintPwdb(dAtA, i, uint64(n51))
 		i--
 		dAtA[i] = 0x1a
 	}
 	if m.CreatedAt != nil {
-		n48, err48 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt):])
-		if err48 != nil {
-			return 0, err48
+		n52, err52 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt):])
+		if err52 != nil {
+			return 0, err52
 		}
-		i -= n48
-		i = encodeVarintPwdb(dAtA, i, uint64(n48))
+		i -= n52
+		i = encodeVarintPwdb(dAtA, i, uint64(n52))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -7002,22 +7605,22 @@ func (m *WhoswhoAttempt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa0
 	}
 	if m.UpdatedAt != nil {
-		n53, err53 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt):])
-		if err53 != nil {
-			return 0, err53
+		n57, err57 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt):])
+		if err57 != nil {
+			return 0, err57
 		}
-		i -= n53
-		i = encodeVarintPwdb(dAtA, i, uint64(n53))
+		i -= n57
+		i = encodeVarintPwdb(dAtA, i, uint64(n57))
 		i--
 		dAtA[i] = 0x1a
 	}
 	if m.CreatedAt != nil {
-		n54, err54 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt):])
-		if err54 != nil {
-			return 0, err54
+		n58, err58 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt):])
+		if err58 != nil {
+			return 0, err58
 		}
-		i -= n54
-		i = encodeVarintPwdb(dAtA, i, uint64(n54))
+		i -= n58
+		i = encodeVarintPwdb(dAtA, i, uint64(n58))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -7112,6 +7715,13 @@ func (m *ChallengeValidation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0xc2
 	}
+	if m.Medal != 0 {
+		i = encodeVarintPwdb(dAtA, i, uint64(m.Medal))
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xd8
+	}
 	if len(m.Slug) > 0 {
 		i -= len(m.Slug)
 		copy(dAtA[i:], m.Slug)
@@ -7156,22 +7766,22 @@ func (m *ChallengeValidation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa0
 	}
 	if m.UpdatedAt != nil {
-		n58, err58 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt):])
-		if err58 != nil {
-			return 0, err58
+		n62, err62 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt):])
+		if err62 != nil {
+			return 0, err62
 		}
-		i -= n58
-		i = encodeVarintPwdb(dAtA, i, uint64(n58))
+		i -= n62
+		i = encodeVarintPwdb(dAtA, i, uint64(n62))
 		i--
 		dAtA[i] = 0x1a
 	}
 	if m.CreatedAt != nil {
-		n59, err59 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt):])
-		if err59 != nil {
-			return 0, err59
+		n63, err63 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt):])
+		if err63 != nil {
+			return 0, err63
 		}
-		i -= n59
-		i = encodeVarintPwdb(dAtA, i, uint64(n59))
+		i -= n63
+		i = encodeVarintPwdb(dAtA, i, uint64(n63))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -7313,12 +7923,12 @@ func (m *ChallengeSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xb2
 	}
 	if m.ClosedAt != nil {
-		n64, err64 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ClosedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ClosedAt):])
-		if err64 != nil {
-			return 0, err64
+		n68, err68 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ClosedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ClosedAt):])
+		if err68 != nil {
+			return 0, err68
 		}
-		i -= n64
-		i = encodeVarintPwdb(dAtA, i, uint64(n64))
+		i -= n68
+		i = encodeVarintPwdb(dAtA, i, uint64(n68))
 		i--
 		dAtA[i] = 0x6
 		i--
@@ -7332,22 +7942,22 @@ func (m *ChallengeSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa0
 	}
 	if m.UpdatedAt != nil {
-		n65, err65 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt):])
-		if err65 != nil {
-			return 0, err65
+		n69, err69 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt):])
+		if err69 != nil {
+			return 0, err69
 		}
-		i -= n65
-		i = encodeVarintPwdb(dAtA, i, uint64(n65))
+		i -= n69
+		i = encodeVarintPwdb(dAtA, i, uint64(n69))
 		i--
 		dAtA[i] = 0x1a
 	}
 	if m.CreatedAt != nil {
-		n66, err66 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt):])
-		if err66 != nil {
-			return 0, err66
+		n70, err70 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt):])
+		if err70 != nil {
+			return 0, err70
 		}
-		i -= n66
-		i = encodeVarintPwdb(dAtA, i, uint64(n66))
+		i -= n70
+		i = encodeVarintPwdb(dAtA, i, uint64(n70))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -7417,22 +8027,22 @@ func (m *InventoryItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa0
 	}
 	if m.UpdatedAt != nil {
-		n68, err68 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt):])
-		if err68 != nil {
-			return 0, err68
+		n72, err72 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt):])
+		if err72 != nil {
+			return 0, err72
 		}
-		i -= n68
-		i = encodeVarintPwdb(dAtA, i, uint64(n68))
+		i -= n72
+		i = encodeVarintPwdb(dAtA, i, uint64(n72))
 		i--
 		dAtA[i] = 0x1a
 	}
 	if m.CreatedAt != nil {
-		n69, err69 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt):])
-		if err69 != nil {
-			return 0, err69
+		n73, err73 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt):])
+		if err73 != nil {
+			return 0, err73
 		}
-		i -= n69
-		i = encodeVarintPwdb(dAtA, i, uint64(n69))
+		i -= n73
+		i = encodeVarintPwdb(dAtA, i, uint64(n73))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -7513,34 +8123,34 @@ func (m *Notification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xaa
 	}
 	if m.ReadAt != nil {
-		n71, err71 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ReadAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ReadAt):])
-		if err71 != nil {
-			return 0, err71
+		n75, err75 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ReadAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ReadAt):])
+		if err75 != nil {
+			return 0, err75
 		}
-		i -= n71
-		i = encodeVarintPwdb(dAtA, i, uint64(n71))
+		i -= n75
+		i = encodeVarintPwdb(dAtA, i, uint64(n75))
 		i--
 		dAtA[i] = 0x6
 		i--
 		dAtA[i] = 0xa2
 	}
 	if m.UpdatedAt != nil {
-		n72, err72 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt):])
-		if err72 != nil {
-			return 0, err72
+		n76, err76 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt):])
+		if err76 != nil {
+			return 0, err76
 		}
-		i -= n72
-		i = encodeVarintPwdb(dAtA, i, uint64(n72))
+		i -= n76
+		i = encodeVarintPwdb(dAtA, i, uint64(n76))
 		i--
 		dAtA[i] = 0x1a
 	}
 	if m.CreatedAt != nil {
-		n73, err73 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt):])
-		if err73 != nil {
-			return 0, err73
+		n77, err77 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt):])
+		if err77 != nil {
+			return 0, err77
 		}
-		i -= n73
-		i = encodeVarintPwdb(dAtA, i, uint64(n73))
+		i -= n77
+		i = encodeVarintPwdb(dAtA, i, uint64(n77))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -7633,22 +8243,22 @@ func (m *Coupon) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa2
 	}
 	if m.UpdatedAt != nil {
-		n75, err75 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt):])
-		if err75 != nil {
-			return 0, err75
+		n79, err79 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt):])
+		if err79 != nil {
+			return 0, err79
 		}
-		i -= n75
-		i = encodeVarintPwdb(dAtA, i, uint64(n75))
+		i -= n79
+		i = encodeVarintPwdb(dAtA, i, uint64(n79))
 		i--
 		dAtA[i] = 0x1a
 	}
 	if m.CreatedAt != nil {
-		n76, err76 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt):])
-		if err76 != nil {
-			return 0, err76
+		n80, err80 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt):])
+		if err80 != nil {
+			return 0, err80
 		}
-		i -= n76
-		i = encodeVarintPwdb(dAtA, i, uint64(n76))
+		i -= n80
+		i = encodeVarintPwdb(dAtA, i, uint64(n80))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -7753,22 +8363,22 @@ func (m *CouponValidation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa2
 	}
 	if m.UpdatedAt != nil {
-		n80, err80 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt):])
-		if err80 != nil {
-			return 0, err80
+		n84, err84 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt):])
+		if err84 != nil {
+			return 0, err84
 		}
-		i -= n80
-		i = encodeVarintPwdb(dAtA, i, uint64(n80))
+		i -= n84
+		i = encodeVarintPwdb(dAtA, i, uint64(n84))
 		i--
 		dAtA[i] = 0x1a
 	}
 	if m.CreatedAt != nil {
-		n81, err81 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt):])
-		if err81 != nil {
-			return 0, err81
+		n85, err85 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt):])
+		if err85 != nil {
+			return 0, err85
 		}
-		i -= n81
-		i = encodeVarintPwdb(dAtA, i, uint64(n81))
+		i -= n85
+		i = encodeVarintPwdb(dAtA, i, uint64(n85))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -7910,22 +8520,22 @@ func (m *Achievement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa0
 	}
 	if m.UpdatedAt != nil {
-		n85, err85 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt):])
-		if err85 != nil {
-			return 0, err85
+		n89, err89 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt):])
+		if err89 != nil {
+			return 0, err89
 		}
-		i -= n85
-		i = encodeVarintPwdb(dAtA, i, uint64(n85))
+		i -= n89
+		i = encodeVarintPwdb(dAtA, i, uint64(n89))
 		i--
 		dAtA[i] = 0x1a
 	}
 	if m.CreatedAt != nil {
-		n86, err86 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt):])
-		if err86 != nil {
-			return 0, err86
+		n90, err90 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt):])
+		if err90 != nil {
+			return 0, err90
 		}
-		i -= n86
-		i = encodeVarintPwdb(dAtA, i, uint64(n86))
+		i -= n90
+		i = encodeVarintPwdb(dAtA, i, uint64(n90))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -8251,6 +8861,20 @@ func (m *Activity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0xc2
 	}
+	if m.ScoreDelta != 0 {
+		i = encodeVarintPwdb(dAtA, i, uint64(m.ScoreDelta))
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.Count != 0 {
+		i = encodeVarintPwdb(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xa8
+	}
 	if m.Kind != 0 {
 		i = encodeVarintPwdb(dAtA, i, uint64(m.Kind))
 		i--
@@ -8259,22 +8883,22 @@ func (m *Activity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa0
 	}
 	if m.UpdatedAt != nil {
-		n101, err101 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt):])
-		if err101 != nil {
-			return 0, err101
+		n105, err105 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt):])
+		if err105 != nil {
+			return 0, err105
 		}
-		i -= n101
-		i = encodeVarintPwdb(dAtA, i, uint64(n101))
+		i -= n105
+		i = encodeVarintPwdb(dAtA, i, uint64(n105))
 		i--
 		dAtA[i] = 0x1a
 	}
 	if m.CreatedAt != nil {
-		n102, err102 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt):])
-		if err102 != nil {
-			return 0, err102
+		n106, err106 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt):])
+		if err106 != nil {
+			return 0, err106
 		}
-		i -= n102
-		i = encodeVarintPwdb(dAtA, i, uint64(n102))
+		i -= n106
+		i = encodeVarintPwdb(dAtA, i, uint64(n106))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -9060,6 +9684,20 @@ func (m *ChallengeFlavor) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovPwdb(uint64(l))
 	}
+	if m.ProxyPolicy != nil {
+		l = m.ProxyPolicy.Size()
+		n += 2 + l + sovPwdb(uint64(l))
+	}
+	l = len(m.ProxyPolicyConfig)
+	if l > 0 {
+		n += 2 + l + sovPwdb(uint64(l))
+	}
+	if m.PerUserPassphrases {
+		n += 3
+	}
+	if m.Points != 0 {
+		n += 2 + sovPwdb(uint64(m.Points))
+	}
 	if m.Challenge != nil {
 		l = m.Challenge.Size()
 		n += 2 + l + sovPwdb(uint64(l))
@@ -9099,6 +9737,51 @@ func (m *ChallengeFlavor_RedumpPolicy) Size() (n int) {
 	return n
 }
 
+func (m *ChallengeFlavor_ProxyPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientMaxBodySize)
+	if l > 0 {
+		n += 1 + l + sovPwdb(uint64(l))
+	}
+	l = len(m.ConnectTimeout)
+	if l > 0 {
+		n += 1 + l + sovPwdb(uint64(l))
+	}
+	l = len(m.SendTimeout)
+	if l > 0 {
+		n += 1 + l + sovPwdb(uint64(l))
+	}
+	l = len(m.ReadTimeout)
+	if l > 0 {
+		n += 1 + l + sovPwdb(uint64(l))
+	}
+	if len(m.Headers) > 0 {
+		for k, v := range m.Headers {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovPwdb(uint64(len(k))) + 1 + len(v) + sovPwdb(uint64(len(v)))
+			n += mapEntrySize + 1 + sovPwdb(uint64(mapEntrySize))
+		}
+	}
+	if m.DisableWebsocket {
+		n += 2
+	}
+	if m.RateLimit != 0 {
+		n += 1 + sovPwdb(uint64(m.RateLimit))
+	}
+	if m.RateLimitBurst != 0 {
+		n += 1 + sovPwdb(uint64(m.RateLimitBurst))
+	}
+	if m.ConnLimit != 0 {
+		n += 1 + sovPwdb(uint64(m.ConnLimit))
+	}
+	return n
+}
+
 func (m *SeasonChallenge) Size() (n int) {
 	if m == nil {
 		return 0
@@ -9120,6 +9803,18 @@ func (m *SeasonChallenge) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovPwdb(uint64(l))
 	}
+	if m.Points != 0 {
+		n += 2 + sovPwdb(uint64(m.Points))
+	}
+	if m.Solves != 0 {
+		n += 2 + sovPwdb(uint64(m.Solves))
+	}
+	if len(m.FirstBloods) > 0 {
+		for _, e := range m.FirstBloods {
+			l = e.Size()
+			n += 2 + l + sovPwdb(uint64(l))
+		}
+	}
 	if m.Flavor != nil {
 		l = m.Flavor.Size()
 		n += 2 + l + sovPwdb(uint64(l))
@@ -9193,6 +9888,13 @@ func (m *ChallengeInstance) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovPwdb(uint64(l))
 	}
+	if m.StartupAttempts != 0 {
+		n += 2 + sovPwdb(uint64(m.StartupAttempts))
+	}
+	if m.LastStartupAttemptAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastStartupAttemptAt)
+		n += 2 + l + sovPwdb(uint64(l))
+	}
 	if m.Agent != nil {
 		l = m.Agent.Size()
 		n += 2 + l + sovPwdb(uint64(l))
@@ -9298,6 +10000,10 @@ func (m *Agent) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovPwdb(uint64(l))
 	}
+	if m.DrainDeadline != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.DrainDeadline)
+		n += 2 + l + sovPwdb(uint64(l))
+	}
 	if len(m.ChallengeInstances) > 0 {
 		for _, e := range m.ChallengeInstances {
 			l = e.Size()
@@ -9662,6 +10368,18 @@ func (m *Season) Size() (n int) {
 	if m.IsTesting {
 		n += 3
 	}
+	if m.DynamicScoring {
+		n += 3
+	}
+	if m.ScoringInitial != 0 {
+		n += 2 + sovPwdb(uint64(m.ScoringInitial))
+	}
+	if m.ScoringMinimum != 0 {
+		n += 2 + sovPwdb(uint64(m.ScoringMinimum))
+	}
+	if m.ScoringDecay != 0 {
+		n += 2 + sovPwdb(uint64(m.ScoringDecay))
+	}
 	if len(m.Teams) > 0 {
 		for _, e := range m.Teams {
 			l = e.Size()
@@ -9726,6 +10444,10 @@ func (m *Team) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovPwdb(uint64(l))
 	}
+	if m.LastScoredAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastScoredAt)
+		n += 2 + l + sovPwdb(uint64(l))
+	}
 	if m.Season != nil {
 		l = m.Season.Size()
 		n += 2 + l + sovPwdb(uint64(l))
@@ -9852,6 +10574,9 @@ func (m *ChallengeValidation) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovPwdb(uint64(l))
 	}
+	if m.Medal != 0 {
+		n += 2 + sovPwdb(uint64(m.Medal))
+	}
 	if m.ChallengeSubscription != nil {
 		l = m.ChallengeSubscription.Size()
 		n += 2 + l + sovPwdb(uint64(l))
@@ -10185,6 +10910,12 @@ func (m *Activity) Size() (n int) {
 	if m.Kind != 0 {
 		n += 2 + sovPwdb(uint64(m.Kind))
 	}
+	if m.Count != 0 {
+		n += 2 + sovPwdb(uint64(m.Count))
+	}
+	if m.ScoreDelta != 0 {
+		n += 2 + sovPwdb(uint64(m.ScoreDelta))
+	}
 	if m.Author != nil {
 		l = m.Author.Size()
 		n += 2 + l + sovPwdb(uint64(l))
@@ -11492,9 +12223,9 @@ func (m *ChallengeFlavor) Unmarshal(dAtA []byte) error {
 			}
 			m.RedumpPolicyConfig = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 200:
+		case 117:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Challenge", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProxyPolicy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11521,18 +12252,18 @@ func (m *ChallengeFlavor) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Challenge == nil {
-				m.Challenge = &Challenge{}
+			if m.ProxyPolicy == nil {
+				m.ProxyPolicy = &ChallengeFlavor_ProxyPolicy{}
 			}
-			if err := m.Challenge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ProxyPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 201:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeID", wireType)
+		case 118:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProxyPolicyConfig", wireType)
 			}
-			m.ChallengeID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPwdb
@@ -11542,16 +12273,29 @@ func (m *ChallengeFlavor) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChallengeID |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 202:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SeasonChallenges", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPwdb
 			}
-			var msglen int
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPwdb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProxyPolicyConfig = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 119:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerUserPassphrases", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPwdb
@@ -11561,16 +12305,110 @@ func (m *ChallengeFlavor) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPwdb
+			m.PerUserPassphrases = bool(v != 0)
+		case 120:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Points", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			m.Points = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPwdb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Points |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 200:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Challenge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPwdb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPwdb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPwdb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Challenge == nil {
+				m.Challenge = &Challenge{}
+			}
+			if err := m.Challenge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 201:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeID", wireType)
+			}
+			m.ChallengeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPwdb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChallengeID |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 202:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeasonChallenges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPwdb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPwdb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthPwdb
 			}
 			if postIndex > l {
@@ -11756,6 +12594,391 @@ func (m *ChallengeFlavor_RedumpPolicy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ChallengeFlavor_ProxyPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPwdb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProxyPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProxyPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientMaxBodySize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPwdb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPwdb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPwdb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientMaxBodySize = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectTimeout", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPwdb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPwdb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPwdb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectTimeout = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendTimeout", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPwdb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPwdb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPwdb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SendTimeout = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadTimeout", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPwdb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPwdb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPwdb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReadTimeout = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPwdb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPwdb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPwdb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPwdb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPwdb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthPwdb
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthPwdb
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPwdb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthPwdb
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthPwdb
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPwdb(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthPwdb
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Headers[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableWebsocket", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPwdb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableWebsocket = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
+			}
+			m.RateLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPwdb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RateLimit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitBurst", wireType)
+			}
+			m.RateLimitBurst = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPwdb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RateLimitBurst |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnLimit", wireType)
+			}
+			m.ConnLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPwdb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConnLimit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPwdb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPwdb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPwdb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *SeasonChallenge) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -11872,15 +13095,85 @@ func (m *SeasonChallenge) Unmarshal(dAtA []byte) error {
 			if m.UpdatedAt == nil {
 				m.UpdatedAt = new(time.Time)
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 100:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slug", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPwdb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPwdb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPwdb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Slug = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 101:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Points", wireType)
+			}
+			m.Points = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPwdb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Points |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 102:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Solves", wireType)
+			}
+			m.Solves = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPwdb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Solves |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 100:
+		case 103:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slug", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstBloods", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPwdb
@@ -11890,23 +13183,25 @@ func (m *SeasonChallenge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPwdb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPwdb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Slug = string(dAtA[iNdEx:postIndex])
+			m.FirstBloods = append(m.FirstBloods, &ChallengeValidation{})
+			if err := m.FirstBloods[len(m.FirstBloods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 200:
 			if wireType != 2 {
@@ -12459,6 +13754,61 @@ func (m *ChallengeInstance) Unmarshal(dAtA []byte) error {
 			}
 			m.Slug = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 109:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartupAttempts", wireType)
+			}
+			m.StartupAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPwdb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartupAttempts |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 110:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastStartupAttemptAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPwdb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPwdb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPwdb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastStartupAttemptAt == nil {
+				m.LastStartupAttemptAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastStartupAttemptAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 200:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Agent", wireType)
@@ -13265,6 +14615,42 @@ func (m *Agent) Unmarshal(dAtA []byte) error {
 			}
 			m.Slug = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 118:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrainDeadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPwdb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPwdb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPwdb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DrainDeadline == nil {
+				m.DrainDeadline = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.DrainDeadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 200:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeInstances", wireType)
@@ -15856,6 +17242,83 @@ func (m *Season) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IsTesting = bool(v != 0)
+		case 107:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicScoring", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPwdb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DynamicScoring = bool(v != 0)
+		case 108:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScoringInitial", wireType)
+			}
+			m.ScoringInitial = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPwdb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScoringInitial |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 109:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScoringMinimum", wireType)
+			}
+			m.ScoringMinimum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPwdb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScoringMinimum |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 110:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScoringDecay", wireType)
+			}
+			m.ScoringDecay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPwdb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScoringDecay |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 200:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Teams", wireType)
@@ -16289,6 +17752,42 @@ func (m *Team) Unmarshal(dAtA []byte) error {
 			}
 			m.Slug = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 109:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastScoredAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPwdb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPwdb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPwdb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastScoredAt == nil {
+				m.LastScoredAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastScoredAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 200:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Season", wireType)
@@ -17208,6 +18707,25 @@ func (m *ChallengeValidation) Unmarshal(dAtA []byte) error {
 			}
 			m.Slug = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 107:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Medal", wireType)
+			}
+			m.Medal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPwdb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Medal |= ChallengeValidation_Medal(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 200:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeSubscription", wireType)
@@ -19690,6 +21208,44 @@ func (m *Activity) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 101:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPwdb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 102:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScoreDelta", wireType)
+			}
+			m.ScoreDelta = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPwdb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScoreDelta |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 200:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
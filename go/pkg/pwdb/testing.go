@@ -15,6 +15,8 @@ func TestingSqliteDB(t *testing.T, logger *zap.Logger) *gorm.DB {
 	if err != nil {
 		t.Fatalf("init in-memory sqlite server: %v", err)
 	}
+	// each connection opens a new in-memory database, the background loops of the service should share the same one
+	db.DB().SetMaxOpenConns(1)
 
 	sfn, err := snowflake.NewNode(1)
 	if err != nil {
@@ -1,8 +1,44 @@
 package pwinit
 
-import "github.com/gobuffalo/packr/v2"
+import (
+	"fmt"
+	"strings"
 
+	"github.com/gobuffalo/packr/v2"
+	"pathwar.land/pathwar/v2/go/pkg/errcode"
+)
+
+// Architectures are the linux architectures pwinit is embedded for, named like GOARCH and docker images
+var Architectures = []string{"amd64", "arm64", "386"}
+
+// DefaultArchitecture is used for the images not reporting their architecture
+const DefaultArchitecture = "amd64"
+
+// Binary returns the pwinit binary for the default architecture
 func Binary() ([]byte, error) {
+	return BinaryFor(DefaultArchitecture)
+}
+
+// BinaryFor returns the pwinit binary for the architecture of an image, i.e., "arm64"
+func BinaryFor(arch string) ([]byte, error) {
+	if arch == "" {
+		arch = DefaultArchitecture
+	}
+	supported := false
+	for _, candidate := range Architectures {
+		if candidate == arch {
+			supported = true
+			break
+		}
+	}
+	if !supported {
+		return nil, errcode.ErrUnsupportedPWInitArch.Wrap(fmt.Errorf("no pwinit binary for linux/%s, supported architectures: %s", arch, strings.Join(Architectures, ", ")))
+	}
+
 	var pwinitBox = packr.New("binaries", "../../out")
-	return pwinitBox.Find("pwinit-linux-amd64")
+	binary, err := pwinitBox.Find("pwinit-linux-" + arch)
+	if err != nil {
+		return nil, errcode.ErrUnsupportedPWInitArch.Wrap(fmt.Errorf("pwinit binary for linux/%s not embedded: %w", arch, err))
+	}
+	return binary, nil
 }
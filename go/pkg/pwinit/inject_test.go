@@ -0,0 +1,31 @@
+package pwinit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pathwar.land/pathwar/v2/go/pkg/errcode"
+)
+
+func TestBinaryFor(t *testing.T) {
+	for _, arch := range []string{"mips", "arm", "AMD64", "x86_64"} {
+		binary, err := BinaryFor(arch)
+		require.Error(t, err, arch)
+		assert.Equal(t, errcode.Code(errcode.ErrUnsupportedPWInitArch), errcode.Code(err), arch)
+		assert.Contains(t, err.Error(), "linux/"+arch, arch)
+		assert.Contains(t, err.Error(), "amd64, arm64, 386", arch)
+		assert.Nil(t, binary, arch)
+	}
+
+	// the supported architectures are only missing when the binaries were not built before the tests
+	for _, arch := range append(Architectures, "") {
+		binary, err := BinaryFor(arch)
+		if err != nil {
+			assert.Equal(t, errcode.Code(errcode.ErrUnsupportedPWInitArch), errcode.Code(err), arch)
+			assert.Contains(t, err.Error(), "not embedded", arch)
+			continue
+		}
+		assert.NotEmpty(t, binary, arch)
+	}
+}
@@ -0,0 +1,41 @@
+package pwinit
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+)
+
+// derivedPassphraseLength is the number of hex characters kept from the HMAC
+const derivedPassphraseLength = 16
+
+// DerivePassphrase returns the passphrase of a player, the prefix hash is the first label of the Host header set by
+// the proxy, i.e., "abcd1234" for abcd1234.pathwar.land.
+//
+// It is the first 16 hex characters of HMAC-SHA256(secret, "<index>:<prefix hash>"), so the challenges can compute it
+// without pwinit, i.e., in PHP: substr(hash_hmac('sha256', "0:$hash", $secret), 0, 16).
+func DerivePassphrase(secret string, index int, prefixHash string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = fmt.Fprintf(mac, "%d:%s", index, prefixHash)
+	return hex.EncodeToString(mac.Sum(nil))[:derivedPassphraseLength]
+}
+
+// IsPerUser returns true if the passphrases are derived for each player
+func (m *InitConfig) IsPerUser() bool {
+	return m.PassphraseSecret != ""
+}
+
+// Passphrase returns a passphrase of the instance, or the passphrase of a player if the passphrases are per user
+func (m *InitConfig) Passphrase(index int, prefixHash string) (string, error) {
+	if m.IsPerUser() {
+		if prefixHash == "" {
+			return "", fmt.Errorf("the passphrases are per user, a prefix hash is required")
+		}
+		return DerivePassphrase(m.PassphraseSecret, index, prefixHash), nil
+	}
+	if index < 0 || index >= len(m.Passphrases) {
+		return "", fmt.Errorf("passphrase %d not found, the instance has %d passphrase(s)", index, len(m.Passphrases))
+	}
+	return m.Passphrases[index], nil
+}
@@ -26,6 +26,12 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type InitConfig struct {
 	Passphrases []string `protobuf:"bytes,1,rep,name=passphrases,proto3" json:"passphrases,omitempty"`
+	// files rendered with the passphrases before running the on-init hook
+	Templates []string `protobuf:"bytes,2,rep,name=templates,proto3" json:"templates,omitempty"`
+	// secret used to derive the random values of the templates, shared by the services of an instance
+	Seed string `protobuf:"bytes,3,opt,name=seed,proto3" json:"seed,omitempty"`
+	// secret of the instance used to derive the passphrases of each player, replaces the passphrases if set
+	PassphraseSecret string `protobuf:"bytes,4,opt,name=passphrase_secret,json=passphraseSecret,proto3" json:"passphrase_secret,omitempty"`
 }
 
 func (m *InitConfig) Reset()         { *m = InitConfig{} }
@@ -68,6 +74,27 @@ func (m *InitConfig) GetPassphrases() []string {
 	return nil
 }
 
+func (m *InitConfig) GetTemplates() []string {
+	if m != nil {
+		return m.Templates
+	}
+	return nil
+}
+
+func (m *InitConfig) GetSeed() string {
+	if m != nil {
+		return m.Seed
+	}
+	return ""
+}
+
+func (m *InitConfig) GetPassphraseSecret() string {
+	if m != nil {
+		return m.PassphraseSecret
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*InitConfig)(nil), "pathwar.init.InitConfig")
 }
@@ -75,18 +102,21 @@ func init() {
 func init() { proto.RegisterFile("pwinit.proto", fileDescriptor_436fa48f4efffa85) }
 
 var fileDescriptor_436fa48f4efffa85 = []byte{
-	// 167 bytes of a gzipped FileDescriptorProto
+	// 224 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x29, 0x28, 0xcf, 0xcc,
 	0xcb, 0x2c, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x29, 0x48, 0x2c, 0xc9, 0x28, 0x4f,
 	0x2c, 0xd2, 0x03, 0x89, 0x49, 0xe9, 0xa6, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7,
 	0xea, 0xa7, 0xe7, 0xa7, 0xe7, 0xeb, 0x83, 0x15, 0x25, 0x95, 0xa6, 0x81, 0x79, 0x60, 0x0e, 0x98,
-	0x05, 0xd1, 0xac, 0xa4, 0xc7, 0xc5, 0xe5, 0x99, 0x97, 0x59, 0xe2, 0x9c, 0x9f, 0x97, 0x96, 0x99,
-	0x2e, 0xa4, 0xc0, 0xc5, 0x5d, 0x90, 0x58, 0x5c, 0x5c, 0x90, 0x51, 0x94, 0x58, 0x9c, 0x5a, 0x2c,
-	0xc1, 0xa8, 0xc0, 0xac, 0xc1, 0x19, 0x84, 0x2c, 0xe4, 0x64, 0x72, 0xe2, 0x91, 0x1c, 0xe3, 0x85,
-	0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3,
-	0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x52, 0x30, 0x67, 0xe4, 0x24, 0xe6, 0xa5, 0xe8, 0x83, 0x2c, 0xce,
-	0x4e, 0xd7, 0x87, 0x38, 0x34, 0x89, 0x0d, 0x6c, 0x99, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x65,
-	0x46, 0x98, 0xc4, 0xb9, 0x00, 0x00, 0x00,
+	0x05, 0xd1, 0xac, 0xd4, 0xcb, 0xc8, 0xc5, 0xe5, 0x99, 0x97, 0x59, 0xe2, 0x9c, 0x9f, 0x97, 0x96,
+	0x99, 0x2e, 0xa4, 0xc0, 0xc5, 0x5d, 0x90, 0x58, 0x5c, 0x5c, 0x90, 0x51, 0x94, 0x58, 0x9c, 0x5a,
+	0x2c, 0xc1, 0xa8, 0xc0, 0xac, 0xc1, 0x19, 0x84, 0x2c, 0x24, 0x24, 0xc3, 0xc5, 0x59, 0x92, 0x9a,
+	0x5b, 0x90, 0x93, 0x58, 0x92, 0x5a, 0x2c, 0xc1, 0x04, 0x96, 0x47, 0x08, 0x08, 0x09, 0x71, 0xb1,
+	0x14, 0xa7, 0xa6, 0xa6, 0x48, 0x30, 0x2b, 0x30, 0x6a, 0x70, 0x06, 0x81, 0xd9, 0x42, 0xda, 0x5c,
+	0x82, 0x08, 0x03, 0xe2, 0x8b, 0x53, 0x93, 0x8b, 0x52, 0x4b, 0x24, 0x58, 0xc0, 0x0a, 0x04, 0x10,
+	0x12, 0xc1, 0x60, 0x71, 0x27, 0x93, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0,
+	0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88,
+	0x92, 0x82, 0x79, 0x33, 0x27, 0x31, 0x2f, 0x45, 0x1f, 0xe4, 0xb1, 0xec, 0x74, 0x7d, 0x48, 0x40,
+	0x24, 0xb1, 0x81, 0x3d, 0x63, 0x0c, 0x18, 0x00, 0x12, 0xa0, 0x36, 0x51, 0x19, 0x01, 0x00, 0x00,
 }
 
 func (m *InitConfig) Marshal() (dAtA []byte, err error) {
@@ -109,6 +139,29 @@ func (m *InitConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PassphraseSecret) > 0 {
+		i -= len(m.PassphraseSecret)
+		copy(dAtA[i:], m.PassphraseSecret)
+		i = encodeVarintPwinit(dAtA, i, uint64(len(m.PassphraseSecret)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Seed) > 0 {
+		i -= len(m.Seed)
+		copy(dAtA[i:], m.Seed)
+		i = encodeVarintPwinit(dAtA, i, uint64(len(m.Seed)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Templates) > 0 {
+		for iNdEx := len(m.Templates) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Templates[iNdEx])
+			copy(dAtA[i:], m.Templates[iNdEx])
+			i = encodeVarintPwinit(dAtA, i, uint64(len(m.Templates[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Passphrases) > 0 {
 		for iNdEx := len(m.Passphrases) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Passphrases[iNdEx])
@@ -144,6 +197,20 @@ func (m *InitConfig) Size() (n int) {
 			n += 1 + l + sovPwinit(uint64(l))
 		}
 	}
+	if len(m.Templates) > 0 {
+		for _, s := range m.Templates {
+			l = len(s)
+			n += 1 + l + sovPwinit(uint64(l))
+		}
+	}
+	l = len(m.Seed)
+	if l > 0 {
+		n += 1 + l + sovPwinit(uint64(l))
+	}
+	l = len(m.PassphraseSecret)
+	if l > 0 {
+		n += 1 + l + sovPwinit(uint64(l))
+	}
 	return n
 }
 
@@ -214,6 +281,102 @@ func (m *InitConfig) Unmarshal(dAtA []byte) error {
 			}
 			m.Passphrases = append(m.Passphrases, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Templates", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPwinit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPwinit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPwinit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Templates = append(m.Templates, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPwinit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPwinit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPwinit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seed = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PassphraseSecret", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPwinit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPwinit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPwinit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PassphraseSecret = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPwinit(dAtA[iNdEx:])
@@ -0,0 +1,95 @@
+package pwinit
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/md5"  // nolint:gosec
+	"crypto/sha1" // nolint:gosec
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io/ioutil"
+	"os"
+	"text/template"
+
+	"pathwar.land/pathwar/v2/go/pkg/errcode"
+)
+
+// TemplateFuncs returns the functions available in the templates, i.e., {{ passphrase 0 | sha256 }}
+func (m *InitConfig) TemplateFuncs() template.FuncMap {
+	return template.FuncMap{
+		// passphrase returns a passphrase of the instance by its index
+		"passphrase": func(index int) (string, error) {
+			return m.Passphrase(index, "")
+		},
+		// passphraseSecret returns the secret used to derive the passphrases of the players, see DerivePassphrase
+		"passphraseSecret": func() (string, error) {
+			if !m.IsPerUser() {
+				return "", fmt.Errorf("the passphrases are not per user")
+			}
+			return m.PassphraseSecret, nil
+		},
+		// random returns a value derived from the seed, the same name gives the same value in every service of an instance
+		"random": func(name string) string {
+			mac := hmac.New(sha256.New, []byte(m.Seed))
+			_, _ = mac.Write([]byte(name))
+			return hex.EncodeToString(mac.Sum(nil))[:32]
+		},
+		"md5":       hexDigest(md5.New),  // nolint:gosec
+		"sha1":      hexDigest(sha1.New), // nolint:gosec
+		"sha256":    hexDigest(sha256.New),
+		"sha512":    hexDigest(sha512.New),
+		"base64":    func(s string) string { return base64.StdEncoding.EncodeToString([]byte(s)) },
+		"base64url": func(s string) string { return base64.URLEncoding.EncodeToString([]byte(s)) },
+		"hex":       func(s string) string { return hex.EncodeToString([]byte(s)) },
+	}
+}
+
+// Render renders a template with the config
+func (m *InitConfig) Render(name string, text []byte) ([]byte, error) {
+	tmpl, err := template.New(name).Funcs(m.TemplateFuncs()).Parse(string(text))
+	if err != nil {
+		return nil, errcode.ErrRenderInitTemplate.Wrap(err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, nil); err != nil {
+		return nil, errcode.ErrRenderInitTemplate.Wrap(err)
+	}
+	return buf.Bytes(), nil
+}
+
+// RenderFile renders a template file in place, its mode and owner are kept
+func (m *InitConfig) RenderFile(path string) error {
+	text, err := ioutil.ReadFile(path)
+	if err != nil {
+		return errcode.ErrRenderInitTemplate.Wrap(err)
+	}
+	out, err := m.Render(path, text)
+	if err != nil {
+		return err
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_TRUNC, 0)
+	if err != nil {
+		return errcode.ErrRenderInitTemplate.Wrap(err)
+	}
+	if _, err := f.Write(out); err != nil {
+		f.Close()
+		return errcode.ErrRenderInitTemplate.Wrap(err)
+	}
+	if err := f.Close(); err != nil {
+		return errcode.ErrRenderInitTemplate.Wrap(err)
+	}
+	return nil
+}
+
+// hexDigest returns a template function computing the hex digest of a string
+func hexDigest(newHash func() hash.Hash) func(string) string {
+	return func(s string) string {
+		h := newHash()
+		_, _ = h.Write([]byte(s))
+		return hex.EncodeToString(h.Sum(nil))
+	}
+}
@@ -0,0 +1,87 @@
+package pwinit
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pathwar.land/pathwar/v2/go/pkg/errcode"
+)
+
+func TestInitConfig_Render(t *testing.T) {
+	config := InitConfig{Passphrases: []string{"a", "b"}, Seed: "s33d"}
+	perUser := InitConfig{PassphraseSecret: "s3cr3t", Seed: "s33d"}
+
+	tests := []struct {
+		name        string
+		config      InitConfig
+		text        string
+		expected    string
+		expectedErr error
+	}{
+		{"passphrase", config, `{{ passphrase 1 }}`, "b", nil},
+		{"digest", config, `{{ passphrase 0 | sha256 }}`, "ca978112ca1bbdcafac231b39a23dc4da786eff8147c4e72b9807785afee48bb", nil},
+		{"encodings", config, `{{ "a" | base64 }} {{ "a" | hex }}`, "YQ== 61", nil},
+		{"passphrase-secret", perUser, `{{ passphraseSecret }}`, "s3cr3t", nil},
+		{"passphrase-out-of-range", config, `{{ passphrase 2 }}`, "", errcode.ErrRenderInitTemplate},
+		{"passphrase-negative", config, `{{ passphrase -1 }}`, "", errcode.ErrRenderInitTemplate},
+		{"passphrase-per-user", perUser, `{{ passphrase 0 }}`, "", errcode.ErrRenderInitTemplate},
+		{"passphrase-secret-shared", config, `{{ passphraseSecret }}`, "", errcode.ErrRenderInitTemplate},
+		{"unknown-function", config, `{{ unknown }}`, "", errcode.ErrRenderInitTemplate},
+	}
+	for _, test := range tests {
+		out, err := test.config.Render(test.name, []byte(test.text))
+		assert.Equalf(t, errcode.Code(test.expectedErr), errcode.Code(err), "%s: %v", test.name, err)
+		if err != nil {
+			continue
+		}
+		assert.Equal(t, test.expected, string(out), test.name)
+	}
+
+	// the random values only depend on the seed and the name
+	random := func(config InitConfig, name string) string {
+		out, err := config.Render(name, []byte(`{{ random "`+name+`" }}`))
+		require.NoError(t, err)
+		return string(out)
+	}
+	value := random(config, "db")
+	assert.Len(t, value, 32)
+	assert.Equal(t, value, random(config, "db"))
+	assert.Equal(t, value, random(InitConfig{Seed: "s33d"}, "db"))
+	assert.NotEqual(t, value, random(config, "api"))
+	assert.NotEqual(t, value, random(InitConfig{Seed: "other"}, "db"))
+}
+
+func TestInitConfig_RenderFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pwinit")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	config := InitConfig{Passphrases: []string{"a"}}
+
+	// rendered in place, the mode is kept
+	path := filepath.Join(dir, "config.php")
+	require.NoError(t, ioutil.WriteFile(path, []byte(`<?php $flag = "{{ passphrase 0 }}";`), 0750))
+	require.NoError(t, config.RenderFile(path))
+	out, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, `<?php $flag = "a";`, string(out))
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0750), info.Mode().Perm())
+
+	// missing file
+	err = config.RenderFile(filepath.Join(dir, "missing"))
+	assert.Equal(t, errcode.Code(errcode.ErrRenderInitTemplate), errcode.Code(err))
+
+	// the file is left untouched on errors
+	invalid := filepath.Join(dir, "invalid")
+	require.NoError(t, ioutil.WriteFile(invalid, []byte(`{{ passphrase 1 }}`), 0644))
+	err = config.RenderFile(invalid)
+	assert.Equal(t, errcode.Code(errcode.ErrRenderInitTemplate), errcode.Code(err))
+	out, err = ioutil.ReadFile(invalid)
+	require.NoError(t, err)
+	assert.Equal(t, `{{ passphrase 1 }}`, string(out))
+}